@@ -0,0 +1,219 @@
+// Package adminapi exposes the admin operations — maintenance, user
+// listing and user removal — over a small authenticated HTTP API, meant
+// for a future admin dashboard. It is strictly opt-in: the server is only
+// started when an address is configured, and every request must carry the
+// admin token. Browser access is limited to the configured origins.
+package adminapi
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/Tomap-Tomap/GophKeeper/storage"
+)
+
+// adminTokenHeader carries the admin token on every request.
+const adminTokenHeader = "X-Admin-Token"
+
+// Storage describes the storage methods used by the admin API.
+type Storage interface {
+	Maintenance(ctx context.Context) (time.Duration, error)
+	ListUsers(ctx context.Context) ([]storage.UserSummary, error)
+	GetUserByID(ctx context.Context, userID string) (*storage.User, error)
+	DeleteUser(ctx context.Context, userID, loginHashed string) ([]string, error)
+	Ping(ctx context.Context) error
+}
+
+// Hasher describes the hashing method used to find a user's salt row.
+type Hasher interface {
+	GenerateHash(str string) string
+}
+
+// FileStore describes the file content removal used when a user is
+// deleted and the reachability check used by the readiness probe.
+type FileStore interface {
+	DeleteDBFile(fileName string) error
+	Ping(ctx context.Context) error
+}
+
+// Server serves the admin API endpoints.
+type Server struct {
+	s  Storage
+	h  Hasher
+	fs FileStore
+
+	token          string
+	allowedOrigins map[string]struct{}
+
+	draining atomic.Bool
+}
+
+// New creates an admin API Server. Empty origins are ignored; with an
+// empty token every request is rejected.
+func New(s Storage, h Hasher, fs FileStore, token string, allowedOrigins []string) *Server {
+	origins := make(map[string]struct{}, len(allowedOrigins))
+
+	for _, origin := range allowedOrigins {
+		if origin != "" {
+			origins[origin] = struct{}{}
+		}
+	}
+
+	return &Server{
+		s:              s,
+		h:              h,
+		fs:             fs,
+		token:          token,
+		allowedOrigins: origins,
+	}
+}
+
+// Handler returns the admin API routes wrapped in the CORS and admin
+// token middleware, plus the unauthenticated health probes.
+func (srv *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("POST /admin/maintenance", srv.maintenance)
+	mux.HandleFunc("GET /admin/users", srv.listUsers)
+	mux.HandleFunc("DELETE /admin/users/{id}", srv.deleteUser)
+
+	root := http.NewServeMux()
+
+	root.HandleFunc("GET /livez", srv.livez)
+	root.HandleFunc("GET /readyz", srv.readyz)
+	root.Handle("/", srv.cors(srv.auth(mux)))
+
+	return root
+}
+
+// auth rejects every request without the exact admin token. An empty
+// configured token disables the API entirely.
+func (srv *Server) auth(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := r.Header.Get(adminTokenHeader)
+
+		if srv.token == "" || subtle.ConstantTimeCompare([]byte(token), []byte(srv.token)) != 1 {
+			http.Error(w, "invalid admin token", http.StatusUnauthorized)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// cors sets the CORS headers for allowed origins and answers preflight
+// requests before authentication, so a browser can learn which headers
+// to send. Requests from other origins get no CORS headers and are
+// blocked by the browser.
+func (srv *Server) cors(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if origin := r.Header.Get("Origin"); origin != "" {
+			if _, ok := srv.allowedOrigins[origin]; ok {
+				w.Header().Set("Access-Control-Allow-Origin", origin)
+				w.Header().Set("Access-Control-Allow-Headers", adminTokenHeader)
+				w.Header().Set("Access-Control-Allow-Methods", "GET, POST, DELETE")
+				w.Header().Set("Vary", "Origin")
+			}
+		}
+
+		if r.Method == http.MethodOptions {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// maintenance runs VACUUM (ANALYZE) on the data tables and reports the
+// run duration.
+func (srv *Server) maintenance(w http.ResponseWriter, r *http.Request) {
+	duration, err := srv.s.Maintenance(r.Context())
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, map[string]string{"duration": duration.String()})
+}
+
+// listUsers returns the id, login and update time of every user.
+func (srv *Server) listUsers(w http.ResponseWriter, r *http.Request) {
+	users, err := srv.s.ListUsers(r.Context())
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	type userInfo struct {
+		ID       string    `json:"id"`
+		Login    string    `json:"login"`
+		UpdateAt time.Time `json:"update_at"`
+	}
+
+	infos := make([]userInfo, 0, len(users))
+
+	for _, user := range users {
+		infos = append(infos, userInfo{
+			ID:       user.ID,
+			Login:    user.Login,
+			UpdateAt: user.UpdateAt,
+		})
+	}
+
+	writeJSON(w, map[string]any{"users": infos})
+}
+
+// deleteUser removes the user with the given id together with all their
+// stored data and file content.
+func (srv *Server) deleteUser(w http.ResponseWriter, r *http.Request) {
+	userID := r.PathValue("id")
+
+	user, err := srv.s.GetUserByID(r.Context(), userID)
+
+	if err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	paths, err := srv.s.DeleteUser(r.Context(), userID, srv.h.GenerateHash(user.Login))
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var contentErrs error
+
+	for _, path := range paths {
+		contentErrs = errors.Join(contentErrs, srv.fs.DeleteDBFile(path))
+	}
+
+	if contentErrs != nil {
+		http.Error(w, contentErrs.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, map[string]string{"deleted": userID})
+}
+
+// writeJSON writes v as the JSON response body.
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,297 @@
+//go:build unit
+
+package adminapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Tomap-Tomap/GophKeeper/storage"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type StorageMockedObject struct {
+	mock.Mock
+}
+
+func (sm *StorageMockedObject) Maintenance(_ context.Context) (time.Duration, error) {
+	args := sm.Called()
+
+	return args.Get(0).(time.Duration), args.Error(1)
+}
+
+func (sm *StorageMockedObject) ListUsers(_ context.Context) ([]storage.UserSummary, error) {
+	args := sm.Called()
+
+	return args.Get(0).([]storage.UserSummary), args.Error(1)
+}
+
+func (sm *StorageMockedObject) GetUserByID(_ context.Context, userID string) (*storage.User, error) {
+	args := sm.Called(userID)
+
+	return args.Get(0).(*storage.User), args.Error(1)
+}
+
+func (sm *StorageMockedObject) DeleteUser(_ context.Context, userID, loginHashed string) ([]string, error) {
+	args := sm.Called(userID, loginHashed)
+
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (sm *StorageMockedObject) Ping(_ context.Context) error {
+	args := sm.Called()
+
+	return args.Error(0)
+}
+
+type HasherMockedObject struct {
+	mock.Mock
+}
+
+func (hm *HasherMockedObject) GenerateHash(str string) string {
+	args := hm.Called(str)
+
+	return args.String(0)
+}
+
+type FileStoreMockedObject struct {
+	mock.Mock
+}
+
+func (fm *FileStoreMockedObject) DeleteDBFile(fileName string) error {
+	args := fm.Called(fileName)
+
+	return args.Error(0)
+}
+
+func (fm *FileStoreMockedObject) Ping(_ context.Context) error {
+	args := fm.Called()
+
+	return args.Error(0)
+}
+
+type AdminAPITestSuite struct {
+	suite.Suite
+
+	sm *StorageMockedObject
+	hm *HasherMockedObject
+	fm *FileStoreMockedObject
+}
+
+func (suite *AdminAPITestSuite) SetupTest() {
+	suite.sm = new(StorageMockedObject)
+	suite.hm = new(HasherMockedObject)
+	suite.fm = new(FileStoreMockedObject)
+}
+
+func (suite *AdminAPITestSuite) handler(token string, origins ...string) http.Handler {
+	return New(suite.sm, suite.hm, suite.fm, token, origins).Handler()
+}
+
+func (suite *AdminAPITestSuite) do(h http.Handler, r *http.Request) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+
+	return w
+}
+
+func (suite *AdminAPITestSuite) TestAuth() {
+	suite.Run("missing token", func() {
+		r := httptest.NewRequest(http.MethodPost, "/admin/maintenance", nil)
+
+		w := suite.do(suite.handler("testToken"), r)
+
+		suite.Equal(http.StatusUnauthorized, w.Code)
+	})
+
+	suite.Run("wrong token", func() {
+		r := httptest.NewRequest(http.MethodPost, "/admin/maintenance", nil)
+		r.Header.Set(adminTokenHeader, "wrongToken")
+
+		w := suite.do(suite.handler("testToken"), r)
+
+		suite.Equal(http.StatusUnauthorized, w.Code)
+	})
+
+	suite.Run("empty configured token", func() {
+		r := httptest.NewRequest(http.MethodPost, "/admin/maintenance", nil)
+		r.Header.Set(adminTokenHeader, "")
+
+		w := suite.do(suite.handler(""), r)
+
+		suite.Equal(http.StatusUnauthorized, w.Code)
+	})
+
+	suite.Run("valid token", func() {
+		suite.sm.On("Maintenance").Return(time.Second, nil)
+
+		r := httptest.NewRequest(http.MethodPost, "/admin/maintenance", nil)
+		r.Header.Set(adminTokenHeader, "testToken")
+
+		w := suite.do(suite.handler("testToken"), r)
+
+		suite.Equal(http.StatusOK, w.Code)
+		suite.JSONEq(`{"duration": "1s"}`, w.Body.String())
+	})
+}
+
+func (suite *AdminAPITestSuite) TestCORS() {
+	suite.Run("allowed origin", func() {
+		suite.sm.On("Maintenance").Return(time.Second, nil)
+
+		r := httptest.NewRequest(http.MethodPost, "/admin/maintenance", nil)
+		r.Header.Set(adminTokenHeader, "testToken")
+		r.Header.Set("Origin", "https://admin.example.com")
+
+		w := suite.do(suite.handler("testToken", "https://admin.example.com"), r)
+
+		suite.Equal(http.StatusOK, w.Code)
+		suite.Equal("https://admin.example.com", w.Header().Get("Access-Control-Allow-Origin"))
+	})
+
+	suite.Run("disallowed origin", func() {
+		suite.sm.On("Maintenance").Return(time.Second, nil)
+
+		r := httptest.NewRequest(http.MethodPost, "/admin/maintenance", nil)
+		r.Header.Set(adminTokenHeader, "testToken")
+		r.Header.Set("Origin", "https://evil.example.com")
+
+		w := suite.do(suite.handler("testToken", "https://admin.example.com"), r)
+
+		suite.Empty(w.Header().Get("Access-Control-Allow-Origin"))
+	})
+
+	suite.Run("preflight before auth", func() {
+		r := httptest.NewRequest(http.MethodOptions, "/admin/maintenance", nil)
+		r.Header.Set("Origin", "https://admin.example.com")
+
+		w := suite.do(suite.handler("testToken", "https://admin.example.com"), r)
+
+		suite.Equal(http.StatusNoContent, w.Code)
+		suite.Equal("https://admin.example.com", w.Header().Get("Access-Control-Allow-Origin"))
+	})
+}
+
+func (suite *AdminAPITestSuite) TestListUsers() {
+	updateAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	suite.sm.On("ListUsers").Return([]storage.UserSummary{
+		{ID: "testID", Login: "testLogin", UpdateAt: updateAt},
+	}, nil)
+
+	r := httptest.NewRequest(http.MethodGet, "/admin/users", nil)
+	r.Header.Set(adminTokenHeader, "testToken")
+
+	w := suite.do(suite.handler("testToken"), r)
+
+	suite.Equal(http.StatusOK, w.Code)
+	suite.JSONEq(
+		`{"users": [{"id": "testID", "login": "testLogin", "update_at": "2024-01-02T03:04:05Z"}]}`,
+		w.Body.String(),
+	)
+}
+
+func (suite *AdminAPITestSuite) TestDeleteUser() {
+	suite.Run("unknown user", func() {
+		suite.sm.On("GetUserByID", "unknownID").
+			Return((*storage.User)(nil), storage.ErrUserNotFound)
+
+		r := httptest.NewRequest(http.MethodDelete, "/admin/users/unknownID", nil)
+		r.Header.Set(adminTokenHeader, "testToken")
+
+		w := suite.do(suite.handler("testToken"), r)
+
+		suite.Equal(http.StatusNotFound, w.Code)
+	})
+
+	suite.Run("positive", func() {
+		suite.sm.On("GetUserByID", "testID").
+			Return(&storage.User{ID: "testID", Login: "testLogin"}, nil)
+		suite.hm.On("GenerateHash", "testLogin").Return("testLoginHashed")
+		suite.sm.On("DeleteUser", "testID", "testLoginHashed").
+			Return([]string{"testPath"}, nil)
+		suite.fm.On("DeleteDBFile", "testPath").Return(nil)
+
+		r := httptest.NewRequest(http.MethodDelete, "/admin/users/testID", nil)
+		r.Header.Set(adminTokenHeader, "testToken")
+
+		w := suite.do(suite.handler("testToken"), r)
+
+		suite.Equal(http.StatusOK, w.Code)
+		suite.JSONEq(`{"deleted": "testID"}`, w.Body.String())
+		suite.fm.AssertExpectations(suite.T())
+	})
+}
+
+func (suite *AdminAPITestSuite) TestHealthProbes() {
+	suite.Run("ready without the admin token", func() {
+		suite.sm.On("Ping").Return(nil).Once()
+		suite.fm.On("Ping").Return(nil).Once()
+
+		r := httptest.NewRequest(http.MethodGet, "/readyz", nil)
+
+		w := suite.do(suite.handler("testToken"), r)
+
+		suite.Equal(http.StatusOK, w.Code)
+		suite.JSONEq(`{"status": "ok"}`, w.Body.String())
+	})
+
+	suite.Run("liveness stays serving while the database is down", func() {
+		suite.sm.On("Ping").Return(errors.New("testError")).Once()
+
+		h := suite.handler("testToken")
+
+		w := suite.do(h, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+		suite.Equal(http.StatusServiceUnavailable, w.Code)
+		suite.Contains(w.Body.String(), "testError")
+
+		w = suite.do(h, httptest.NewRequest(http.MethodGet, "/livez", nil))
+
+		suite.Equal(http.StatusOK, w.Code)
+	})
+
+	suite.Run("not ready while the file store is down", func() {
+		suite.sm.On("Ping").Return(nil).Once()
+		suite.fm.On("Ping").Return(errors.New("testError")).Once()
+
+		r := httptest.NewRequest(http.MethodGet, "/readyz", nil)
+
+		w := suite.do(suite.handler("testToken"), r)
+
+		suite.Equal(http.StatusServiceUnavailable, w.Code)
+	})
+
+	suite.Run("not ready while draining", func() {
+		srv := New(suite.sm, suite.hm, suite.fm, "testToken", nil)
+		srv.SetDraining()
+
+		h := srv.Handler()
+
+		w := suite.do(h, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+		suite.Equal(http.StatusServiceUnavailable, w.Code)
+		suite.Contains(w.Body.String(), "draining")
+
+		w = suite.do(h, httptest.NewRequest(http.MethodGet, "/livez", nil))
+
+		suite.Equal(http.StatusOK, w.Code)
+	})
+}
+
+func TestAdminAPITestSuite(t *testing.T) {
+	suite.Run(t, new(AdminAPITestSuite))
+}
+
+func TestNewFiltersEmptyOrigins(t *testing.T) {
+	srv := New(nil, nil, nil, "testToken", []string{"", "https://admin.example.com", ""})
+
+	require.Len(t, srv.allowedOrigins, 1)
+	require.Contains(t, srv.allowedOrigins, "https://admin.example.com")
+}
@@ -0,0 +1,42 @@
+package adminapi
+
+import "net/http"
+
+// SetDraining marks the server as draining: from this point the
+// readiness probe reports not-ready so load balancers stop routing new
+// traffic, while the liveness probe keeps answering so an orchestrator
+// does not kill the process mid-drain. The shutdown path calls it before
+// the gRPC server's graceful stop.
+func (srv *Server) SetDraining() {
+	srv.draining.Store(true)
+}
+
+// livez is the liveness probe: it answers OK whenever the process is up
+// and serving HTTP, regardless of the database or file store state, so a
+// dependency outage never restarts the server.
+func (srv *Server) livez(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, map[string]string{"status": "ok"})
+}
+
+// readyz is the readiness probe: it answers OK only while the server can
+// actually serve requests — not draining, the database reachable and the
+// file store reachable. Any failed check answers 503 with the reason, so
+// load balancers take the instance out of rotation without killing it.
+func (srv *Server) readyz(w http.ResponseWriter, r *http.Request) {
+	if srv.draining.Load() {
+		http.Error(w, "draining", http.StatusServiceUnavailable)
+		return
+	}
+
+	if err := srv.s.Ping(r.Context()); err != nil {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+
+	if err := srv.fs.Ping(r.Context()); err != nil {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+
+	writeJSON(w, map[string]string{"status": "ok"})
+}
@@ -0,0 +1,58 @@
+//go:build unit
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestRateLimits transfers three 1 KiB chunks under a 1 KiB/s cap. The
+// limiter allows one second's worth of bytes up front, so the remaining
+// two chunks must take at least two seconds; the assertion leaves slack
+// for timer granularity.
+func TestRateLimits(t *testing.T) {
+	addr, _ := startFileEchoTestServer(t)
+
+	content := make([]byte, 3*1024)
+	_, err := rand.Read(content)
+	require.NoError(t, err)
+
+	const minElapsed = 1800 * time.Millisecond
+
+	t.Run("upload rate limit", func(t *testing.T) {
+		client, err := New(newOfflineTestCrypter(t), addr, WithUploadRateLimit(1024))
+		require.NoError(t, err)
+		defer client.Close()
+
+		start := time.Now()
+
+		require.NoError(t, client.CreateFileFromReader(context.Background(), "testName", bytes.NewReader(content), "testMeta"))
+		require.GreaterOrEqual(t, time.Since(start), minElapsed)
+	})
+
+	t.Run("download rate limit", func(t *testing.T) {
+		client, err := New(newOfflineTestCrypter(t), addr, WithDownloadRateLimit(1024))
+		require.NoError(t, err)
+		defer client.Close()
+
+		require.NoError(t, client.CreateFileFromReader(context.Background(), "testName", bytes.NewReader(content), "testMeta"))
+
+		dir := t.TempDir()
+		start := time.Now()
+
+		require.NoError(t, client.GetFile(context.Background(), "testFileID", dir))
+		require.GreaterOrEqual(t, time.Since(start), minElapsed)
+
+		data, err := os.ReadFile(filepath.Join(dir, "testFileID"))
+		require.NoError(t, err)
+		require.Equal(t, content, data)
+	})
+}
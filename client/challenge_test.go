@@ -0,0 +1,93 @@
+//go:build unit
+
+package client
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"net"
+	"testing"
+
+	proto "github.com/Tomap-Tomap/GophKeeper/proto/gophkeeper/v1"
+	"github.com/Tomap-Tomap/GophKeeper/tokener"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// challengeTestServer answers Challenge with a real signature over the
+// client's nonce, optionally corrupting it to simulate an impostor.
+type challengeTestServer struct {
+	proto.UnimplementedGophKeeperServiceServer
+
+	key    *rsa.PrivateKey
+	tamper bool
+}
+
+func (s *challengeTestServer) Challenge(_ context.Context, req *proto.ChallengeRequest) (*proto.ChallengeResponse, error) {
+	signature, err := tokener.SignChallenge(s.key, req.GetNonce())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if s.tamper {
+		signature[0] ^= 0xFF
+	}
+
+	return &proto.ChallengeResponse{Signature: signature}, nil
+}
+
+func startChallengeTestServer(t *testing.T, key *rsa.PrivateKey, tamper bool) string {
+	lis, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer()
+	proto.RegisterGophKeeperServiceServer(server, &challengeTestServer{key: key, tamper: tamper})
+
+	go func() {
+		_ = server.Serve(lis)
+	}()
+
+	t.Cleanup(server.Stop)
+
+	return lis.Addr().String()
+}
+
+func TestVerifyServer(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	t.Run("valid signature", func(t *testing.T) {
+		addr := startChallengeTestServer(t, key, false)
+
+		client, err := New(newOfflineTestCrypter(t), addr)
+		require.NoError(t, err)
+		defer client.Close()
+
+		require.NoError(t, client.VerifyServer(context.Background(), &key.PublicKey))
+	})
+
+	t.Run("tampered signature", func(t *testing.T) {
+		addr := startChallengeTestServer(t, key, true)
+
+		client, err := New(newOfflineTestCrypter(t), addr)
+		require.NoError(t, err)
+		defer client.Close()
+
+		require.ErrorContains(t, client.VerifyServer(context.Background(), &key.PublicKey), "server identity is not confirmed")
+	})
+
+	t.Run("unexpected key", func(t *testing.T) {
+		otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+
+		addr := startChallengeTestServer(t, otherKey, false)
+
+		client, err := New(newOfflineTestCrypter(t), addr)
+		require.NoError(t, err)
+		defer client.Close()
+
+		require.ErrorContains(t, client.VerifyServer(context.Background(), &key.PublicKey), "server identity is not confirmed")
+	})
+}
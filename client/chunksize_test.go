@@ -0,0 +1,73 @@
+//go:build unit
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetChunkSizeFallback(t *testing.T) {
+	content := bytes.Repeat([]byte("fallback chunk size test data,"), 10)
+
+	roundTrip := func(t *testing.T, client *Client, content []byte) []byte {
+		require.NoError(t, client.CreateFileFromReader(context.Background(), "testName", bytes.NewReader(content), "testMeta"))
+
+		dir := t.TempDir()
+		require.NoError(t, client.GetFile(context.Background(), "testFileID", dir))
+
+		got, err := os.ReadFile(filepath.Join(dir, "testFileID"))
+		require.NoError(t, err)
+
+		return got
+	}
+
+	t.Run("unavailable server falls back to the default", func(t *testing.T) {
+		addr, echo := startFileEchoTestServer(t)
+		echo.chunkSizeErr = status.Error(codes.Unavailable, "server is down")
+
+		client, err := New(newOfflineTestCrypter(t), addr)
+		require.NoError(t, err)
+		defer client.Close()
+
+		got := roundTrip(t, client, content)
+		require.Equal(t, content, got)
+	})
+
+	t.Run("configured fallback bounds the chunks", func(t *testing.T) {
+		addr, echo := startFileEchoTestServer(t)
+		echo.chunkSizeErr = status.Error(codes.Unavailable, "server is down")
+
+		client, err := New(newOfflineTestCrypter(t), addr, WithFallbackChunkSize(64))
+		require.NoError(t, err)
+		defer client.Close()
+
+		got := roundTrip(t, client, content)
+		require.Equal(t, content, got)
+
+		// One nonce frame plus the content read in 64 byte chunks.
+		echo.mu.Lock()
+		defer echo.mu.Unlock()
+
+		require.Len(t, echo.frames, 1+(len(content)+63)/64)
+	})
+
+	t.Run("other errors still abort the upload", func(t *testing.T) {
+		addr, echo := startFileEchoTestServer(t)
+		echo.chunkSizeErr = status.Error(codes.Internal, "broken")
+
+		client, err := New(newOfflineTestCrypter(t), addr)
+		require.NoError(t, err)
+		defer client.Close()
+
+		err = client.CreateFileFromReader(context.Background(), "testName", bytes.NewReader(content), "testMeta")
+		require.ErrorContains(t, err, "cannot get chunk size")
+	})
+}
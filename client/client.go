@@ -0,0 +1,1804 @@
+// Package client provides a gRPC client for interacting with the GophKeeper service.
+// It includes functionalities for user registration, authentication, and CRUD operations
+// for different types of data such as passwords, bank details, texts, and files.
+// The package ensures data security by incorporating cryptographic operations for
+// sealing and opening data before transmission and after reception.
+package client
+
+import (
+	"bufio"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/Tomap-Tomap/GophKeeper/crypto"
+	"github.com/Tomap-Tomap/GophKeeper/logger"
+	proto "github.com/Tomap-Tomap/GophKeeper/proto/gophkeeper/v1"
+	"github.com/Tomap-Tomap/GophKeeper/storage"
+	"github.com/Tomap-Tomap/GophKeeper/tokener"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Crypter defines the interface for cryptographic operations.
+type Crypter interface {
+	SealStringWithoutNonce(str string) (string, error)
+	OpenStringWithoutNonce(encryptStr string) (string, error)
+	SealStringsWithoutNonce(strs []string) ([]string, error)
+	OpenStringsWithoutNonce(encryptStrs []string) ([]string, error)
+	WrapKey(key []byte) (string, error)
+	UnwrapKey(wrapped string) ([]byte, error)
+	GenerateNonce() ([]byte, error)
+	SealBytes(b, nonce []byte) []byte
+	NonceSize() int
+	GetNonceFromBytes(b []byte, nonceSize int, location crypto.NonceLocation) ([]byte, []byte, int, error)
+	OpenBytes(enctyptB []byte, nonce []byte) ([]byte, error)
+}
+
+// stdoutPath is the special GetFile path that streams decrypted content to
+// stdout instead of creating a file.
+const stdoutPath = "-"
+
+// defaultFallbackChunkSize is the upload chunk size used when the server
+// cannot report its own, see getChunkSize.
+const defaultFallbackChunkSize = 32 << 10
+
+// ErrDecryptFailed marks decryption failures, so callers can tell a wrong
+// key from a transport error.
+var ErrDecryptFailed = errors.New("decrypt failed")
+
+// ErrClientClosed is returned by any RPC method called after Close.
+var ErrClientClosed = errors.New("client is closed")
+
+// Client represents the gRPC client for interacting with the GophKeeper service.
+type Client struct {
+	grpc    proto.GophKeeperServiceClient
+	conn    *grpc.ClientConn
+	crypter Crypter
+	ti      *tokenInterceptor
+	stdout  io.Writer
+
+	markExpired       bool
+	rawExp            bool
+	envelope          bool
+	compressFiles     bool
+	decryptWorkers    int
+	fallbackChunkSize uint64
+	policies          policies
+	metaTemplates     map[string]string
+	fingerprintKey    []byte
+
+	uploadLimit   *byteLimiter
+	downloadLimit *byteLimiter
+
+	closed  *atomic.Bool
+	offline *offlineQueue
+}
+
+// Option configures optional Client behavior.
+type Option func(*options)
+
+type options struct {
+	creds             credentials.TransportCredentials
+	padding           bool
+	markExpired       bool
+	rawExp            bool
+	envelope          bool
+	compressFiles     bool
+	tokenStore        TokenStore
+	decryptWorkers    int
+	fallbackChunkSize uint64
+	policies          policies
+	metaTemplates     map[string]string
+	fingerprintKey    []byte
+	offlineQueuePath  string
+	metadata          map[string]string
+
+	reconnectAttempts int
+	reconnectNotify   func(ReconnectStatus)
+
+	slowCallLog       *zap.Logger
+	slowCallThreshold time.Duration
+
+	uploadRateLimit   uint64
+	downloadRateLimit uint64
+
+	tokenRefresh func(ctx context.Context) (string, error)
+}
+
+// WithPinnedCert makes the client connect over TLS and accept only a server
+// whose leaf certificate matches the given hex-encoded SHA-256 fingerprint.
+// Pinning the exact certificate defends a self-hosted server against rogue
+// CAs.
+func WithPinnedCert(fingerprint string) Option {
+	return func(o *options) {
+		o.creds = credentials.NewTLS(newPinnedTLSConfig(fingerprint))
+	}
+}
+
+// WithFieldPadding pads string fields to the next padBucketSize multiple
+// before sealing, so an observer of ciphertext cannot infer field lengths.
+// Data sealed without padding still opens.
+func WithFieldPadding() Option {
+	return func(o *options) {
+		o.padding = true
+	}
+}
+
+// WithExpiryCheck makes bank retrieval set the Expired flag on returned
+// cards. Exp is stored encrypted, so the check runs client-side after the
+// data is opened.
+func WithExpiryCheck() Option {
+	return func(o *options) {
+		o.markExpired = true
+	}
+}
+
+// WithRawExp stores bank expiry dates exactly as entered instead of
+// normalizing them to MM/YY, for cards with non-standard expiry formats.
+func WithRawExp() Option {
+	return func(o *options) {
+		o.rawExp = true
+	}
+}
+
+// WithDecryptWorkers bounds how many entries list retrievals decrypt
+// concurrently. The Crypter must be safe for concurrent use; AES-GCM Open
+// is. Values below one fall back to GOMAXPROCS.
+func WithDecryptWorkers(workers int) Option {
+	return func(o *options) {
+		o.decryptWorkers = workers
+	}
+}
+
+// WithEntryPolicy enforces the given check on a plaintext field of the
+// entry type (EntryPassword, EntryBank, EntryText or EntryFile) before the
+// entry is sealed on create and update. Checks accumulate and all failures
+// are reported together.
+func WithEntryPolicy(entryType, field string, check FieldPolicy) Option {
+	return func(o *options) {
+		if o.policies == nil {
+			o.policies = make(policies)
+		}
+
+		o.policies.add(entryType, field, check)
+	}
+}
+
+// WithMetaTemplate prefills the meta of new entries of the given type
+// (EntryPassword, EntryBank, EntryText or EntryFile) with the template,
+// e.g. `{"category":"","url":""}`, so structured-meta users get a
+// consistent skeleton to fill in. The template is applied only when the
+// provided meta is empty.
+func WithMetaTemplate(entryType, template string) Option {
+	return func(o *options) {
+		if o.metaTemplates == nil {
+			o.metaTemplates = make(map[string]string)
+		}
+
+		o.metaTemplates[entryType] = template
+	}
+}
+
+// WithTokenStore persists the auth token through the given TokenStore. On
+// start the client loads a persisted token, so the user does not have to
+// log in again; Logout clears it.
+func WithTokenStore(ts TokenStore) Option {
+	return func(o *options) {
+		o.tokenStore = ts
+	}
+}
+
+// WithOfflineQueue queues write RPCs that fail because the server is
+// unavailable in an encrypted file at the given path and reports them as
+// succeeded. Sync replays the queue in order once the server is reachable
+// again. The queue survives restarts.
+func WithOfflineQueue(path string) Option {
+	return func(o *options) {
+		o.offlineQueuePath = path
+	}
+}
+
+// WithPasswordFingerprints attaches a keyed hash of the plaintext password
+// (HMAC-SHA256 with the given client secret) to every created or updated
+// password entry. The server can then report reused passwords by grouping
+// the opaque fingerprints without ever seeing a plaintext.
+func WithPasswordFingerprints(secret []byte) Option {
+	return func(o *options) {
+		o.fingerprintKey = secret
+	}
+}
+
+// WithReconnect retries unary calls that fail because the server is
+// unavailable, backing off exponentially between attempts, so a server
+// restart does not surface an error for every action. notify, if not nil,
+// receives a status update before each retry and once after recovery. The
+// session token is kept across the retries, so the user is not logged
+// out. Streaming calls are not retried.
+func WithReconnect(maxAttempts int, notify func(ReconnectStatus)) Option {
+	return func(o *options) {
+		o.reconnectAttempts = maxAttempts
+		o.reconnectNotify = notify
+	}
+}
+
+// WithEnvelopeEncryption seals each new password entry with a freshly
+// generated data key and stores that key alongside the ciphertext, wrapped
+// with the master key. Sharing an entry then only requires re-wrapping the
+// data key for the recipient instead of re-encrypting the entry. Entries
+// sealed either way can always be opened.
+func WithEnvelopeEncryption() Option {
+	return func(o *options) {
+		o.envelope = true
+	}
+}
+
+// WithFallbackChunkSize sets the upload chunk size used when the server
+// cannot report its own, overriding the built-in 32KB default. Values of
+// zero keep the default.
+func WithFallbackChunkSize(size uint64) Option {
+	return func(o *options) {
+		o.fallbackChunkSize = size
+	}
+}
+
+// WithMetadata attaches the given static metadata to every outgoing
+// request, alongside the auth token. Deployments can pass values like a
+// tenant or device id for the server to log and audit.
+func WithMetadata(md map[string]string) Option {
+	return func(o *options) {
+		o.metadata = md
+	}
+}
+
+// WithTokenRefresh installs a callback that mints a fresh access token.
+// After an Unauthenticated response the client refreshes the token and
+// retries the call once; concurrent calls that hit Unauthenticated on the
+// same stale token share a single refresh.
+func WithTokenRefresh(refresh func(ctx context.Context) (string, error)) Option {
+	return func(o *options) {
+		o.tokenRefresh = refresh
+	}
+}
+
+// newPinnedTLSConfig builds a TLS config that skips chain verification and
+// instead compares the leaf certificate SHA-256 against the pinned
+// fingerprint during the handshake.
+func newPinnedTLSConfig(fingerprint string) *tls.Config {
+	return &tls.Config{
+		InsecureSkipVerify: true,
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			if len(rawCerts) == 0 {
+				return errors.New("no peer certificate")
+			}
+
+			sum := sha256.Sum256(rawCerts[0])
+			got := hex.EncodeToString(sum[:])
+
+			if !strings.EqualFold(got, fingerprint) {
+				return fmt.Errorf("pinned certificate mismatch: got %s", got)
+			}
+
+			return nil
+		},
+	}
+}
+
+// closedGuardUnary rejects unary calls after Close with ErrClientClosed
+// instead of letting them hit the closed connection.
+func closedGuardUnary(closed *atomic.Bool) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if closed.Load() {
+			return ErrClientClosed
+		}
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// staticMetadataUnary attaches the configured metadata pairs to every
+// outgoing unary call.
+func staticMetadataUnary(pairs []string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(metadata.AppendToOutgoingContext(ctx, pairs...), method, req, reply, cc, opts...)
+	}
+}
+
+// staticMetadataStream attaches the configured metadata pairs to every
+// outgoing stream call.
+func staticMetadataStream(pairs []string) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(metadata.AppendToOutgoingContext(ctx, pairs...), desc, cc, method, opts...)
+	}
+}
+
+// closedGuardStream rejects stream calls after Close with ErrClientClosed.
+func closedGuardStream(closed *atomic.Bool) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		if closed.Load() {
+			return nil, ErrClientClosed
+		}
+
+		return streamer(ctx, desc, cc, method, opts...)
+	}
+}
+
+// New creates a new Client instance with the given Crypter and server address.
+func New(crypter Crypter, addr string, opts ...Option) (retClient *Client, err error) {
+	o := &options{
+		creds:          insecure.NewCredentials(),
+		decryptWorkers: runtime.GOMAXPROCS(0),
+	}
+
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	if o.decryptWorkers < 1 {
+		o.decryptWorkers = runtime.GOMAXPROCS(0)
+	}
+
+	if o.fallbackChunkSize == 0 {
+		o.fallbackChunkSize = defaultFallbackChunkSize
+	}
+
+	ti := newTokenInterceptor()
+	ti.refresh = o.tokenRefresh
+	closed := new(atomic.Bool)
+
+	unaryInterceptors := []grpc.UnaryClientInterceptor{closedGuardUnary(closed)}
+	streamInterceptors := []grpc.StreamClientInterceptor{closedGuardStream(closed)}
+
+	if len(o.metadata) > 0 {
+		pairs := make([]string, 0, len(o.metadata)*2)
+
+		for key, value := range o.metadata {
+			pairs = append(pairs, key, value)
+		}
+
+		unaryInterceptors = append(unaryInterceptors, staticMetadataUnary(pairs))
+		streamInterceptors = append(streamInterceptors, staticMetadataStream(pairs))
+	}
+
+	var oq *offlineQueue
+
+	if o.offlineQueuePath != "" {
+		oq = &offlineQueue{path: o.offlineQueuePath}
+		unaryInterceptors = append(unaryInterceptors, oq.interceptor)
+	}
+
+	if o.reconnectAttempts > 0 {
+		r := &reconnector{
+			notify:      o.reconnectNotify,
+			maxAttempts: o.reconnectAttempts,
+		}
+
+		unaryInterceptors = append(unaryInterceptors, r.interceptor)
+	}
+
+	if o.slowCallThreshold > 0 {
+		log := o.slowCallLog
+
+		if log == nil {
+			log = logger.Log
+		}
+
+		unaryInterceptors = append(unaryInterceptors, slowCallUnary(log, o.slowCallThreshold))
+	}
+
+	unaryInterceptors = append(unaryInterceptors, ti.interceptorAddTokenUnary)
+	streamInterceptors = append(streamInterceptors, ti.interceptorAddTokenStream)
+
+	conn, err := grpc.NewClient(
+		addr,
+		grpc.WithTransportCredentials(o.creds),
+		grpc.WithChainUnaryInterceptor(unaryInterceptors...),
+		grpc.WithChainStreamInterceptor(streamInterceptors...),
+		grpc.WithDefaultCallOptions(grpc.UseCompressor(gzip.Name)),
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot create grpc client: %w", err)
+	}
+
+	// Release the connection if any later initialization step fails.
+	defer func() {
+		if err != nil {
+			err = errors.Join(err, conn.Close())
+		}
+	}()
+
+	if o.tokenStore != nil {
+		ti.store = o.tokenStore
+
+		token, err := o.tokenStore.Load()
+
+		if err != nil {
+			return nil, fmt.Errorf("cannot load token: %w", err)
+		}
+
+		if token != "" {
+			ti.setToken(token)
+		}
+	}
+
+	if o.padding {
+		crypter = newPaddingCrypter(crypter)
+	}
+
+	if oq != nil {
+		oq.crypter = crypter
+	}
+
+	return &Client{
+		grpc:    proto.NewGophKeeperServiceClient(conn),
+		conn:    conn,
+		crypter: crypter,
+		ti:      ti,
+		stdout:  os.Stdout,
+
+		markExpired:       o.markExpired,
+		rawExp:            o.rawExp,
+		envelope:          o.envelope,
+		compressFiles:     o.compressFiles,
+		decryptWorkers:    o.decryptWorkers,
+		fallbackChunkSize: o.fallbackChunkSize,
+		policies:          o.policies,
+		metaTemplates:     o.metaTemplates,
+		fingerprintKey:    o.fingerprintKey,
+
+		uploadLimit:   newByteLimiter(o.uploadRateLimit),
+		downloadLimit: newByteLimiter(o.downloadRateLimit),
+
+		closed:  closed,
+		offline: oq,
+	}, nil
+}
+
+// Close closes the gRPC client connection. Further RPC methods return
+// ErrClientClosed; closing an already closed client is a no-op.
+func (c *Client) Close() error {
+	if c.closed.Swap(true) {
+		return nil
+	}
+
+	return c.conn.Close()
+}
+
+// Closed reports whether Close has been called.
+func (c *Client) Closed() bool {
+	return c.closed.Load()
+}
+
+// IsAuthenticated reports whether the stored token is still accepted by the
+// server. Unauthenticated and PermissionDenied mean a missing, expired or
+// rejected token and are reported as false, not as an error.
+func (c *Client) IsAuthenticated(ctx context.Context) (bool, error) {
+	_, err := c.grpc.ValidateToken(ctx, &proto.ValidateTokenRequest{})
+
+	switch status.Code(err) {
+	case codes.OK:
+		return true, nil
+	case codes.Unauthenticated, codes.PermissionDenied:
+		return false, nil
+	default:
+		return false, fmt.Errorf("cannot validate token: %w", err)
+	}
+}
+
+// LoadPersistedToken reloads the token from the configured TokenStore into
+// the session, so a non-interactive command started after a login run
+// picks up the persisted token without re-authenticating. Without a
+// configured store it is a no-op.
+func (c *Client) LoadPersistedToken() error {
+	if c.ti.store == nil {
+		return nil
+	}
+
+	token, err := c.ti.store.Load()
+
+	if err != nil {
+		return fmt.Errorf("cannot load token: %w", err)
+	}
+
+	if token != "" {
+		c.ti.setToken(token)
+	}
+
+	return nil
+}
+
+// Logout drops the in-memory auth token and clears the persisted one, if
+// a TokenStore is configured.
+func (c *Client) Logout() error {
+	return c.ti.clearToken()
+}
+
+// challengeNonceSize is the size of the random nonce sent with an
+// identity challenge.
+const challengeNonceSize = 32
+
+// VerifyServer sends a random nonce to the server and checks its
+// signature against the given public key, confirming the server holds
+// the expected signing key before any credentials are sent.
+func (c *Client) VerifyServer(ctx context.Context, pub *rsa.PublicKey) error {
+	nonce := make([]byte, challengeNonceSize)
+
+	_, err := rand.Read(nonce)
+
+	if err != nil {
+		return fmt.Errorf("cannot generate nonce: %w", err)
+	}
+
+	res, err := c.grpc.Challenge(ctx, &proto.ChallengeRequest{Nonce: nonce})
+
+	if err != nil {
+		return fmt.Errorf("cannot get challenge signature: %w", err)
+	}
+
+	if err := tokener.VerifyChallenge(pub, nonce, res.GetSignature()); err != nil {
+		return fmt.Errorf("server identity is not confirmed: %w", err)
+	}
+
+	return nil
+}
+
+// Register registers a new user with the given login and password.
+func (c *Client) Register(ctx context.Context, login, password string) error {
+	_, err := c.grpc.Register(ctx, &proto.RegisterRequest{
+		Login:    login,
+		Password: password,
+	})
+
+	return err
+}
+
+// SignIn authenticates a user with the given login and password.
+func (c *Client) SignIn(ctx context.Context, login, password string) error {
+	_, err := c.grpc.Auth(ctx, &proto.AuthRequest{
+		Login:    login,
+		Password: password,
+	})
+
+	return err
+}
+
+// GetAllPasswords retrieves all stored passwords.
+func (c *Client) GetAllPasswords(ctx context.Context) ([]storage.Password, error) {
+	res, err := c.grpc.GetPasswords(ctx, &proto.GetPasswordsRequest{})
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot get passwords: %w", err)
+	}
+
+	pwds, err := mapParallel(ctx, c.decryptWorkers, res.GetPasswords(), func(v *proto.Password) (storage.Password, error) {
+		pwd, err := c.openPassword(v)
+
+		if err != nil {
+			return pwd, fmt.Errorf("cannot open password data: %w", err)
+		}
+
+		return pwd, nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return pwds, nil
+}
+
+// FindPasswordsByLogin retrieves all stored passwords and returns the ones
+// whose decrypted login contains the given substring. Logins are stored
+// encrypted with random nonces, so the filter necessarily runs client-side
+// after decryption.
+func (c *Client) FindPasswordsByLogin(ctx context.Context, substr string) ([]storage.Password, error) {
+	pwds, err := c.GetAllPasswords(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	matches := make([]storage.Password, 0, len(pwds))
+
+	for _, v := range pwds {
+		if strings.Contains(v.Login, substr) {
+			matches = append(matches, v)
+		}
+	}
+
+	return matches, nil
+}
+
+// applyMetaTemplate substitutes the configured meta template of the entry
+// type when no meta was provided. A non-empty meta is kept as given.
+func (c *Client) applyMetaTemplate(entryType, meta string) string {
+	if meta != "" {
+		return meta
+	}
+
+	return c.metaTemplates[entryType]
+}
+
+// CreatePassword creates a new password entry with the given details.
+func (c *Client) CreatePassword(ctx context.Context, name, login, password, meta string) error {
+	meta = c.applyMetaTemplate(EntryPassword, meta)
+
+	err := c.policies.check(EntryPassword, map[string]string{
+		"name":     name,
+		"login":    login,
+		"password": password,
+		"meta":     meta,
+	})
+
+	if err != nil {
+		return fmt.Errorf("invalid password entry: %w", err)
+	}
+
+	sealPassword, err := c.sealPassword(name, login, password, meta)
+
+	if err != nil {
+		return fmt.Errorf("cannot seal password: %w", err)
+	}
+
+	_, err = c.grpc.CreatePassword(ctx, &proto.CreatePasswordRequest{
+		Name:        sealPassword.Name,
+		Login:       sealPassword.Login,
+		Password:    sealPassword.Password,
+		Meta:        sealPassword.Meta,
+		WrappedKey:  sealPassword.WrappedKey,
+		Fingerprint: sealPassword.Fingerprint,
+	})
+
+	if err != nil {
+		return fmt.Errorf("cannot create password: %w", err)
+	}
+
+	return nil
+}
+
+// UpdatePassword updates an existing password entry with the given details.
+func (c *Client) UpdatePassword(ctx context.Context, id, name, login, password, meta string) error {
+	err := c.policies.check(EntryPassword, map[string]string{
+		"name":     name,
+		"login":    login,
+		"password": password,
+		"meta":     meta,
+	})
+
+	if err != nil {
+		return fmt.Errorf("invalid password entry: %w", err)
+	}
+
+	sealPassword, err := c.sealPassword(name, login, password, meta)
+
+	if err != nil {
+		return fmt.Errorf("cannot seal password: %w", err)
+	}
+
+	_, err = c.grpc.UpdatePassword(ctx, &proto.UpdatePasswordRequest{
+		Id:          id,
+		Name:        sealPassword.Name,
+		Login:       sealPassword.Login,
+		Password:    sealPassword.Password,
+		Meta:        sealPassword.Meta,
+		WrappedKey:  sealPassword.WrappedKey,
+		Fingerprint: sealPassword.Fingerprint,
+	})
+
+	if err != nil {
+		return fmt.Errorf("cannot update password: %w", err)
+	}
+
+	return nil
+}
+
+// RotatePassword replaces only the password field of an existing entry,
+// keeping its name, login and meta unchanged. It is a convenience over
+// UpdatePassword for guided credential rotation.
+func (c *Client) RotatePassword(ctx context.Context, id, newPassword string) error {
+	res, err := c.grpc.GetPassword(ctx, &proto.GetPasswordRequest{
+		Id: id,
+	})
+
+	if err != nil {
+		return fmt.Errorf("cannot get password: %w", err)
+	}
+
+	pwd, err := c.openPassword(res.GetPassword())
+
+	if err != nil {
+		return fmt.Errorf("cannot open password data: %w", err)
+	}
+
+	return c.UpdatePassword(ctx, id, pwd.Name, pwd.Login, newPassword, pwd.Meta)
+}
+
+// GetReusedPasswords returns the ids of password entries sharing one
+// fingerprint, grouped per reused value. Only entries created or updated
+// with WithPasswordFingerprints are covered.
+func (c *Client) GetReusedPasswords(ctx context.Context) ([][]string, error) {
+	res, err := c.grpc.GetReusedPasswords(ctx, &proto.GetReusedPasswordsRequest{})
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot get reused passwords: %w", err)
+	}
+
+	groups := make([][]string, 0, len(res.GetReused()))
+
+	for _, group := range res.GetReused() {
+		groups = append(groups, group.GetIds())
+	}
+
+	return groups, nil
+}
+
+// DeletePassword deletes a password entry by its ID.
+func (c *Client) DeletePassword(ctx context.Context, id string) error {
+	_, err := c.grpc.DeletePassword(ctx, &proto.DeletePasswordRequest{
+		Id: id,
+	})
+
+	if err != nil {
+		return fmt.Errorf("cannot delete password: %w", err)
+	}
+
+	return nil
+}
+
+// GetAllBanks retrieves all stored bank details.
+func (c *Client) GetAllBanks(ctx context.Context) ([]storage.Bank, error) {
+	res, err := c.grpc.GetBanks(ctx, &proto.GetBanksRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("cannot get banks: %w", err)
+	}
+
+	banks := make([]storage.Bank, 0, len(res.GetBanks()))
+
+	var errs error
+
+	for _, v := range res.GetBanks() {
+		bank, err := c.openBank(v)
+
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("cannot open bank's data: %w", err))
+		}
+
+		if c.markExpired {
+			bank.Expired = bank.IsExpired()
+		}
+
+		banks = append(banks, bank)
+
+	}
+
+	if errs != nil {
+		return nil, errs
+	}
+
+	return banks, nil
+}
+
+// CreateBank creates a new bank entry with the given details.
+func (c *Client) CreateBank(ctx context.Context, name, number, cvc, owner, exp, meta string) error {
+	meta = c.applyMetaTemplate(EntryBank, meta)
+
+	err := c.policies.check(EntryBank, map[string]string{
+		"name":   name,
+		"number": number,
+		"cvc":    cvc,
+		"owner":  owner,
+		"exp":    exp,
+		"meta":   meta,
+	})
+
+	if err != nil {
+		return fmt.Errorf("invalid bank entry: %w", err)
+	}
+
+	if !c.rawExp {
+		var err error
+
+		exp, err = canonicalizeExp(exp)
+
+		if err != nil {
+			return fmt.Errorf("cannot canonicalize exp: %w", err)
+		}
+	}
+
+	sealBank, err := c.sealBank(name, number, cvc, owner, exp, meta)
+	if err != nil {
+		return fmt.Errorf("cannot seal bank data: %w", err)
+	}
+
+	_, err = c.grpc.CreateBank(ctx, &proto.CreateBankRequest{
+		Name:       sealBank.Name,
+		CardNumber: sealBank.CardNumber,
+		Cvc:        sealBank.CVC,
+		Owner:      sealBank.Owner,
+		Exp:        sealBank.Exp,
+		Meta:       sealBank.Meta,
+	})
+
+	if err != nil {
+		return fmt.Errorf("cannot create bank: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateBank updates an existing bank entry with the given details.
+func (c *Client) UpdateBank(ctx context.Context, id, name, number, cvc, owner, exp, meta string) error {
+	err := c.policies.check(EntryBank, map[string]string{
+		"name":   name,
+		"number": number,
+		"cvc":    cvc,
+		"owner":  owner,
+		"exp":    exp,
+		"meta":   meta,
+	})
+
+	if err != nil {
+		return fmt.Errorf("invalid bank entry: %w", err)
+	}
+
+	if !c.rawExp {
+		var err error
+
+		exp, err = canonicalizeExp(exp)
+
+		if err != nil {
+			return fmt.Errorf("cannot canonicalize exp: %w", err)
+		}
+	}
+
+	sealBank, err := c.sealBank(name, number, cvc, owner, exp, meta)
+	if err != nil {
+		return fmt.Errorf("cannot seal bank data: %w", err)
+	}
+
+	_, err = c.grpc.UpdateBank(ctx, &proto.UpdateBankRequest{
+		Id:         id,
+		Name:       sealBank.Name,
+		CardNumber: sealBank.CardNumber,
+		Cvc:        sealBank.CVC,
+		Owner:      sealBank.Owner,
+		Exp:        sealBank.Exp,
+		Meta:       sealBank.Meta,
+	})
+
+	if err != nil {
+		return fmt.Errorf("cannot update bank: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteBank deletes a bank entry by its ID.
+func (c *Client) DeleteBank(ctx context.Context, id string) error {
+	_, err := c.grpc.DeleteBank(ctx, &proto.DeleteBankRequest{
+		Id: id,
+	})
+
+	if err != nil {
+		return fmt.Errorf("cannot delete bank: %w", err)
+	}
+
+	return nil
+}
+
+// GetAllTexts retrieves all stored text entries.
+func (c *Client) GetAllTexts(ctx context.Context) ([]storage.Text, error) {
+	res, err := c.grpc.GetTexts(ctx, &proto.GetTextsRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("cannot get texts: %w", err)
+	}
+
+	return c.openTexts(res.GetTexts())
+}
+
+// GetTextsPage retrieves one page of stored text entries ordered by update
+// time, so callers can load large vaults incrementally.
+func (c *Client) GetTextsPage(ctx context.Context, limit, offset uint32) ([]storage.Text, error) {
+	res, err := c.grpc.GetTexts(ctx, &proto.GetTextsRequest{
+		OrderBy: "update_at",
+		Limit:   limit,
+		Offset:  offset,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot get texts: %w", err)
+	}
+
+	return c.openTexts(res.GetTexts())
+}
+
+// GetTextPage reveals a slice of one text entry, so a huge note can be read
+// page by page. Texts are sealed whole, so the entry is fetched and opened
+// in full and the slicing happens client-side. Offset and length count
+// runes; an offset beyond the end yields an empty page and a page reaching
+// past the end is cut short.
+func (c *Client) GetTextPage(ctx context.Context, id string, offset, length int) (string, error) {
+	if offset < 0 || length < 0 {
+		return "", fmt.Errorf("offset and length must be non-negative, got %d and %d", offset, length)
+	}
+
+	res, err := c.grpc.GetText(ctx, &proto.GetTextRequest{Id: id})
+
+	if err != nil {
+		return "", fmt.Errorf("cannot get text: %w", err)
+	}
+
+	text, err := c.openText(res.GetText())
+
+	if err != nil {
+		return "", fmt.Errorf("cannot open text data: %w", err)
+	}
+
+	runes := []rune(text.Text)
+
+	if offset >= len(runes) {
+		return "", nil
+	}
+
+	end := offset + length
+
+	if end > len(runes) {
+		end = len(runes)
+	}
+
+	return string(runes[offset:end]), nil
+}
+
+func (c *Client) openTexts(protoTexts []*proto.Text) ([]storage.Text, error) {
+	texts := make([]storage.Text, 0, len(protoTexts))
+
+	var errs error
+
+	for _, v := range protoTexts {
+		text, err := c.openText(v)
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("cannot open text data: %w", err))
+		}
+		texts = append(texts, text)
+	}
+
+	if errs != nil {
+		return nil, errs
+	}
+
+	return texts, nil
+}
+
+// CreateText creates a new text entry with the given details.
+func (c *Client) CreateText(ctx context.Context, name, text, meta string) error {
+	meta = c.applyMetaTemplate(EntryText, meta)
+
+	err := c.policies.check(EntryText, map[string]string{
+		"name": name,
+		"text": text,
+		"meta": meta,
+	})
+
+	if err != nil {
+		return fmt.Errorf("invalid text entry: %w", err)
+	}
+
+	sealText, err := c.sealText(name, text, meta)
+	if err != nil {
+		return fmt.Errorf("cannot seal text: %w", err)
+	}
+
+	_, err = c.grpc.CreateText(ctx, &proto.CreateTextRequest{
+		Name: sealText.Name,
+		Text: sealText.Text,
+		Meta: sealText.Meta,
+	})
+
+	if err != nil {
+		return fmt.Errorf("cannot create text: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateText updates an existing text entry with the given details.
+func (c *Client) UpdateText(ctx context.Context, id, name, text, meta string) error {
+	err := c.policies.check(EntryText, map[string]string{
+		"name": name,
+		"text": text,
+		"meta": meta,
+	})
+
+	if err != nil {
+		return fmt.Errorf("invalid text entry: %w", err)
+	}
+
+	sealText, err := c.sealText(name, text, meta)
+	if err != nil {
+		return fmt.Errorf("cannot seal text: %w", err)
+	}
+
+	_, err = c.grpc.UpdateText(ctx, &proto.UpdateTextRequest{
+		Id:   id,
+		Name: sealText.Name,
+		Text: sealText.Text,
+		Meta: sealText.Meta,
+	})
+
+	if err != nil {
+		return fmt.Errorf("cannot update text: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteText deletes a text entry by its ID.
+func (c *Client) DeleteText(ctx context.Context, id string) error {
+	_, err := c.grpc.DeleteText(ctx, &proto.DeleteTextRequest{
+		Id: id,
+	})
+
+	if err != nil {
+		return fmt.Errorf("cannot delete text: %w", err)
+	}
+
+	return nil
+}
+
+// GetAllFiles retrieves all stored file entries.
+func (c *Client) GetAllFiles(ctx context.Context) ([]storage.File, error) {
+	res, err := c.grpc.GetFiles(ctx, &proto.GetFilesRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("cannot get files: %w", err)
+	}
+
+	files := make([]storage.File, 0, len(res.GetFileInfo()))
+	var errs error
+
+	for _, v := range res.GetFileInfo() {
+		file, err := c.openFile(v)
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("cannot open file data: %w", err))
+		}
+		files = append(files, file)
+	}
+
+	if errs != nil {
+		return nil, errs
+	}
+
+	return files, nil
+}
+
+// getChunkSize asks the server for its upload chunk size. When the server
+// is unavailable or does not implement the RPC, it falls back to the
+// configured default with a warning, so uploads can proceed against an
+// older server or during a blip.
+func (c *Client) getChunkSize(ctx context.Context) (uint64, error) {
+	chunkSize, err := c.grpc.GetChunkSize(ctx, &proto.GetChunkSizeRequest{})
+
+	if err != nil {
+		switch status.Code(err) {
+		case codes.Unavailable, codes.Unimplemented:
+			logger.Log.Warn("Cannot get chunk size, falling back to the default",
+				zap.Uint64("size", c.fallbackChunkSize),
+				zap.Error(err),
+			)
+
+			return c.fallbackChunkSize, nil
+		default:
+			return 0, fmt.Errorf("cannot get chunk size: %w", err)
+		}
+	}
+
+	if chunkSize.GetSize() == 0 {
+		return 0, errors.New("server returned zero chunk size")
+	}
+
+	return chunkSize.GetSize(), nil
+}
+
+// CreateFile creates a new file entry with the given details.
+func (c *Client) CreateFile(ctx context.Context, name, pathToFile, meta string) (err error) {
+	file, err := os.Open(pathToFile)
+
+	if err != nil {
+		return fmt.Errorf("cannot open file by path %s: %w", pathToFile, err)
+	}
+
+	defer func() {
+		err = errors.Join(err, file.Close())
+	}()
+
+	return c.CreateFileFromReader(ctx, name, file, meta)
+}
+
+// CreateFileFromReader creates a new file entry streaming the content from r,
+// so data can be uploaded from stdin or an in-memory buffer without a file on
+// disk.
+func (c *Client) CreateFileFromReader(ctx context.Context, name string, r io.Reader, meta string) error {
+	meta = c.applyMetaTemplate(EntryFile, meta)
+
+	err := c.policies.check(EntryFile, map[string]string{
+		"name": name,
+		"meta": meta,
+	})
+
+	if err != nil {
+		return fmt.Errorf("invalid file entry: %w", err)
+	}
+
+	chunkSize, err := c.getChunkSize(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	fileInfo, err := c.sealFile(name, markFileFormat(meta))
+
+	if err != nil {
+		return fmt.Errorf("cannot seal file info: %w", err)
+	}
+
+	stream, err := c.grpc.CreateFile(ctx)
+
+	if err != nil {
+		return fmt.Errorf("cannot start creating a file stream: %w", err)
+	}
+
+	err = stream.Send(
+		&proto.CreateFileRequest{
+			Data: &proto.CreateFileRequest_FileInfo{
+				FileInfo: &proto.File{
+					Name: fileInfo.Name,
+					Meta: fileInfo.Meta,
+				},
+			},
+		},
+	)
+
+	if err != nil {
+		return fmt.Errorf("cannot send file info: %w", err)
+	}
+
+	nonce, err := c.crypter.GenerateNonce()
+
+	if err != nil {
+		return fmt.Errorf("cannot generate nonce: %w", err)
+	}
+
+	err = stream.Send(&proto.CreateFileRequest{
+		Data: &proto.CreateFileRequest_Content{
+			Content: nonce,
+		},
+	})
+
+	if err != nil {
+		return fmt.Errorf("cannot send nonce: %w", err)
+	}
+
+	err = c.sendFileContent(ctx, r, chunkSize, nonce, func(content []byte) error {
+		return stream.Send(&proto.CreateFileRequest{
+			Data: &proto.CreateFileRequest_Content{
+				Content: content,
+			},
+		})
+	})
+
+	if err != nil {
+		return err
+	}
+
+	_, err = stream.CloseAndRecv()
+
+	if err != nil {
+		return fmt.Errorf("cannot close streaming: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateFile updates an existing file entry with the given details.
+func (c *Client) UpdateFile(ctx context.Context, id, name, pathToFile, meta string) (err error) {
+	err = c.policies.check(EntryFile, map[string]string{
+		"name": name,
+		"meta": meta,
+	})
+
+	if err != nil {
+		return fmt.Errorf("invalid file entry: %w", err)
+	}
+
+	chunkSize, err := c.getChunkSize(ctx)
+	if err != nil {
+		return err
+	}
+
+	fileInfo, err := c.sealFile(name, markFileFormat(meta))
+	if err != nil {
+		return fmt.Errorf("cannot seal file info: %w", err)
+	}
+
+	stream, err := c.grpc.UpdateFile(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot start updating a file stream: %w", err)
+	}
+
+	err = stream.Send(
+		&proto.UpdateFileRequest{
+			Data: &proto.UpdateFileRequest_FileInfo{
+				FileInfo: &proto.File{
+					Id:   id,
+					Name: fileInfo.Name,
+					Meta: fileInfo.Meta,
+				},
+			},
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("cannot send file info: %w", err)
+	}
+
+	file, err := os.Open(pathToFile)
+	if err != nil {
+		return fmt.Errorf("cannot open file by path %s: %w", pathToFile, err)
+	}
+	defer func() {
+		err = errors.Join(err, file.Close())
+	}()
+
+	nonce, err := c.crypter.GenerateNonce()
+	if err != nil {
+		return fmt.Errorf("cannot generate nonce: %w", err)
+	}
+
+	err = stream.Send(&proto.UpdateFileRequest{
+		Data: &proto.UpdateFileRequest_Content{
+			Content: nonce,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("cannot send nonce: %w", err)
+	}
+
+	err = c.sendFileContent(ctx, file, chunkSize, nonce, func(content []byte) error {
+		return stream.Send(&proto.UpdateFileRequest{
+			Data: &proto.UpdateFileRequest_Content{
+				Content: content,
+			},
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = stream.CloseAndRecv()
+	if err != nil {
+		return fmt.Errorf("cannot close streaming: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateFileInfo updates a file's name and metadata without re-uploading
+// its content. The stored meta loses its format marker, because the
+// client cannot know the content's format without downloading it; the
+// file then counts as legacy and is re-uploaded by the next migration,
+// which is wasteful but safe.
+func (c *Client) UpdateFileInfo(ctx context.Context, id, name, meta string) error {
+	err := c.policies.check(EntryFile, map[string]string{
+		"name": name,
+		"meta": meta,
+	})
+
+	if err != nil {
+		return fmt.Errorf("invalid file entry: %w", err)
+	}
+
+	fileInfo, err := c.sealFile(name, meta)
+
+	if err != nil {
+		return fmt.Errorf("cannot seal file info: %w", err)
+	}
+
+	_, err = c.grpc.UpdateFileInfo(ctx, &proto.UpdateFileInfoRequest{
+		Id:   id,
+		Name: fileInfo.Name,
+		Meta: fileInfo.Meta,
+	})
+
+	if err != nil {
+		return fmt.Errorf("cannot update file info: %w", err)
+	}
+
+	return nil
+}
+
+// RenameFilesByPattern renames every file entry whose decrypted name
+// matches the regular expression, applying the replacement the way
+// regexp.ReplaceAllString does. Only the metadata is updated, the stored
+// content is never touched. Returns how many entries were renamed.
+func (c *Client) RenameFilesByPattern(ctx context.Context, match, replace string) (int, error) {
+	re, err := regexp.Compile(match)
+
+	if err != nil {
+		return 0, fmt.Errorf("cannot compile pattern: %w", err)
+	}
+
+	files, err := c.GetAllFiles(ctx)
+
+	if err != nil {
+		return 0, fmt.Errorf("cannot get files: %w", err)
+	}
+
+	renamed := 0
+
+	for _, file := range files {
+		newName := re.ReplaceAllString(file.Name, replace)
+
+		if newName == file.Name {
+			continue
+		}
+
+		if err := c.UpdateFileInfo(ctx, file.ID, newName, file.Meta); err != nil {
+			return renamed, fmt.Errorf("cannot rename file %s: %w", file.ID, err)
+		}
+
+		renamed++
+	}
+
+	return renamed, nil
+}
+
+// GetFile retrieves a file by its ID and saves it to the specified path.
+// Passing "-" as the path streams the decrypted content to stdout instead
+// of creating a file.
+func (c *Client) GetFile(ctx context.Context, id, pathToFile string) (err error) {
+	stream, err := c.grpc.GetFile(ctx, &proto.GetFileRequest{
+		Id: id,
+	})
+
+	if err != nil {
+		return fmt.Errorf("cannot get file stream: %w", err)
+	}
+
+	_, err = stream.Recv()
+
+	if err != nil {
+		return fmt.Errorf("cannot get file info: %w", err)
+	}
+
+	var w *bufio.Writer
+
+	if pathToFile == stdoutPath {
+		w = bufio.NewWriter(c.stdout)
+	} else {
+		filePath := filepath.Join(pathToFile, id)
+
+		file, fErr := os.Create(filePath)
+
+		if fErr != nil {
+			return fmt.Errorf("cannot create file %s: %w", filePath, fErr)
+		}
+
+		defer func() {
+			err = errors.Join(err, file.Close())
+		}()
+
+		w = bufio.NewWriter(file)
+	}
+
+	return c.receiveFileContent(stream, w)
+}
+
+// receiveFileContent receives the rest of a GetFile stream after the file
+// info message, opens the content and writes the plaintext to w, honoring
+// the codec marker when one was uploaded and pacing the loop when a
+// download rate limit is set.
+func (c *Client) receiveFileContent(stream proto.GophKeeperService_GetFileClient, w *bufio.Writer) error {
+	nonce, err := c.receiveNonce(stream, w)
+
+	if err != nil {
+		return err
+	}
+
+	first := true
+	codec := codecNone
+
+	for {
+		res, err := stream.Recv()
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("cannot get content: %w", err)
+		}
+
+		if c.downloadLimit != nil {
+			if err := c.downloadLimit.wait(stream.Context(), len(res.GetContent())); err != nil {
+				return fmt.Errorf("cannot wait for download budget: %w", err)
+			}
+		}
+
+		openData, err := c.crypter.OpenBytes(res.GetContent(), nonce)
+
+		if err != nil {
+			return fmt.Errorf("cannot open content: %w: %w", ErrDecryptFailed, err)
+		}
+
+		if first {
+			first = false
+
+			if markerCodec, ok := parseCodecMarker(openData); ok {
+				codec = markerCodec
+				continue
+			}
+		}
+
+		if codec == codecFlate {
+			openData, err = decompressChunk(openData)
+
+			if err != nil {
+				return fmt.Errorf("cannot decompress content: %w", err)
+			}
+		}
+
+		_, err = w.Write(openData)
+
+		if err != nil {
+			return fmt.Errorf("cannot write content: %w", err)
+		}
+	}
+
+	err = w.Flush()
+
+	if err != nil {
+		return fmt.Errorf("cannot flush content: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteFile deletes a file entry by its ID.
+func (c *Client) DeleteFile(ctx context.Context, id string) error {
+	_, err := c.grpc.DeleteFile(ctx, &proto.DeleteFileRequest{
+		Id: id,
+	})
+
+	if err != nil {
+		return fmt.Errorf("cannot delete file: %w", err)
+	}
+
+	return nil
+}
+
+// envelopeKeySize is the size of the per-entry data keys generated with
+// envelope encryption enabled (AES-256).
+const envelopeKeySize = 32
+
+// openPassword opens the fields in one batch, in the order name, login,
+// password, meta; batch errors report that order by index. Entries carrying
+// a wrapped key are opened with the unwrapped per-entry data key.
+func (c *Client) openPassword(password *proto.Password) (retPassword storage.Password, retErr error) {
+	crypter := c.crypter
+
+	if password.GetWrappedKey() != "" {
+		dataKey, err := c.crypter.UnwrapKey(password.GetWrappedKey())
+
+		if err != nil {
+			retErr = fmt.Errorf("%w: cannot unwrap data key: %w", ErrDecryptFailed, err)
+			return
+		}
+
+		dataCrypter, err := crypto.NewCrypterByKey(dataKey)
+
+		if err != nil {
+			retErr = fmt.Errorf("%w: cannot create data key crypter: %w", ErrDecryptFailed, err)
+			return
+		}
+
+		crypter = dataCrypter
+	}
+
+	opened, err := crypter.OpenStringsWithoutNonce([]string{
+		password.GetName(),
+		password.GetLogin(),
+		password.GetPassword(),
+		password.GetMeta(),
+	})
+
+	if err != nil {
+		retErr = fmt.Errorf("%w: %w", ErrDecryptFailed, err)
+		return
+	}
+
+	retPassword = storage.Password{
+		ID:             password.GetId(),
+		Name:           opened[0],
+		Login:          opened[1],
+		Password:       opened[2],
+		Meta:           opened[3],
+		WrappedKey:     password.GetWrappedKey(),
+		Pinned:         password.GetPinned(),
+		RequiresReauth: password.GetRequiresReauth(),
+		UpdateAt:       password.GetUpdateAt().AsTime(),
+	}
+
+	return
+}
+
+// sealPassword seals the fields in one batch, in the order name, login,
+// password, meta; batch errors report that order by index. With envelope
+// encryption enabled the fields are sealed with a fresh data key, which is
+// returned wrapped with the master key.
+func (c *Client) sealPassword(name, login, password, meta string) (*storage.Password, error) {
+	crypter := c.crypter
+
+	var wrappedKey string
+
+	if c.envelope {
+		dataKey, err := crypto.GenerateDataKey(envelopeKeySize)
+
+		if err != nil {
+			return nil, fmt.Errorf("cannot generate data key: %w", err)
+		}
+
+		dataCrypter, err := crypto.NewCrypterByKey(dataKey)
+
+		if err != nil {
+			return nil, fmt.Errorf("cannot create data key crypter: %w", err)
+		}
+
+		wrappedKey, err = c.crypter.WrapKey(dataKey)
+
+		if err != nil {
+			return nil, fmt.Errorf("cannot wrap data key: %w", err)
+		}
+
+		crypter = dataCrypter
+	}
+
+	sealed, err := crypter.SealStringsWithoutNonce([]string{name, login, password, meta})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &storage.Password{
+		Name:        sealed[0],
+		Login:       sealed[1],
+		Password:    sealed[2],
+		Meta:        sealed[3],
+		WrappedKey:  wrappedKey,
+		Fingerprint: c.passwordFingerprint(password),
+	}, nil
+}
+
+// passwordFingerprint computes the keyed hash sent alongside a password
+// entry when fingerprints are enabled, and an empty string otherwise.
+func (c *Client) passwordFingerprint(password string) string {
+	if len(c.fingerprintKey) == 0 {
+		return ""
+	}
+
+	mac := hmac.New(sha256.New, c.fingerprintKey)
+	mac.Write([]byte(password))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// openBank opens the fields in one batch, in the order name, card number,
+// cvc, owner, exp, meta; batch errors report that order by index.
+func (c *Client) openBank(bank *proto.Bank) (retBank storage.Bank, retErr error) {
+	opened, err := c.crypter.OpenStringsWithoutNonce([]string{
+		bank.GetName(),
+		bank.GetCardNumber(),
+		bank.GetCvc(),
+		bank.GetOwner(),
+		bank.GetExp(),
+		bank.GetMeta(),
+	})
+
+	if err != nil {
+		retErr = fmt.Errorf("%w: %w", ErrDecryptFailed, err)
+		return
+	}
+
+	retBank = storage.Bank{
+		ID:             bank.GetId(),
+		Name:           opened[0],
+		CardNumber:     opened[1],
+		CVC:            opened[2],
+		Owner:          opened[3],
+		Exp:            opened[4],
+		Meta:           opened[5],
+		Pinned:         bank.GetPinned(),
+		RequiresReauth: bank.GetRequiresReauth(),
+		UpdateAt:       bank.GetUpdateAt().AsTime(),
+	}
+
+	return
+}
+
+// sealBank seals the fields in one batch, in the order name, card number,
+// cvc, owner, exp, meta; batch errors report that order by index.
+func (c *Client) sealBank(name, number, cvc, owner, exp, meta string) (*storage.Bank, error) {
+	sealed, err := c.crypter.SealStringsWithoutNonce([]string{name, number, cvc, owner, exp, meta})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &storage.Bank{
+		Name:       sealed[0],
+		CardNumber: sealed[1],
+		CVC:        sealed[2],
+		Owner:      sealed[3],
+		Exp:        sealed[4],
+		Meta:       sealed[5],
+	}, nil
+}
+
+// openText opens the fields in one batch, in the order name, text, meta;
+// batch errors report that order by index.
+func (c *Client) openText(text *proto.Text) (retText storage.Text, retErr error) {
+	opened, err := c.crypter.OpenStringsWithoutNonce([]string{
+		text.GetName(),
+		text.GetText(),
+		text.GetMeta(),
+	})
+
+	if err != nil {
+		retErr = fmt.Errorf("%w: %w", ErrDecryptFailed, err)
+		return
+	}
+
+	retText = storage.Text{
+		ID:             text.GetId(),
+		Name:           opened[0],
+		Text:           opened[1],
+		Meta:           opened[2],
+		Pinned:         text.GetPinned(),
+		RequiresReauth: text.GetRequiresReauth(),
+		UpdateAt:       text.GetUpdateAt().AsTime(),
+	}
+
+	return
+}
+
+// sealText seals the fields in one batch, in the order name, text, meta;
+// batch errors report that order by index.
+func (c *Client) sealText(name, text, meta string) (*storage.Text, error) {
+	sealed, err := c.crypter.SealStringsWithoutNonce([]string{name, text, meta})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &storage.Text{
+		Name: sealed[0],
+		Text: sealed[1],
+		Meta: sealed[2],
+	}, nil
+}
+
+func (c *Client) openFile(file *proto.File) (retFile storage.File, retErr error) {
+	name, err := c.crypter.OpenStringWithoutNonce(file.GetName())
+	if err != nil {
+		retErr = errors.Join(retErr, fmt.Errorf("cannot open name: %w", err))
+	}
+
+	meta, err := c.crypter.OpenStringWithoutNonce(file.GetMeta())
+	if err != nil {
+		retErr = errors.Join(retErr, fmt.Errorf("cannot open meta: %w", err))
+	}
+
+	if retErr != nil {
+		retErr = fmt.Errorf("%w: %w", ErrDecryptFailed, retErr)
+		return
+	}
+
+	_, meta = parseFileFormat(meta)
+
+	retFile = storage.File{
+		ID:             file.GetId(),
+		Name:           name,
+		Meta:           meta,
+		Pinned:         file.GetPinned(),
+		RequiresReauth: file.GetRequiresReauth(),
+		UpdateAt:       file.GetUpdateAt().AsTime(),
+	}
+
+	return
+}
+
+func (c *Client) sealFile(name, meta string) (retFile *storage.File, retErr error) {
+	sName, err := c.crypter.SealStringWithoutNonce(name)
+	if err != nil {
+		retErr = errors.Join(retErr, fmt.Errorf("cannot seal name: %w", err))
+	}
+
+	sMeta, err := c.crypter.SealStringWithoutNonce(meta)
+	if err != nil {
+		retErr = errors.Join(retErr, fmt.Errorf("cannot seal meta: %w", err))
+	}
+
+	if retErr != nil {
+		return
+	}
+
+	retFile = &storage.File{
+		Name: sName,
+		Meta: sMeta,
+	}
+
+	return
+}
+
+func (c *Client) receiveNonce(stream proto.GophKeeperService_GetFileClient, w *bufio.Writer) ([]byte, error) {
+	var nonce []byte
+	nonceSize := c.crypter.NonceSize()
+
+	for {
+		res, err := stream.Recv()
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("cannot receive nonce: %w", err)
+		}
+
+		n, content, lastNonceSize, err := c.crypter.GetNonceFromBytes(res.GetContent(), nonceSize, crypto.AtFront)
+
+		if err != nil {
+			return nil, fmt.Errorf("cannot get nonce: %w", err)
+		}
+
+		nonce = append(nonce, n...)
+		nonceSize = lastNonceSize
+
+		if content != nil {
+			openData, err := c.crypter.OpenBytes(content, nonce)
+
+			if err != nil {
+				return nil, fmt.Errorf("cannot open content: %w: %w", ErrDecryptFailed, err)
+			}
+
+			_, err = w.Write(openData)
+
+			if err != nil {
+				return nil, fmt.Errorf("cannot write content: %w", err)
+			}
+		}
+
+		if nonceSize == 0 {
+			break
+		}
+	}
+
+	return nonce, nil
+}
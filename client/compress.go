@@ -0,0 +1,142 @@
+package client
+
+import (
+	"bytes"
+	"compress/flate"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// fileCodecMagic prefixes the sealed per-file codec marker sent ahead of
+// the content, so downloads can tell which codec was applied. Files
+// uploaded without compression support carry no marker and are passed
+// through unchanged.
+const fileCodecMagic = "GKCODEC1"
+
+// Codecs recorded in the per-file marker.
+const (
+	codecNone = byte(iota)
+	codecFlate
+)
+
+// WithFileCompression makes the client flate-compress file content chunk
+// by chunk before encryption. The first chunk is sampled and compression
+// is skipped for the whole file when it does not shrink, so CPU is not
+// wasted on already-compressed or encrypted-looking data. The choice is
+// recorded per file in a sealed codec marker, which downloads honor
+// regardless of this option.
+func WithFileCompression() Option {
+	return func(o *options) {
+		o.compressFiles = true
+	}
+}
+
+// compressChunk flate-compresses one content chunk.
+func compressChunk(chunk []byte) ([]byte, error) {
+	var buf bytes.Buffer
+
+	fw, err := flate.NewWriter(&buf, flate.BestSpeed)
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot create compressor: %w", err)
+	}
+
+	if _, err := fw.Write(chunk); err != nil {
+		return nil, fmt.Errorf("cannot compress chunk: %w", err)
+	}
+
+	if err := fw.Close(); err != nil {
+		return nil, fmt.Errorf("cannot compress chunk: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// decompressChunk reverses compressChunk.
+func decompressChunk(chunk []byte) ([]byte, error) {
+	fr := flate.NewReader(bytes.NewReader(chunk))
+
+	data, err := io.ReadAll(fr)
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot decompress chunk: %w", err)
+	}
+
+	if err := fr.Close(); err != nil {
+		return nil, fmt.Errorf("cannot decompress chunk: %w", err)
+	}
+
+	return data, nil
+}
+
+// codecMarker builds the plaintext codec marker for the chosen codec.
+func codecMarker(codec byte) []byte {
+	return append([]byte(fileCodecMagic), codec)
+}
+
+// parseCodecMarker reports whether the opened chunk is a codec marker and
+// which codec it names.
+func parseCodecMarker(chunk []byte) (byte, bool) {
+	if len(chunk) != len(fileCodecMagic)+1 || !bytes.HasPrefix(chunk, []byte(fileCodecMagic)) {
+		return codecNone, false
+	}
+
+	return chunk[len(fileCodecMagic)], true
+}
+
+// sendFileContent reads r in chunkSize chunks, seals each one with the
+// nonce and hands it to send, pacing the loop when an upload rate limit
+// is set. When compression is enabled the first chunk is sampled: if
+// flate shrinks it, every chunk is compressed before sealing, and a
+// sealed codec marker recording the choice is sent ahead of the content.
+func (c *Client) sendFileContent(ctx context.Context, r io.Reader, chunkSize uint64, nonce []byte, send func([]byte) error) error {
+	buf := make([]byte, chunkSize)
+
+	first := true
+	codec := codecNone
+
+	for {
+		n, err := r.Read(buf)
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("cannot read file data: %w", err)
+		}
+
+		chunk := buf[:n]
+
+		if first && c.compressFiles {
+			first = false
+
+			if compressed, cErr := compressChunk(chunk); cErr == nil && len(compressed) < len(chunk) {
+				codec = codecFlate
+			}
+
+			if err := send(c.crypter.SealBytes(codecMarker(codec), nonce)); err != nil {
+				return fmt.Errorf("cannot send file data: %w", err)
+			}
+		}
+
+		if codec == codecFlate {
+			chunk, err = compressChunk(chunk)
+
+			if err != nil {
+				return fmt.Errorf("cannot compress file data: %w", err)
+			}
+		}
+
+		if err := c.uploadLimit.wait(ctx, len(chunk)); err != nil {
+			return fmt.Errorf("cannot wait for upload budget: %w", err)
+		}
+
+		if err := send(c.crypter.SealBytes(chunk, nonce)); err != nil {
+			return fmt.Errorf("cannot send file data: %w", err)
+		}
+	}
+
+	return nil
+}
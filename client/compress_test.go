@@ -0,0 +1,209 @@
+//go:build unit
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"errors"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	proto "github.com/Tomap-Tomap/GophKeeper/proto/gophkeeper/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// fileEchoTestServer stores the content frames of one uploaded file in
+// memory and replays them for GetFile, preserving frame boundaries like
+// the real server, so upload and download round-trip through real crypto.
+type fileEchoTestServer struct {
+	proto.UnimplementedGophKeeperServiceServer
+
+	mu           sync.Mutex
+	frames       [][]byte
+	chunkSizeErr error
+}
+
+func (s *fileEchoTestServer) GetChunkSize(_ context.Context, _ *proto.GetChunkSizeRequest) (*proto.GetChunkSizeResponse, error) {
+	if s.chunkSizeErr != nil {
+		return nil, s.chunkSizeErr
+	}
+
+	return &proto.GetChunkSizeResponse{Size: 1024}, nil
+}
+
+func (s *fileEchoTestServer) CreateFile(stream proto.GophKeeperService_CreateFileServer) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.frames = nil
+
+	for {
+		req, err := stream.Recv()
+
+		if errors.Is(err, io.EOF) {
+			return stream.SendAndClose(&proto.CreateFileResponse{Id: "testFileID"})
+		}
+		if err != nil {
+			return err
+		}
+
+		if content, ok := req.GetData().(*proto.CreateFileRequest_Content); ok {
+			s.frames = append(s.frames, content.Content)
+		}
+	}
+}
+
+func (s *fileEchoTestServer) GetFile(_ *proto.GetFileRequest, stream proto.GophKeeperService_GetFileServer) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	err := stream.Send(&proto.GetFileResponse{
+		Data: &proto.GetFileResponse_FileInfo{
+			FileInfo: &proto.File{Id: "testFileID"},
+		},
+	})
+
+	if err != nil {
+		return err
+	}
+
+	for _, frame := range s.frames {
+		err := stream.Send(&proto.GetFileResponse{
+			Data: &proto.GetFileResponse_Content{Content: frame},
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func startFileEchoTestServer(t *testing.T) (string, *fileEchoTestServer) {
+	t.Helper()
+
+	lis, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer()
+	echo := &fileEchoTestServer{}
+
+	proto.RegisterGophKeeperServiceServer(server, echo)
+
+	go func() {
+		_ = server.Serve(lis)
+	}()
+
+	t.Cleanup(server.Stop)
+
+	return lis.Addr().String(), echo
+}
+
+// uploadedCodec opens the codec marker frame of the captured upload.
+func uploadedCodec(t *testing.T, client *Client, echo *fileEchoTestServer) byte {
+	t.Helper()
+
+	echo.mu.Lock()
+	defer echo.mu.Unlock()
+
+	require.GreaterOrEqual(t, len(echo.frames), 2)
+
+	marker, err := client.crypter.OpenBytes(echo.frames[1], echo.frames[0])
+	require.NoError(t, err)
+
+	codec, ok := parseCodecMarker(marker)
+	require.True(t, ok)
+
+	return codec
+}
+
+func TestFileCompression(t *testing.T) {
+	compressible := bytes.Repeat([]byte("compressible text data,"), 100)
+
+	incompressible := make([]byte, 2048)
+	_, err := rand.Read(incompressible)
+	require.NoError(t, err)
+
+	roundTrip := func(t *testing.T, client *Client, echo *fileEchoTestServer, content []byte) []byte {
+		require.NoError(t, client.CreateFileFromReader(context.Background(), "testName", bytes.NewReader(content), "testMeta"))
+
+		dir := t.TempDir()
+		require.NoError(t, client.GetFile(context.Background(), "testFileID", dir))
+
+		got, err := os.ReadFile(filepath.Join(dir, "testFileID"))
+		require.NoError(t, err)
+
+		return got
+	}
+
+	t.Run("compressible input is compressed", func(t *testing.T) {
+		addr, echo := startFileEchoTestServer(t)
+
+		client, err := New(newOfflineTestCrypter(t), addr, WithFileCompression())
+		require.NoError(t, err)
+		defer client.Close()
+
+		got := roundTrip(t, client, echo, compressible)
+		require.Equal(t, compressible, got)
+		require.Equal(t, codecFlate, uploadedCodec(t, client, echo))
+	})
+
+	t.Run("incompressible input is passed through", func(t *testing.T) {
+		addr, echo := startFileEchoTestServer(t)
+
+		client, err := New(newOfflineTestCrypter(t), addr, WithFileCompression())
+		require.NoError(t, err)
+		defer client.Close()
+
+		got := roundTrip(t, client, echo, incompressible)
+		require.Equal(t, incompressible, got)
+		require.Equal(t, codecNone, uploadedCodec(t, client, echo))
+	})
+
+	t.Run("GetFileReader honors the codec marker", func(t *testing.T) {
+		addr, echo := startFileEchoTestServer(t)
+
+		client, err := New(newOfflineTestCrypter(t), addr, WithFileCompression())
+		require.NoError(t, err)
+		defer client.Close()
+
+		require.NoError(t, client.CreateFileFromReader(context.Background(), "testName", bytes.NewReader(compressible), "testMeta"))
+		require.Equal(t, codecFlate, uploadedCodec(t, client, echo))
+
+		r, err := client.GetFileReader(context.Background(), "testFileID")
+		require.NoError(t, err)
+		defer r.Close()
+
+		got, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.Equal(t, compressible, got)
+	})
+
+	t.Run("plain uploads stay marker-free", func(t *testing.T) {
+		addr, echo := startFileEchoTestServer(t)
+
+		client, err := New(newOfflineTestCrypter(t), addr)
+		require.NoError(t, err)
+		defer client.Close()
+
+		got := roundTrip(t, client, echo, compressible)
+		require.Equal(t, compressible, got)
+
+		echo.mu.Lock()
+		defer echo.mu.Unlock()
+
+		marker, err := client.crypter.OpenBytes(echo.frames[1], echo.frames[0])
+		require.NoError(t, err)
+
+		_, ok := parseCodecMarker(marker)
+		require.False(t, ok)
+	})
+}
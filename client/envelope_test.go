@@ -0,0 +1,80 @@
+//go:build unit
+
+package client
+
+import (
+	"testing"
+
+	"github.com/Tomap-Tomap/GophKeeper/crypto"
+	proto "github.com/Tomap-Tomap/GophKeeper/proto/gophkeeper/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvelopePasswordRoundTrip(t *testing.T) {
+	crypter, _, err := crypto.NewCrypter(32, t.TempDir())
+	require.NoError(t, err)
+
+	envelopeClient := &Client{crypter: crypter, envelope: true}
+
+	sealed, err := envelopeClient.sealPassword("testName", "testLogin", "testPassword", "testMeta")
+	require.NoError(t, err)
+	require.NotEmpty(t, sealed.WrappedKey)
+	require.NotEqual(t, "testName", sealed.Name)
+
+	protoPwd := &proto.Password{
+		Id:         "testID",
+		Name:       sealed.Name,
+		Login:      sealed.Login,
+		Password:   sealed.Password,
+		Meta:       sealed.Meta,
+		WrappedKey: sealed.WrappedKey,
+	}
+
+	opened, err := envelopeClient.openPassword(protoPwd)
+	require.NoError(t, err)
+	require.Equal(t, "testName", opened.Name)
+	require.Equal(t, "testLogin", opened.Login)
+	require.Equal(t, "testPassword", opened.Password)
+	require.Equal(t, "testMeta", opened.Meta)
+
+	t.Run("wrapped entries open without the envelope option", func(t *testing.T) {
+		plainClient := &Client{crypter: crypter}
+
+		opened, err := plainClient.openPassword(protoPwd)
+		require.NoError(t, err)
+		require.Equal(t, "testPassword", opened.Password)
+	})
+
+	t.Run("master-sealed entries open with the envelope option", func(t *testing.T) {
+		plainClient := &Client{crypter: crypter}
+
+		masterSealed, err := plainClient.sealPassword("testName", "testLogin", "testPassword", "testMeta")
+		require.NoError(t, err)
+		require.Empty(t, masterSealed.WrappedKey)
+
+		opened, err := envelopeClient.openPassword(&proto.Password{
+			Id:       "testID",
+			Name:     masterSealed.Name,
+			Login:    masterSealed.Login,
+			Password: masterSealed.Password,
+			Meta:     masterSealed.Meta,
+		})
+		require.NoError(t, err)
+		require.Equal(t, "testPassword", opened.Password)
+	})
+
+	t.Run("tampered wrapped key fails to open", func(t *testing.T) {
+		tampered := &proto.Password{
+			Id:         "testID",
+			Name:       sealed.Name,
+			Login:      sealed.Login,
+			Password:   sealed.Password,
+			Meta:       sealed.Meta,
+			WrappedKey: "#@!",
+		}
+
+		_, err := envelopeClient.openPassword(tampered)
+		require.ErrorIs(t, err, ErrDecryptFailed)
+		require.ErrorContains(t, err, "cannot unwrap data key")
+	})
+}
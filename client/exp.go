@@ -0,0 +1,27 @@
+package client
+
+import (
+	"fmt"
+	"time"
+)
+
+// expLayout is the canonical MM/YY expiry format stored on the server.
+const expLayout = "01/06"
+
+// expLayouts are the expiry formats accepted from user input. The numeric
+// month layouts also match zero-padded values, so MM/YY, M/YY, MM/YYYY and
+// M/YYYY all parse.
+var expLayouts = []string{"1/06", "1/2006"}
+
+// canonicalizeExp normalizes an expiry string to the MM/YY format before
+// sealing, so all stored cards are consistent regardless of how the input
+// was formatted.
+func canonicalizeExp(exp string) (string, error) {
+	for _, layout := range expLayouts {
+		if parsed, err := time.Parse(layout, exp); err == nil {
+			return parsed.Format(expLayout), nil
+		}
+	}
+
+	return "", fmt.Errorf("cannot parse exp %s: expected MM/YY or MM/YYYY", exp)
+}
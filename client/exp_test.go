@@ -0,0 +1,84 @@
+//go:build unit
+
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_canonicalizeExp(t *testing.T) {
+	tests := []struct {
+		name      string
+		exp       string
+		want      string
+		expectErr bool
+	}{
+		{
+			name: "MM/YY",
+			exp:  "01/30",
+			want: "01/30",
+		},
+		{
+			name: "M/YY",
+			exp:  "1/30",
+			want: "01/30",
+		},
+		{
+			name: "MM/YYYY",
+			exp:  "01/2030",
+			want: "01/30",
+		},
+		{
+			name: "M/YYYY",
+			exp:  "1/2030",
+			want: "01/30",
+		},
+		{
+			name:      "not a date",
+			exp:       "testExp",
+			expectErr: true,
+		},
+		{
+			name:      "month out of range",
+			exp:       "13/30",
+			expectErr: true,
+		},
+		{
+			name:      "missing year",
+			exp:       "01/",
+			expectErr: true,
+		},
+		{
+			name:      "empty",
+			exp:       "",
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := canonicalizeExp(tt.exp)
+
+			if tt.expectErr {
+				require.ErrorContains(t, err, "cannot parse exp")
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestWithRawExp(t *testing.T) {
+	client, err := New(new(CrypterMockedObject), "testAddr", WithRawExp())
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, client.Close())
+	}()
+
+	require.True(t, client.rawExp)
+}
@@ -0,0 +1,151 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/Tomap-Tomap/GophKeeper/storage"
+)
+
+// ExportBundle carries everything Export gathered: decrypted entries of
+// every kind plus the file blobs. FileContents maps file IDs to their
+// decrypted content; a metadata-only export leaves it nil and records the
+// exclusion, so a later import can warn that the blobs are missing
+// instead of silently restoring empty files.
+type ExportBundle struct {
+	Bundle
+
+	Files         []storage.File
+	FileContents  map[string][]byte
+	FilesExcluded bool
+}
+
+// ImportWarnings reports what a restore from this bundle cannot recover.
+// A metadata-only export lists every file entry whose content is absent.
+func (b *ExportBundle) ImportWarnings() []string {
+	if !b.FilesExcluded {
+		return nil
+	}
+
+	warnings := make([]string, 0, len(b.Files))
+
+	for _, file := range b.Files {
+		warnings = append(warnings, fmt.Sprintf("file %q was exported without content; restore the blob separately", file.Name))
+	}
+
+	return warnings
+}
+
+// ExportOption configures Export.
+type ExportOption func(*exportOptions)
+
+type exportOptions struct {
+	excludeFileContent bool
+}
+
+// WithoutFileContent makes Export keep only file metadata and skip
+// downloading the blobs, so the bundle stays small enough for a quick
+// backup. The exclusion is recorded on the bundle and surfaces through
+// ImportWarnings.
+func WithoutFileContent() ExportOption {
+	return func(o *exportOptions) {
+		o.excludeFileContent = true
+	}
+}
+
+// Export gathers all of the user's entries into a bundle for backup.
+// File blobs are downloaded and decrypted unless WithoutFileContent is
+// given.
+func (c *Client) Export(ctx context.Context, opts ...ExportOption) (*ExportBundle, error) {
+	o := &exportOptions{}
+
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	pwds, err := c.GetAllPasswords(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot get passwords: %w", err)
+	}
+
+	banks, err := c.GetAllBanks(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot get banks: %w", err)
+	}
+
+	texts, err := c.GetAllTexts(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot get texts: %w", err)
+	}
+
+	files, err := c.GetAllFiles(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot get files: %w", err)
+	}
+
+	bundle := &ExportBundle{
+		Bundle: Bundle{
+			Passwords: pwds,
+			Banks:     banks,
+			Texts:     texts,
+		},
+		Files: files,
+	}
+
+	if o.excludeFileContent {
+		bundle.FilesExcluded = true
+
+		return bundle, nil
+	}
+
+	contents, err := c.downloadFileContents(ctx, files)
+
+	if err != nil {
+		return nil, err
+	}
+
+	bundle.FileContents = contents
+
+	return bundle, nil
+}
+
+// downloadFileContents fetches and decrypts every file blob through the
+// regular GetFile path, which writes each one under its ID in a temporary
+// directory removed afterwards.
+func (c *Client) downloadFileContents(ctx context.Context, files []storage.File) (contents map[string][]byte, err error) {
+	dir, err := os.MkdirTemp("", "gophkeeper-export")
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot create export directory: %w", err)
+	}
+
+	defer func() {
+		if rmErr := os.RemoveAll(dir); rmErr != nil && err == nil {
+			err = fmt.Errorf("cannot remove export directory: %w", rmErr)
+		}
+	}()
+
+	contents = make(map[string][]byte, len(files))
+
+	for _, file := range files {
+		if err := c.GetFile(ctx, file.ID, dir); err != nil {
+			return nil, fmt.Errorf("cannot download file %s: %w", file.ID, err)
+		}
+
+		content, err := os.ReadFile(filepath.Join(dir, file.ID))
+
+		if err != nil {
+			return nil, fmt.Errorf("cannot read downloaded file %s: %w", file.ID, err)
+		}
+
+		contents[file.ID] = content
+	}
+
+	return contents, nil
+}
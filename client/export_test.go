@@ -0,0 +1,56 @@
+//go:build unit
+
+package client
+
+import (
+	"context"
+
+	proto "github.com/Tomap-Tomap/GophKeeper/proto/gophkeeper/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (suite *ClientTestSuite) TestExportWithoutFileContent() {
+	require := suite.Require()
+
+	suite.Run("service error", func() {
+		suite.serverMock.onGetPasswords(nil, errTest)
+
+		bundle, err := suite.client.Export(context.Background(), WithoutFileContent())
+		require.ErrorContains(err, "cannot get passwords")
+		require.Nil(bundle)
+	})
+
+	suite.Run("metadata-only export keeps file entries without blobs", func() {
+		suite.serverMock.onGetPasswords(&proto.GetPasswordsResponse{}, nil)
+		suite.serverMock.onGetBanks(&proto.GetBanksResponse{}, nil)
+		suite.serverMock.onGetTexts(&proto.GetTextsResponse{}, nil)
+		suite.serverMock.onGetFiles(&proto.GetFilesResponse{
+			FileInfo: []*proto.File{
+				{
+					Id:       suite.testFileID,
+					Name:     suite.testName,
+					Meta:     suite.testMeta,
+					UpdateAt: timestamppb.New(suite.testUpdateAt),
+				},
+			},
+		}, nil)
+
+		suite.crypterMock.onOpenStringWithoutNonce(suite.testName, suite.testName, nil)
+		suite.crypterMock.onOpenStringWithoutNonce(suite.testMeta, suite.testMeta, nil)
+
+		bundle, err := suite.client.Export(context.Background(), WithoutFileContent())
+		require.NoError(err)
+
+		require.Len(bundle.Files, 1)
+		require.Equal(suite.testFileID, bundle.Files[0].ID)
+		require.Nil(bundle.FileContents)
+		require.True(bundle.FilesExcluded)
+		require.Empty(bundle.Passwords)
+		require.Empty(bundle.Banks)
+		require.Empty(bundle.Texts)
+
+		warnings := bundle.ImportWarnings()
+		require.Len(warnings, 1)
+		require.Contains(warnings[0], suite.testName)
+	})
+}
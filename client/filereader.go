@@ -0,0 +1,151 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/Tomap-Tomap/GophKeeper/crypto"
+	proto "github.com/Tomap-Tomap/GophKeeper/proto/gophkeeper/v1"
+)
+
+// fileReader lazily pulls encrypted chunks from a GetFile stream and serves
+// the decrypted content through io.ReadCloser. The nonce prefix of the first
+// chunks is consumed internally before any content is returned.
+type fileReader struct {
+	crypter Crypter
+	stream  proto.GophKeeperService_GetFileClient
+	cancel  context.CancelFunc
+
+	nonce     []byte
+	nonceSize int
+	first     bool
+	codec     byte
+	buf       []byte
+	err       error
+}
+
+// GetFileReader retrieves a file by its ID and returns a reader that lazily
+// pulls and decrypts chunks from the stream, so callers can consume the
+// content without a file on disk. Closing the reader releases the stream.
+func (c *Client) GetFileReader(ctx context.Context, id string) (io.ReadCloser, error) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	stream, err := c.grpc.GetFile(ctx, &proto.GetFileRequest{
+		Id: id,
+	})
+
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("cannot get file stream: %w", err)
+	}
+
+	_, err = stream.Recv()
+
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("cannot get file info: %w", err)
+	}
+
+	return &fileReader{
+		crypter:   c.crypter,
+		stream:    stream,
+		cancel:    cancel,
+		nonceSize: c.crypter.NonceSize(),
+		first:     true,
+	}, nil
+}
+
+// Read fills p with decrypted content, pulling the next chunk from the
+// stream once the buffered one is exhausted. Returns io.EOF after the last
+// chunk has been consumed.
+func (fr *fileReader) Read(p []byte) (int, error) {
+	for len(fr.buf) == 0 {
+		if fr.err != nil {
+			return 0, fr.err
+		}
+
+		fr.pullChunk()
+	}
+
+	n := copy(p, fr.buf)
+	fr.buf = fr.buf[n:]
+
+	return n, nil
+}
+
+// Close releases the underlying stream. Subsequent reads fail.
+func (fr *fileReader) Close() error {
+	fr.cancel()
+
+	if fr.err == nil || errors.Is(fr.err, io.EOF) {
+		fr.err = errors.New("file reader is closed")
+	}
+
+	return nil
+}
+
+// pullChunk receives the next chunk from the stream and decrypts it into the
+// buffer, consuming the nonce prefix first and honoring the codec marker
+// when the file was uploaded with compression. Failures are kept in fr.err
+// for the next Read call.
+func (fr *fileReader) pullChunk() {
+	res, err := fr.stream.Recv()
+
+	if errors.Is(err, io.EOF) {
+		fr.err = io.EOF
+		return
+	}
+	if err != nil {
+		fr.err = fmt.Errorf("cannot get content: %w", err)
+		return
+	}
+
+	content := res.GetContent()
+
+	if fr.nonceSize != 0 {
+		n, rest, lastNonceSize, err := fr.crypter.GetNonceFromBytes(content, fr.nonceSize, crypto.AtFront)
+
+		if err != nil {
+			fr.err = fmt.Errorf("cannot get nonce: %w", err)
+			return
+		}
+
+		fr.nonce = append(fr.nonce, n...)
+		fr.nonceSize = lastNonceSize
+
+		if rest == nil {
+			return
+		}
+
+		content = rest
+	}
+
+	openData, err := fr.crypter.OpenBytes(content, fr.nonce)
+
+	if err != nil {
+		fr.err = fmt.Errorf("cannot open content: %w: %w", ErrDecryptFailed, err)
+		return
+	}
+
+	if fr.first {
+		fr.first = false
+
+		if markerCodec, ok := parseCodecMarker(openData); ok {
+			fr.codec = markerCodec
+			return
+		}
+	}
+
+	if fr.codec == codecFlate {
+		openData, err = decompressChunk(openData)
+
+		if err != nil {
+			fr.err = fmt.Errorf("cannot decompress content: %w", err)
+			return
+		}
+	}
+
+	fr.buf = openData
+}
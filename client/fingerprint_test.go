@@ -0,0 +1,86 @@
+//go:build unit
+
+package client
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	proto "github.com/Tomap-Tomap/GophKeeper/proto/gophkeeper/v1"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPasswordFingerprints(t *testing.T) {
+	crypter := newOfflineTestCrypter(t)
+	addr, serverMock := startOfflineTestServer(t)
+	defer serverMock.AssertExpectations(t)
+
+	secret := []byte("testFingerprintSecret")
+
+	client, err := New(crypter, addr, WithPasswordFingerprints(secret))
+	require.NoError(t, err)
+	defer client.Close()
+
+	var created []*proto.CreatePasswordRequest
+
+	serverMock.On("CreatePassword", mock.Anything).Return(&proto.CreatePasswordResponse{Id: "testPasswordID"}, nil).Run(func(args mock.Arguments) {
+		created = append(created, args.Get(0).(*proto.CreatePasswordRequest))
+	})
+
+	require.NoError(t, client.CreatePassword(context.Background(), "testName1", "testLogin1", "testPassword", "testMeta1"))
+	require.NoError(t, client.CreatePassword(context.Background(), "testName2", "testLogin2", "testPassword", "testMeta2"))
+	require.NoError(t, client.CreatePassword(context.Background(), "testName3", "testLogin3", "otherPassword", "testMeta3"))
+	require.Len(t, created, 3)
+
+	t.Run("fingerprint is the keyed hash of the plaintext", func(t *testing.T) {
+		mac := hmac.New(sha256.New, secret)
+		mac.Write([]byte("testPassword"))
+
+		require.Equal(t, hex.EncodeToString(mac.Sum(nil)), created[0].GetFingerprint())
+	})
+
+	t.Run("same password yields the same fingerprint", func(t *testing.T) {
+		require.Equal(t, created[0].GetFingerprint(), created[1].GetFingerprint())
+		require.NotEqual(t, created[0].GetFingerprint(), created[2].GetFingerprint())
+	})
+
+	t.Run("no fingerprint without the option", func(t *testing.T) {
+		plainClient, err := New(crypter, addr)
+		require.NoError(t, err)
+
+		defer plainClient.Close()
+
+		require.NoError(t, plainClient.CreatePassword(context.Background(), "testName4", "testLogin4", "testPassword", "testMeta4"))
+		require.Len(t, created, 4)
+		require.Empty(t, created[3].GetFingerprint())
+	})
+
+	t.Run("reused groups are returned as id lists", func(t *testing.T) {
+		serverMock.onGetReusedPasswords(&proto.GetReusedPasswordsResponse{
+			Reused: []*proto.ReusedPasswords{
+				{
+					Fingerprint: created[0].GetFingerprint(),
+					Ids:         []string{"testPasswordID1", "testPasswordID2"},
+				},
+			},
+		}, nil)
+
+		groups, err := client.GetReusedPasswords(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, [][]string{{"testPasswordID1", "testPasswordID2"}}, groups)
+	})
+
+	t.Run("get reused passwords error", func(t *testing.T) {
+		offlineClient, err := New(crypter, unreachableAddr)
+		require.NoError(t, err)
+
+		defer offlineClient.Close()
+
+		_, err = offlineClient.GetReusedPasswords(context.Background())
+		require.ErrorContains(t, err, "cannot get reused passwords")
+	})
+}
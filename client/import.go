@@ -0,0 +1,313 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Tomap-Tomap/GophKeeper/storage"
+)
+
+// Bundle groups decrypted entries prepared for a bulk import.
+type Bundle struct {
+	Passwords []storage.Password
+	Banks     []storage.Bank
+	Texts     []storage.Text
+}
+
+// ImportDiff categorizes bundle entries against the current server state.
+// Entries are matched by name, then compared by decrypted content.
+type ImportDiff struct {
+	New       Bundle
+	Changed   Bundle
+	Identical Bundle
+}
+
+// DiffImport fetches the current entries and categorizes the bundle into new,
+// changed and identical entries without mutating anything. It lets a caller
+// confirm what an import would do before running it.
+func (c *Client) DiffImport(ctx context.Context, bundle Bundle) (*ImportDiff, error) {
+	pwds, err := c.GetAllPasswords(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot get passwords: %w", err)
+	}
+
+	banks, err := c.GetAllBanks(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot get banks: %w", err)
+	}
+
+	texts, err := c.GetAllTexts(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot get texts: %w", err)
+	}
+
+	diff := &ImportDiff{}
+
+	pwdByName := make(map[string]storage.Password, len(pwds))
+
+	for _, v := range pwds {
+		pwdByName[v.Name] = v
+	}
+
+	for _, v := range bundle.Passwords {
+		cur, ok := pwdByName[v.Name]
+
+		switch {
+		case !ok:
+			diff.New.Passwords = append(diff.New.Passwords, v)
+		case samePassword(cur, v):
+			diff.Identical.Passwords = append(diff.Identical.Passwords, v)
+		default:
+			diff.Changed.Passwords = append(diff.Changed.Passwords, v)
+		}
+	}
+
+	bankByName := make(map[string]storage.Bank, len(banks))
+
+	for _, v := range banks {
+		bankByName[v.Name] = v
+	}
+
+	for _, v := range bundle.Banks {
+		cur, ok := bankByName[v.Name]
+
+		switch {
+		case !ok:
+			diff.New.Banks = append(diff.New.Banks, v)
+		case sameBank(cur, v):
+			diff.Identical.Banks = append(diff.Identical.Banks, v)
+		default:
+			diff.Changed.Banks = append(diff.Changed.Banks, v)
+		}
+	}
+
+	textByName := make(map[string]storage.Text, len(texts))
+
+	for _, v := range texts {
+		textByName[v.Name] = v
+	}
+
+	for _, v := range bundle.Texts {
+		cur, ok := textByName[v.Name]
+
+		switch {
+		case !ok:
+			diff.New.Texts = append(diff.New.Texts, v)
+		case sameText(cur, v):
+			diff.Identical.Texts = append(diff.Identical.Texts, v)
+		default:
+			diff.Changed.Texts = append(diff.Changed.Texts, v)
+		}
+	}
+
+	return diff, nil
+}
+
+// ConflictStrategy selects how Import resolves a bundle entry whose name
+// already exists in the vault with different decrypted content.
+type ConflictStrategy int
+
+const (
+	// ConflictSuffix imports the conflicting entry under its name plus
+	// importSuffix, keeping the existing entry untouched.
+	ConflictSuffix ConflictStrategy = iota
+	// ConflictSkip keeps the existing entry and drops the bundle one.
+	ConflictSkip
+	// ConflictOverwrite replaces the existing entry's content in place.
+	ConflictOverwrite
+	// ConflictKeepBoth imports the conflicting entry under its original
+	// name, leaving two entries with the same name.
+	ConflictKeepBoth
+)
+
+// importSuffix marks entries renamed by the ConflictSuffix strategy.
+const importSuffix = " (imported)"
+
+// ImportSummary counts the actions Import took per bundle entry.
+type ImportSummary struct {
+	// Created counts entries imported as new, including renamed and
+	// kept-both ones.
+	Created int
+	// Updated counts existing entries overwritten with bundle content.
+	Updated int
+	// Skipped counts conflicting entries dropped by ConflictSkip.
+	Skipped int
+	// Identical counts bundle entries whose decrypted content already
+	// matches the vault; they are never re-imported.
+	Identical int
+}
+
+// Import writes the bundle into the vault. Entries whose name is free are
+// created, entries identical to the stored one (compared by decrypted
+// content) are left alone, and the remaining name conflicts are resolved
+// by the chosen strategy.
+func (c *Client) Import(ctx context.Context, bundle Bundle, strategy ConflictStrategy) (*ImportSummary, error) {
+	pwds, err := c.GetAllPasswords(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot get passwords: %w", err)
+	}
+
+	banks, err := c.GetAllBanks(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot get banks: %w", err)
+	}
+
+	texts, err := c.GetAllTexts(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot get texts: %w", err)
+	}
+
+	summary := &ImportSummary{}
+
+	pwdByName := make(map[string]storage.Password, len(pwds))
+
+	for _, v := range pwds {
+		pwdByName[v.Name] = v
+	}
+
+	for _, v := range bundle.Passwords {
+		cur, ok := pwdByName[v.Name]
+
+		switch {
+		case !ok:
+			if err := c.CreatePassword(ctx, v.Name, v.Login, v.Password, v.Meta); err != nil {
+				return nil, fmt.Errorf("cannot import password %s: %w", v.Name, err)
+			}
+
+			summary.Created++
+		case samePassword(cur, v):
+			summary.Identical++
+		default:
+			switch strategy {
+			case ConflictSkip:
+				summary.Skipped++
+			case ConflictOverwrite:
+				if err := c.UpdatePassword(ctx, cur.ID, v.Name, v.Login, v.Password, v.Meta); err != nil {
+					return nil, fmt.Errorf("cannot import password %s: %w", v.Name, err)
+				}
+
+				summary.Updated++
+			case ConflictKeepBoth:
+				if err := c.CreatePassword(ctx, v.Name, v.Login, v.Password, v.Meta); err != nil {
+					return nil, fmt.Errorf("cannot import password %s: %w", v.Name, err)
+				}
+
+				summary.Created++
+			default:
+				if err := c.CreatePassword(ctx, v.Name+importSuffix, v.Login, v.Password, v.Meta); err != nil {
+					return nil, fmt.Errorf("cannot import password %s: %w", v.Name, err)
+				}
+
+				summary.Created++
+			}
+		}
+	}
+
+	bankByName := make(map[string]storage.Bank, len(banks))
+
+	for _, v := range banks {
+		bankByName[v.Name] = v
+	}
+
+	for _, v := range bundle.Banks {
+		cur, ok := bankByName[v.Name]
+
+		switch {
+		case !ok:
+			if err := c.CreateBank(ctx, v.Name, v.CardNumber, v.CVC, v.Owner, v.Exp, v.Meta); err != nil {
+				return nil, fmt.Errorf("cannot import bank %s: %w", v.Name, err)
+			}
+
+			summary.Created++
+		case sameBank(cur, v):
+			summary.Identical++
+		default:
+			switch strategy {
+			case ConflictSkip:
+				summary.Skipped++
+			case ConflictOverwrite:
+				if err := c.UpdateBank(ctx, cur.ID, v.Name, v.CardNumber, v.CVC, v.Owner, v.Exp, v.Meta); err != nil {
+					return nil, fmt.Errorf("cannot import bank %s: %w", v.Name, err)
+				}
+
+				summary.Updated++
+			case ConflictKeepBoth:
+				if err := c.CreateBank(ctx, v.Name, v.CardNumber, v.CVC, v.Owner, v.Exp, v.Meta); err != nil {
+					return nil, fmt.Errorf("cannot import bank %s: %w", v.Name, err)
+				}
+
+				summary.Created++
+			default:
+				if err := c.CreateBank(ctx, v.Name+importSuffix, v.CardNumber, v.CVC, v.Owner, v.Exp, v.Meta); err != nil {
+					return nil, fmt.Errorf("cannot import bank %s: %w", v.Name, err)
+				}
+
+				summary.Created++
+			}
+		}
+	}
+
+	textByName := make(map[string]storage.Text, len(texts))
+
+	for _, v := range texts {
+		textByName[v.Name] = v
+	}
+
+	for _, v := range bundle.Texts {
+		cur, ok := textByName[v.Name]
+
+		switch {
+		case !ok:
+			if err := c.CreateText(ctx, v.Name, v.Text, v.Meta); err != nil {
+				return nil, fmt.Errorf("cannot import text %s: %w", v.Name, err)
+			}
+
+			summary.Created++
+		case sameText(cur, v):
+			summary.Identical++
+		default:
+			switch strategy {
+			case ConflictSkip:
+				summary.Skipped++
+			case ConflictOverwrite:
+				if err := c.UpdateText(ctx, cur.ID, v.Name, v.Text, v.Meta); err != nil {
+					return nil, fmt.Errorf("cannot import text %s: %w", v.Name, err)
+				}
+
+				summary.Updated++
+			case ConflictKeepBoth:
+				if err := c.CreateText(ctx, v.Name, v.Text, v.Meta); err != nil {
+					return nil, fmt.Errorf("cannot import text %s: %w", v.Name, err)
+				}
+
+				summary.Created++
+			default:
+				if err := c.CreateText(ctx, v.Name+importSuffix, v.Text, v.Meta); err != nil {
+					return nil, fmt.Errorf("cannot import text %s: %w", v.Name, err)
+				}
+
+				summary.Created++
+			}
+		}
+	}
+
+	return summary, nil
+}
+
+func samePassword(a, b storage.Password) bool {
+	return a.Login == b.Login && a.Password == b.Password && a.Meta == b.Meta
+}
+
+func sameBank(a, b storage.Bank) bool {
+	return a.CardNumber == b.CardNumber && a.CVC == b.CVC && a.Owner == b.Owner && a.Exp == b.Exp && a.Meta == b.Meta
+}
+
+func sameText(a, b storage.Text) bool {
+	return a.Text == b.Text && a.Meta == b.Meta
+}
@@ -0,0 +1,242 @@
+//go:build unit
+
+package client
+
+import (
+	"context"
+
+	proto "github.com/Tomap-Tomap/GophKeeper/proto/gophkeeper/v1"
+	"github.com/Tomap-Tomap/GophKeeper/storage"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (suite *ClientTestSuite) TestDiffImport() {
+	require := suite.Require()
+
+	sameName := "sameName"
+	changedName := "changedName"
+	newName := "newName"
+	oldPassword := "oldPassword"
+	newPassword := "newPassword"
+
+	pwdsRes := &proto.GetPasswordsResponse{
+		Passwords: []*proto.Password{
+			{
+				Id:       suite.testPasswordID,
+				Name:     sameName,
+				Login:    suite.testLogin,
+				Password: suite.testPassword,
+				Meta:     suite.testMeta,
+				UpdateAt: timestamppb.New(suite.testUpdateAt),
+			},
+			{
+				Id:       suite.testPasswordID,
+				Name:     changedName,
+				Login:    suite.testLogin,
+				Password: oldPassword,
+				Meta:     suite.testMeta,
+				UpdateAt: timestamppb.New(suite.testUpdateAt),
+			},
+		},
+	}
+
+	bundle := Bundle{
+		Passwords: []storage.Password{
+			{
+				Name:     sameName,
+				Login:    suite.testLogin,
+				Password: suite.testPassword,
+				Meta:     suite.testMeta,
+			},
+			{
+				Name:     changedName,
+				Login:    suite.testLogin,
+				Password: newPassword,
+				Meta:     suite.testMeta,
+			},
+			{
+				Name:     newName,
+				Login:    suite.testLogin,
+				Password: suite.testPassword,
+				Meta:     suite.testMeta,
+			},
+		},
+	}
+
+	suite.Run("service error", func() {
+		suite.serverMock.onGetPasswords(nil, errTest)
+
+		diff, err := suite.client.DiffImport(context.Background(), bundle)
+		require.ErrorContains(err, "cannot get passwords")
+		require.Nil(diff)
+	})
+
+	suite.Run("positive test", func() {
+		suite.serverMock.onGetPasswords(pwdsRes, nil)
+		suite.serverMock.onGetBanks(&proto.GetBanksResponse{}, nil)
+		suite.serverMock.onGetTexts(&proto.GetTextsResponse{}, nil)
+
+		suite.crypterMock.onOpenStringWithoutNonce(sameName, sameName, nil)
+		suite.crypterMock.onOpenStringWithoutNonce(changedName, changedName, nil)
+		suite.crypterMock.onOpenStringWithoutNonce(suite.testLogin, suite.testLogin, nil)
+		suite.crypterMock.onOpenStringWithoutNonce(suite.testPassword, suite.testPassword, nil)
+		suite.crypterMock.onOpenStringWithoutNonce(oldPassword, oldPassword, nil)
+		suite.crypterMock.onOpenStringWithoutNonce(suite.testMeta, suite.testMeta, nil)
+
+		diff, err := suite.client.DiffImport(context.Background(), bundle)
+		require.NoError(err)
+
+		require.Equal(bundle.Passwords[0:1], diff.Identical.Passwords)
+		require.Equal(bundle.Passwords[1:2], diff.Changed.Passwords)
+		require.Equal(bundle.Passwords[2:3], diff.New.Passwords)
+		require.Empty(diff.New.Banks)
+		require.Empty(diff.New.Texts)
+	})
+}
+
+func (suite *ClientTestSuite) TestImportStrategies() {
+	require := suite.Require()
+
+	sameName := "sameName"
+	conflictName := "conflictName"
+	newName := "newName"
+	oldPassword := "oldPassword"
+	newPassword := "newPassword"
+
+	pwdsRes := &proto.GetPasswordsResponse{
+		Passwords: []*proto.Password{
+			{
+				Id:       suite.testPasswordID,
+				Name:     sameName,
+				Login:    suite.testLogin,
+				Password: suite.testPassword,
+				Meta:     suite.testMeta,
+				UpdateAt: timestamppb.New(suite.testUpdateAt),
+			},
+			{
+				Id:       suite.testPasswordID,
+				Name:     conflictName,
+				Login:    suite.testLogin,
+				Password: oldPassword,
+				Meta:     suite.testMeta,
+				UpdateAt: timestamppb.New(suite.testUpdateAt),
+			},
+		},
+	}
+
+	bundle := Bundle{
+		Passwords: []storage.Password{
+			{
+				Name:     sameName,
+				Login:    suite.testLogin,
+				Password: suite.testPassword,
+				Meta:     suite.testMeta,
+			},
+			{
+				Name:     conflictName,
+				Login:    suite.testLogin,
+				Password: newPassword,
+				Meta:     suite.testMeta,
+			},
+			{
+				Name:     newName,
+				Login:    suite.testLogin,
+				Password: suite.testPassword,
+				Meta:     suite.testMeta,
+			},
+		},
+	}
+
+	setupVault := func() {
+		suite.serverMock.onGetPasswords(pwdsRes, nil)
+		suite.serverMock.onGetBanks(&proto.GetBanksResponse{}, nil)
+		suite.serverMock.onGetTexts(&proto.GetTextsResponse{}, nil)
+
+		suite.crypterMock.onOpenStringWithoutNonce(sameName, sameName, nil)
+		suite.crypterMock.onOpenStringWithoutNonce(conflictName, conflictName, nil)
+		suite.crypterMock.onOpenStringWithoutNonce(suite.testLogin, suite.testLogin, nil)
+		suite.crypterMock.onOpenStringWithoutNonce(suite.testPassword, suite.testPassword, nil)
+		suite.crypterMock.onOpenStringWithoutNonce(oldPassword, oldPassword, nil)
+		suite.crypterMock.onOpenStringWithoutNonce(suite.testMeta, suite.testMeta, nil)
+
+		suite.crypterMock.onSealStringWithoutNonce(newName, newName, nil)
+		suite.crypterMock.onSealStringWithoutNonce(suite.testLogin, suite.testLogin, nil)
+		suite.crypterMock.onSealStringWithoutNonce(suite.testPassword, suite.testPassword, nil)
+		suite.crypterMock.onSealStringWithoutNonce(suite.testMeta, suite.testMeta, nil)
+
+		suite.serverMock.onCreatePassword(&proto.CreatePasswordRequest{
+			Name:     newName,
+			Login:    suite.testLogin,
+			Password: suite.testPassword,
+			Meta:     suite.testMeta,
+		}, &proto.CreatePasswordResponse{}, nil)
+	}
+
+	suite.Run("service error", func() {
+		suite.serverMock.onGetPasswords(nil, errTest)
+
+		summary, err := suite.client.Import(context.Background(), bundle, ConflictSkip)
+		require.ErrorContains(err, "cannot get passwords")
+		require.Nil(summary)
+	})
+
+	suite.Run("skip keeps the existing entry", func() {
+		setupVault()
+
+		summary, err := suite.client.Import(context.Background(), bundle, ConflictSkip)
+		require.NoError(err)
+		require.Equal(&ImportSummary{Created: 1, Skipped: 1, Identical: 1}, summary)
+	})
+
+	suite.Run("overwrite updates the existing entry", func() {
+		setupVault()
+
+		suite.crypterMock.onSealStringWithoutNonce(conflictName, conflictName, nil)
+		suite.crypterMock.onSealStringWithoutNonce(newPassword, newPassword, nil)
+		suite.serverMock.onUpdatePassword(&proto.UpdatePasswordRequest{
+			Id:       suite.testPasswordID,
+			Name:     conflictName,
+			Login:    suite.testLogin,
+			Password: newPassword,
+			Meta:     suite.testMeta,
+		}, &proto.UpdatePasswordResponse{}, nil)
+
+		summary, err := suite.client.Import(context.Background(), bundle, ConflictOverwrite)
+		require.NoError(err)
+		require.Equal(&ImportSummary{Created: 1, Updated: 1, Identical: 1}, summary)
+	})
+
+	suite.Run("keep both imports under the same name", func() {
+		setupVault()
+
+		suite.crypterMock.onSealStringWithoutNonce(conflictName, conflictName, nil)
+		suite.crypterMock.onSealStringWithoutNonce(newPassword, newPassword, nil)
+		suite.serverMock.onCreatePassword(&proto.CreatePasswordRequest{
+			Name:     conflictName,
+			Login:    suite.testLogin,
+			Password: newPassword,
+			Meta:     suite.testMeta,
+		}, &proto.CreatePasswordResponse{}, nil)
+
+		summary, err := suite.client.Import(context.Background(), bundle, ConflictKeepBoth)
+		require.NoError(err)
+		require.Equal(&ImportSummary{Created: 2, Identical: 1}, summary)
+	})
+
+	suite.Run("suffix renames the imported entry", func() {
+		setupVault()
+
+		suite.crypterMock.onSealStringWithoutNonce(conflictName+importSuffix, conflictName+importSuffix, nil)
+		suite.crypterMock.onSealStringWithoutNonce(newPassword, newPassword, nil)
+		suite.serverMock.onCreatePassword(&proto.CreatePasswordRequest{
+			Name:     conflictName + importSuffix,
+			Login:    suite.testLogin,
+			Password: newPassword,
+			Meta:     suite.testMeta,
+		}, &proto.CreatePasswordResponse{}, nil)
+
+		summary, err := suite.client.Import(context.Background(), bundle, ConflictSuffix)
+		require.NoError(err)
+		require.Equal(&ImportSummary{Created: 2, Identical: 1}, summary)
+	})
+}
@@ -0,0 +1,55 @@
+package client
+
+import (
+	"fmt"
+	"slices"
+)
+
+// SealStructuredMeta seals the values of a structured metadata map for the
+// JSONB meta_json column. Keys stay in plaintext; values of keys listed in
+// plaintextKeys are designated non-sensitive and also stay in plaintext, so
+// the server can filter on them.
+func (c *Client) SealStructuredMeta(meta map[string]string, plaintextKeys ...string) (map[string]string, error) {
+	sealed := make(map[string]string, len(meta))
+
+	for k, v := range meta {
+		if slices.Contains(plaintextKeys, k) {
+			sealed[k] = v
+			continue
+		}
+
+		sv, err := c.crypter.SealStringWithoutNonce(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("cannot seal meta value %s: %w", k, err)
+		}
+
+		sealed[k] = sv
+	}
+
+	return sealed, nil
+}
+
+// OpenStructuredMeta opens the values of a structured metadata map sealed
+// by SealStructuredMeta. The same plaintextKeys must be designated as on
+// sealing; their values are returned as is.
+func (c *Client) OpenStructuredMeta(meta map[string]string, plaintextKeys ...string) (map[string]string, error) {
+	opened := make(map[string]string, len(meta))
+
+	for k, v := range meta {
+		if slices.Contains(plaintextKeys, k) {
+			opened[k] = v
+			continue
+		}
+
+		ov, err := c.crypter.OpenStringWithoutNonce(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("cannot open meta value %s: %w", k, err)
+		}
+
+		opened[k] = ov
+	}
+
+	return opened, nil
+}
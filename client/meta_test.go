@@ -0,0 +1,59 @@
+//go:build unit
+
+package client
+
+func (suite *ClientTestSuite) TestSealStructuredMeta() {
+	require := suite.Require()
+
+	meta := map[string]string{
+		"url":   "https://example.com",
+		"notes": "secret notes",
+	}
+
+	suite.Run("cannot seal meta value", func() {
+		suite.crypterMock.onSealStringWithoutNonce("secret notes", "", errTest)
+
+		sealed, err := suite.client.SealStructuredMeta(meta, "url")
+		require.ErrorContains(err, "cannot seal meta value notes")
+		suite.Nil(sealed)
+	})
+
+	suite.Run("positive test", func() {
+		suite.crypterMock.onSealStringWithoutNonce("secret notes", "sealedNotes", nil)
+
+		sealed, err := suite.client.SealStructuredMeta(meta, "url")
+		require.NoError(err)
+		suite.Equal(map[string]string{
+			"url":   "https://example.com",
+			"notes": "sealedNotes",
+		}, sealed)
+	})
+}
+
+func (suite *ClientTestSuite) TestOpenStructuredMeta() {
+	require := suite.Require()
+
+	meta := map[string]string{
+		"url":   "https://example.com",
+		"notes": "sealedNotes",
+	}
+
+	suite.Run("cannot open meta value", func() {
+		suite.crypterMock.onOpenStringWithoutNonce("sealedNotes", "", errTest)
+
+		opened, err := suite.client.OpenStructuredMeta(meta, "url")
+		require.ErrorContains(err, "cannot open meta value notes")
+		suite.Nil(opened)
+	})
+
+	suite.Run("positive test", func() {
+		suite.crypterMock.onOpenStringWithoutNonce("sealedNotes", "secret notes", nil)
+
+		opened, err := suite.client.OpenStructuredMeta(meta, "url")
+		require.NoError(err)
+		suite.Equal(map[string]string{
+			"url":   "https://example.com",
+			"notes": "secret notes",
+		}, opened)
+	})
+}
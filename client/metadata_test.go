@@ -0,0 +1,92 @@
+//go:build unit
+
+package client
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+
+	proto "github.com/Tomap-Tomap/GophKeeper/proto/gophkeeper/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// metadataTestServer records the incoming metadata of every GetFiles
+// call, so tests can assert what the client attached.
+type metadataTestServer struct {
+	proto.UnimplementedGophKeeperServiceServer
+
+	mu sync.Mutex
+	md metadata.MD
+}
+
+func (s *metadataTestServer) GetFiles(ctx context.Context, _ *proto.GetFilesRequest) (*proto.GetFilesResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.md, _ = metadata.FromIncomingContext(ctx)
+
+	return &proto.GetFilesResponse{}, nil
+}
+
+func startMetadataTestServer(t *testing.T) (string, *metadataTestServer) {
+	t.Helper()
+
+	lis, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer()
+	capture := &metadataTestServer{}
+
+	proto.RegisterGophKeeperServiceServer(server, capture)
+
+	go func() {
+		_ = server.Serve(lis)
+	}()
+
+	t.Cleanup(server.Stop)
+
+	return lis.Addr().String(), capture
+}
+
+func TestWithMetadata(t *testing.T) {
+	t.Run("static metadata reaches the server", func(t *testing.T) {
+		addr, server := startMetadataTestServer(t)
+
+		client, err := New(newOfflineTestCrypter(t), addr, WithMetadata(map[string]string{
+			"tenant":    "testTenant",
+			"device-id": "testDevice",
+		}))
+		require.NoError(t, err)
+		defer client.Close()
+
+		_, err = client.GetAllFiles(context.Background())
+		require.NoError(t, err)
+
+		server.mu.Lock()
+		defer server.mu.Unlock()
+
+		require.Equal(t, []string{"testTenant"}, server.md.Get("tenant"))
+		require.Equal(t, []string{"testDevice"}, server.md.Get("device-id"))
+	})
+
+	t.Run("no metadata configured attaches nothing", func(t *testing.T) {
+		addr, server := startMetadataTestServer(t)
+
+		client, err := New(newOfflineTestCrypter(t), addr)
+		require.NoError(t, err)
+		defer client.Close()
+
+		_, err = client.GetAllFiles(context.Background())
+		require.NoError(t, err)
+
+		server.mu.Lock()
+		defer server.mu.Unlock()
+
+		require.Empty(t, server.md.Get("tenant"))
+		require.Empty(t, server.md.Get("device-id"))
+	})
+}
@@ -0,0 +1,80 @@
+//go:build unit
+
+package client
+
+import (
+	"context"
+	"testing"
+
+	proto "github.com/Tomap-Tomap/GophKeeper/proto/gophkeeper/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithMetaTemplate(t *testing.T) {
+	template := `{"category":"","url":""}`
+
+	client, err := New(
+		new(CrypterMockedObject),
+		"testAddr",
+		WithMetaTemplate(EntryPassword, template),
+	)
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, client.Close())
+	}()
+
+	t.Run("blank meta gets the template", func(t *testing.T) {
+		require.Equal(t, template, client.applyMetaTemplate(EntryPassword, ""))
+	})
+
+	t.Run("provided meta is kept", func(t *testing.T) {
+		require.Equal(t, "testMeta", client.applyMetaTemplate(EntryPassword, "testMeta"))
+	})
+
+	t.Run("unconfigured type stays empty", func(t *testing.T) {
+		require.Equal(t, "", client.applyMetaTemplate(EntryText, ""))
+	})
+}
+
+func (suite *ClientTestSuite) TestCreatePasswordMetaTemplate() {
+	require := suite.Require()
+
+	template := `{"category":"","url":""}`
+
+	suite.client.metaTemplates = map[string]string{EntryPassword: template}
+
+	suite.Run("template fills blank meta", func() {
+		suite.crypterMock.onSealStringWithoutNonce(suite.testName, suite.testName, nil)
+		suite.crypterMock.onSealStringWithoutNonce(suite.testLogin, suite.testLogin, nil)
+		suite.crypterMock.onSealStringWithoutNonce(suite.testPassword, suite.testPassword, nil)
+		suite.crypterMock.onSealStringWithoutNonce(template, template, nil)
+
+		suite.serverMock.onCreatePassword(&proto.CreatePasswordRequest{
+			Name:     suite.testName,
+			Login:    suite.testLogin,
+			Password: suite.testPassword,
+			Meta:     template,
+		}, &proto.CreatePasswordResponse{}, nil)
+
+		err := suite.client.CreatePassword(context.Background(), suite.testName, suite.testLogin, suite.testPassword, "")
+		require.NoError(err)
+	})
+
+	suite.Run("provided meta wins over the template", func() {
+		suite.crypterMock.onSealStringWithoutNonce(suite.testName, suite.testName, nil)
+		suite.crypterMock.onSealStringWithoutNonce(suite.testLogin, suite.testLogin, nil)
+		suite.crypterMock.onSealStringWithoutNonce(suite.testPassword, suite.testPassword, nil)
+		suite.crypterMock.onSealStringWithoutNonce(suite.testMeta, suite.testMeta, nil)
+
+		suite.serverMock.onCreatePassword(&proto.CreatePasswordRequest{
+			Name:     suite.testName,
+			Login:    suite.testLogin,
+			Password: suite.testPassword,
+			Meta:     suite.testMeta,
+		}, &proto.CreatePasswordResponse{}, nil)
+
+		err := suite.client.CreatePassword(context.Background(), suite.testName, suite.testLogin, suite.testPassword, suite.testMeta)
+		require.NoError(err)
+	})
+}
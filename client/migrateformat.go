@@ -0,0 +1,215 @@
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	proto "github.com/Tomap-Tomap/GophKeeper/proto/gophkeeper/v1"
+)
+
+// fileFormatVersion is the content format the client currently writes: a
+// single nonce sent ahead of the content with every chunk sealed by it,
+// optionally preceded by a codec marker. Files uploaded before format
+// versioning carry no marker in their meta and count as legacy.
+const fileFormatVersion = 1
+
+// fileFormatPrefix prefixes the plaintext meta of files whose content
+// format is recorded, followed by the version number and fileFormatSep.
+// Like the padding marker, it starts with a control byte no user-typed
+// meta begins with, and openFile strips it, so the marker never reaches
+// the user.
+const (
+	fileFormatPrefix = "\x02fmt"
+	fileFormatSep    = ";"
+)
+
+// markFileFormat stamps meta with the current format version.
+func markFileFormat(meta string) string {
+	return fileFormatPrefix + strconv.Itoa(fileFormatVersion) + fileFormatSep + meta
+}
+
+// parseFileFormat splits a meta into its recorded format version and the
+// user's meta. Metas without a well-formed marker belong to legacy files
+// and report version zero.
+func parseFileFormat(meta string) (int, string) {
+	if !strings.HasPrefix(meta, fileFormatPrefix) {
+		return 0, meta
+	}
+
+	rest := meta[len(fileFormatPrefix):]
+	sep := strings.Index(rest, fileFormatSep)
+
+	if sep < 0 {
+		return 0, meta
+	}
+
+	version, err := strconv.Atoi(rest[:sep])
+
+	if err != nil {
+		return 0, meta
+	}
+
+	return version, rest[sep+len(fileFormatSep):]
+}
+
+// MigrateFileFormat downloads one file, decrypting whatever format it was
+// uploaded in, re-uploads it in the current format and stamps the format
+// version into its meta. A file already carrying the current version is
+// left untouched, so re-running is safe. The content is buffered in
+// memory during the migration.
+func (c *Client) MigrateFileFormat(ctx context.Context, id string) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := c.grpc.GetFile(ctx, &proto.GetFileRequest{Id: id})
+
+	if err != nil {
+		return fmt.Errorf("cannot get file stream: %w", err)
+	}
+
+	res, err := stream.Recv()
+
+	if err != nil {
+		return fmt.Errorf("cannot get file info: %w", err)
+	}
+
+	info := res.GetFileInfo()
+
+	name, err := c.crypter.OpenStringWithoutNonce(info.GetName())
+
+	if err != nil {
+		return fmt.Errorf("cannot open name: %w: %w", ErrDecryptFailed, err)
+	}
+
+	meta, err := c.crypter.OpenStringWithoutNonce(info.GetMeta())
+
+	if err != nil {
+		return fmt.Errorf("cannot open meta: %w: %w", ErrDecryptFailed, err)
+	}
+
+	version, userMeta := parseFileFormat(meta)
+
+	if version >= fileFormatVersion {
+		return nil
+	}
+
+	var buf bytes.Buffer
+
+	if err := c.receiveFileContent(stream, bufio.NewWriter(&buf)); err != nil {
+		return err
+	}
+
+	return c.uploadMigratedFile(ctx, id, name, userMeta, &buf)
+}
+
+// uploadMigratedFile re-uploads the plaintext content of a migrated file
+// under the same id with the format marker stamped into its meta.
+func (c *Client) uploadMigratedFile(ctx context.Context, id, name, userMeta string, content *bytes.Buffer) error {
+	chunkSize, err := c.getChunkSize(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	fileInfo, err := c.sealFile(name, markFileFormat(userMeta))
+
+	if err != nil {
+		return fmt.Errorf("cannot seal file info: %w", err)
+	}
+
+	stream, err := c.grpc.UpdateFile(ctx)
+
+	if err != nil {
+		return fmt.Errorf("cannot start updating a file stream: %w", err)
+	}
+
+	err = stream.Send(
+		&proto.UpdateFileRequest{
+			Data: &proto.UpdateFileRequest_FileInfo{
+				FileInfo: &proto.File{
+					Id:   id,
+					Name: fileInfo.Name,
+					Meta: fileInfo.Meta,
+				},
+			},
+		},
+	)
+
+	if err != nil {
+		return fmt.Errorf("cannot send file info: %w", err)
+	}
+
+	nonce, err := c.crypter.GenerateNonce()
+
+	if err != nil {
+		return fmt.Errorf("cannot generate nonce: %w", err)
+	}
+
+	err = stream.Send(&proto.UpdateFileRequest{
+		Data: &proto.UpdateFileRequest_Content{
+			Content: nonce,
+		},
+	})
+
+	if err != nil {
+		return fmt.Errorf("cannot send nonce: %w", err)
+	}
+
+	err = c.sendFileContent(ctx, content, chunkSize, nonce, func(data []byte) error {
+		return stream.Send(&proto.UpdateFileRequest{
+			Data: &proto.UpdateFileRequest_Content{
+				Content: data,
+			},
+		})
+	})
+
+	if err != nil {
+		return err
+	}
+
+	_, err = stream.CloseAndRecv()
+
+	if err != nil {
+		return fmt.Errorf("cannot close streaming: %w", err)
+	}
+
+	return nil
+}
+
+// MigrateAllFiles migrates every file flagged as legacy by its format
+// marker and returns how many files were re-uploaded. Files already in
+// the current format are skipped, so re-running is safe and a run
+// interrupted part way picks up where it stopped.
+func (c *Client) MigrateAllFiles(ctx context.Context) (int, error) {
+	res, err := c.grpc.GetFiles(ctx, &proto.GetFilesRequest{})
+
+	if err != nil {
+		return 0, fmt.Errorf("cannot get files: %w", err)
+	}
+
+	migrated := 0
+
+	for _, file := range res.GetFileInfo() {
+		meta, err := c.crypter.OpenStringWithoutNonce(file.GetMeta())
+
+		if err != nil {
+			return migrated, fmt.Errorf("cannot open meta: %w: %w", ErrDecryptFailed, err)
+		}
+
+		if version, _ := parseFileFormat(meta); version >= fileFormatVersion {
+			continue
+		}
+
+		if err := c.MigrateFileFormat(ctx, file.GetId()); err != nil {
+			return migrated, fmt.Errorf("cannot migrate file %s: %w", file.GetId(), err)
+		}
+
+		migrated++
+	}
+
+	return migrated, nil
+}
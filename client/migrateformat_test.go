@@ -0,0 +1,236 @@
+//go:build unit
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	proto "github.com/Tomap-Tomap/GophKeeper/proto/gophkeeper/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// migrateTestServer stores one file's sealed info and content frames in
+// memory, so a format migration runs against real streams and real crypto
+// and the test can inspect what the migration re-uploaded.
+type migrateTestServer struct {
+	proto.UnimplementedGophKeeperServiceServer
+
+	mu      sync.Mutex
+	name    string
+	meta    string
+	frames  [][]byte
+	updates int
+}
+
+func (s *migrateTestServer) GetChunkSize(_ context.Context, _ *proto.GetChunkSizeRequest) (*proto.GetChunkSizeResponse, error) {
+	return &proto.GetChunkSizeResponse{Size: 1024}, nil
+}
+
+func (s *migrateTestServer) CreateFile(stream proto.GophKeeperService_CreateFileServer) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.frames = nil
+
+	for {
+		req, err := stream.Recv()
+
+		if errors.Is(err, io.EOF) {
+			return stream.SendAndClose(&proto.CreateFileResponse{Id: "testFileID"})
+		}
+		if err != nil {
+			return err
+		}
+
+		switch data := req.GetData().(type) {
+		case *proto.CreateFileRequest_FileInfo:
+			s.name = data.FileInfo.GetName()
+			s.meta = data.FileInfo.GetMeta()
+		case *proto.CreateFileRequest_Content:
+			s.frames = append(s.frames, data.Content)
+		}
+	}
+}
+
+func (s *migrateTestServer) UpdateFile(stream proto.GophKeeperService_UpdateFileServer) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.frames = nil
+	s.updates++
+
+	for {
+		req, err := stream.Recv()
+
+		if errors.Is(err, io.EOF) {
+			return stream.SendAndClose(&proto.UpdateFileResponse{})
+		}
+		if err != nil {
+			return err
+		}
+
+		switch data := req.GetData().(type) {
+		case *proto.UpdateFileRequest_FileInfo:
+			s.name = data.FileInfo.GetName()
+			s.meta = data.FileInfo.GetMeta()
+		case *proto.UpdateFileRequest_Content:
+			s.frames = append(s.frames, data.Content)
+		}
+	}
+}
+
+func (s *migrateTestServer) GetFile(_ *proto.GetFileRequest, stream proto.GophKeeperService_GetFileServer) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	err := stream.Send(&proto.GetFileResponse{
+		Data: &proto.GetFileResponse_FileInfo{
+			FileInfo: &proto.File{Id: "testFileID", Name: s.name, Meta: s.meta},
+		},
+	})
+
+	if err != nil {
+		return err
+	}
+
+	for _, frame := range s.frames {
+		err := stream.Send(&proto.GetFileResponse{
+			Data: &proto.GetFileResponse_Content{Content: frame},
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *migrateTestServer) GetFiles(_ context.Context, _ *proto.GetFilesRequest) (*proto.GetFilesResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return &proto.GetFilesResponse{
+		FileInfo: []*proto.File{{Id: "testFileID", Name: s.name, Meta: s.meta}},
+	}, nil
+}
+
+func startMigrateTestServer(t *testing.T) (string, *migrateTestServer) {
+	t.Helper()
+
+	lis, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer()
+	mts := &migrateTestServer{}
+
+	proto.RegisterGophKeeperServiceServer(server, mts)
+
+	go func() {
+		_ = server.Serve(lis)
+	}()
+
+	t.Cleanup(server.Stop)
+
+	return lis.Addr().String(), mts
+}
+
+func TestFileFormatMarker(t *testing.T) {
+	t.Run("mark and parse round trip", func(t *testing.T) {
+		version, meta := parseFileFormat(markFileFormat("testMeta"))
+		require.Equal(t, fileFormatVersion, version)
+		require.Equal(t, "testMeta", meta)
+	})
+
+	t.Run("unmarked meta is legacy", func(t *testing.T) {
+		version, meta := parseFileFormat("testMeta")
+		require.Zero(t, version)
+		require.Equal(t, "testMeta", meta)
+	})
+
+	t.Run("malformed marker is passed through", func(t *testing.T) {
+		version, meta := parseFileFormat(fileFormatPrefix + "notanumber")
+		require.Zero(t, version)
+		require.Equal(t, fileFormatPrefix+"notanumber", meta)
+	})
+}
+
+func TestMigrateAllFiles(t *testing.T) {
+	content := bytes.Repeat([]byte("legacy file content,"), 200)
+
+	addr, server := startMigrateTestServer(t)
+
+	client, err := New(newOfflineTestCrypter(t), addr)
+	require.NoError(t, err)
+	defer client.Close()
+
+	require.NoError(t, client.CreateFileFromReader(context.Background(), "testName", bytes.NewReader(content), "testMeta"))
+
+	server.mu.Lock()
+	uploadedMeta := server.meta
+	server.mu.Unlock()
+
+	uploadedOpenMeta, err := client.crypter.OpenStringWithoutNonce(uploadedMeta)
+	require.NoError(t, err)
+
+	uploadVersion, _ := parseFileFormat(uploadedOpenMeta)
+	require.Equal(t, fileFormatVersion, uploadVersion, "fresh uploads must carry the current format marker")
+
+	// Strip the marker to simulate a file uploaded before format
+	// versioning, so the migration has a legacy file to pick up.
+	legacyMeta, err := client.crypter.SealStringWithoutNonce("testMeta")
+	require.NoError(t, err)
+
+	server.mu.Lock()
+	server.meta = legacyMeta
+	server.mu.Unlock()
+
+	migrated, err := client.MigrateAllFiles(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, migrated)
+
+	server.mu.Lock()
+	storedMeta := server.meta
+	updates := server.updates
+	server.mu.Unlock()
+
+	require.Equal(t, 1, updates)
+
+	openMeta, err := client.crypter.OpenStringWithoutNonce(storedMeta)
+	require.NoError(t, err)
+
+	version, userMeta := parseFileFormat(openMeta)
+	require.Equal(t, fileFormatVersion, version)
+	require.Equal(t, "testMeta", userMeta)
+
+	dir := t.TempDir()
+	require.NoError(t, client.GetFile(context.Background(), "testFileID", dir))
+
+	got, err := os.ReadFile(filepath.Join(dir, "testFileID"))
+	require.NoError(t, err)
+	require.Equal(t, content, got)
+
+	files, err := client.GetAllFiles(context.Background())
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	require.Equal(t, "testMeta", files[0].Meta)
+
+	migrated, err = client.MigrateAllFiles(context.Background())
+	require.NoError(t, err)
+	require.Zero(t, migrated)
+
+	server.mu.Lock()
+	updates = server.updates
+	server.mu.Unlock()
+
+	require.Equal(t, 1, updates)
+}
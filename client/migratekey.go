@@ -0,0 +1,421 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/Tomap-Tomap/GophKeeper/crypto"
+	proto "github.com/Tomap-Tomap/GophKeeper/proto/gophkeeper/v1"
+)
+
+// migrationKeySize is the size in bytes of the key generated for a
+// migration target.
+const migrationKeySize = 32
+
+// migrationProgressSuffix names the progress file kept next to the new key
+// file while a migration is running; its presence means a migration was
+// interrupted and MigrateKey will resume it.
+const migrationProgressSuffix = ".migrating"
+
+// migrationProgress is the on-disk state of a running key migration. The
+// new key is stored wrapped with the current key, so the progress file
+// never exposes it, and Done records which entries are already re-sealed.
+type migrationProgress struct {
+	WrappedKey string          `json:"wrapped_key"`
+	Done       map[string]bool `json:"done"`
+}
+
+// save persists the progress to path, so an interrupted migration can be
+// resumed instead of restarted.
+func (p *migrationProgress) save(path string) error {
+	data, err := json.Marshal(p)
+
+	if err != nil {
+		return fmt.Errorf("cannot marshal migration progress: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return fmt.Errorf("cannot save migration progress: %w", err)
+	}
+
+	return nil
+}
+
+// markDone records that the entry is sealed with the new key.
+func (p *migrationProgress) markDone(path, entry string) error {
+	p.Done[entry] = true
+
+	return p.save(path)
+}
+
+// markUndone records that the entry is sealed with the current key again,
+// so a later resume does not skip it.
+func (p *migrationProgress) markUndone(path, entry string) error {
+	delete(p.Done, entry)
+
+	return p.save(path)
+}
+
+// rollbackOp re-seals one already migrated entry with the current key.
+type rollbackOp struct {
+	entry string
+	fn    func() error
+}
+
+// MigrateKey re-encrypts every stored entry from the current key to a
+// freshly generated one and writes the new key to newKeyPath only after
+// everything succeeded, so the key file never points at a partially
+// migrated account. On a server error it rolls the already migrated
+// entries back to the current key. If the process is interrupted, the
+// progress file next to newKeyPath keeps the new key wrapped with the
+// current one, and calling MigrateKey with the same path resumes where it
+// stopped; data is then mixed between the two keys only while the
+// migration is running. A configured offline queue file is re-pointed at
+// the new key afterwards, so queued offline writes must be synced before
+// migrating. MigrateKey must not run concurrently with other calls on the
+// client.
+func (c *Client) MigrateKey(ctx context.Context, newKeyPath string) error {
+	progressPath := newKeyPath + migrationProgressSuffix
+
+	progress, key, resumed, err := c.loadOrStartMigration(progressPath)
+
+	if err != nil {
+		return err
+	}
+
+	newCrypter, err := crypto.NewCrypterByKey(key)
+
+	if err != nil {
+		return fmt.Errorf("cannot create crypter for the new key: %w", err)
+	}
+
+	// Re-apply the decorators New configured on the current crypter, so a
+	// padding-enabled client keeps padding its fields after the migration.
+	var sealCrypter Crypter = newCrypter
+
+	if _, ok := c.crypter.(*paddingCrypter); ok {
+		sealCrypter = newPaddingCrypter(sealCrypter)
+	}
+
+	newClient := c.withCrypter(sealCrypter)
+
+	rollbacks, err := c.migrateForward(ctx, newClient, progress, progressPath)
+
+	if err != nil {
+		if resumed {
+			return fmt.Errorf("cannot resume key migration, run MigrateKey with the same path again to finish: %w", err)
+		}
+
+		if rbErr := c.rollbackMigration(progress, progressPath, rollbacks); rbErr != nil {
+			return errors.Join(
+				fmt.Errorf("cannot migrate key: %w", err),
+				fmt.Errorf("cannot roll back, run MigrateKey with the same path again to finish: %w", rbErr),
+			)
+		}
+
+		return fmt.Errorf("cannot migrate key, all entries were rolled back to the current key: %w", err)
+	}
+
+	if err := writeKeyFile(newKeyPath, key); err != nil {
+		return fmt.Errorf("cannot write new key file: %w", err)
+	}
+
+	if err := os.Remove(progressPath); err != nil {
+		return fmt.Errorf("cannot remove migration progress file: %w", err)
+	}
+
+	c.crypter = sealCrypter
+
+	if c.offline != nil {
+		c.offline.mu.Lock()
+		c.offline.crypter = sealCrypter
+		c.offline.mu.Unlock()
+	}
+
+	return nil
+}
+
+// loadOrStartMigration resumes the migration recorded at progressPath or
+// starts a new one by generating a key and persisting it wrapped with the
+// current key. The third result reports whether an existing migration was
+// resumed.
+func (c *Client) loadOrStartMigration(progressPath string) (*migrationProgress, []byte, bool, error) {
+	data, err := os.ReadFile(progressPath)
+
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, nil, false, fmt.Errorf("cannot read migration progress file: %w", err)
+	}
+
+	if err == nil {
+		progress := &migrationProgress{}
+
+		if err := json.Unmarshal(data, progress); err != nil {
+			return nil, nil, false, fmt.Errorf("cannot unmarshal migration progress: %w", err)
+		}
+
+		if progress.Done == nil {
+			progress.Done = make(map[string]bool)
+		}
+
+		key, err := c.crypter.UnwrapKey(progress.WrappedKey)
+
+		if err != nil {
+			return nil, nil, false, fmt.Errorf("cannot unwrap migration key: %w", err)
+		}
+
+		return progress, key, true, nil
+	}
+
+	key, err := crypto.GenerateDataKey(migrationKeySize)
+
+	if err != nil {
+		return nil, nil, false, fmt.Errorf("cannot generate migration key: %w", err)
+	}
+
+	wrapped, err := c.crypter.WrapKey(key)
+
+	if err != nil {
+		return nil, nil, false, fmt.Errorf("cannot wrap migration key: %w", err)
+	}
+
+	progress := &migrationProgress{
+		WrappedKey: wrapped,
+		Done:       make(map[string]bool),
+	}
+
+	if err := progress.save(progressPath); err != nil {
+		return nil, nil, false, err
+	}
+
+	return progress, key, false, nil
+}
+
+// withCrypter returns a shallow copy of the client that seals and opens
+// with the given crypter but shares the connection and session.
+func (c *Client) withCrypter(crypter Crypter) *Client {
+	clone := *c
+	clone.crypter = crypter
+
+	return &clone
+}
+
+// migrateForward pulls every entry, opens it with the current key and
+// updates it through newClient, which seals with the new key. Entries
+// already recorded in progress are skipped, and progress is persisted
+// after each entry. It returns the rollback operations for the entries
+// migrated in this run.
+func (c *Client) migrateForward(ctx context.Context, newClient *Client, progress *migrationProgress, progressPath string) (rollbacks []rollbackOp, retErr error) {
+	pwds, err := c.grpc.GetPasswords(ctx, &proto.GetPasswordsRequest{})
+
+	if err != nil {
+		return rollbacks, fmt.Errorf("cannot get passwords: %w", err)
+	}
+
+	for _, protoPwd := range pwds.GetPasswords() {
+		entry := "password/" + protoPwd.GetId()
+
+		if progress.Done[entry] {
+			continue
+		}
+
+		pwd, err := c.openPassword(protoPwd)
+
+		if err != nil {
+			return rollbacks, fmt.Errorf("cannot open password %s: %w", protoPwd.GetId(), err)
+		}
+
+		if err := newClient.UpdatePassword(ctx, pwd.ID, pwd.Name, pwd.Login, pwd.Password, pwd.Meta); err != nil {
+			return rollbacks, fmt.Errorf("cannot re-seal password %s: %w", pwd.ID, err)
+		}
+
+		rollbacks = append(rollbacks, rollbackOp{entry: entry, fn: func() error {
+			return c.UpdatePassword(ctx, pwd.ID, pwd.Name, pwd.Login, pwd.Password, pwd.Meta)
+		}})
+
+		if err := progress.markDone(progressPath, entry); err != nil {
+			return rollbacks, err
+		}
+	}
+
+	banks, err := c.grpc.GetBanks(ctx, &proto.GetBanksRequest{})
+
+	if err != nil {
+		return rollbacks, fmt.Errorf("cannot get banks: %w", err)
+	}
+
+	for _, protoBank := range banks.GetBanks() {
+		entry := "bank/" + protoBank.GetId()
+
+		if progress.Done[entry] {
+			continue
+		}
+
+		bank, err := c.openBank(protoBank)
+
+		if err != nil {
+			return rollbacks, fmt.Errorf("cannot open bank %s: %w", protoBank.GetId(), err)
+		}
+
+		if err := newClient.UpdateBank(ctx, bank.ID, bank.Name, bank.CardNumber, bank.CVC, bank.Owner, bank.Exp, bank.Meta); err != nil {
+			return rollbacks, fmt.Errorf("cannot re-seal bank %s: %w", bank.ID, err)
+		}
+
+		rollbacks = append(rollbacks, rollbackOp{entry: entry, fn: func() error {
+			return c.UpdateBank(ctx, bank.ID, bank.Name, bank.CardNumber, bank.CVC, bank.Owner, bank.Exp, bank.Meta)
+		}})
+
+		if err := progress.markDone(progressPath, entry); err != nil {
+			return rollbacks, err
+		}
+	}
+
+	texts, err := c.grpc.GetTexts(ctx, &proto.GetTextsRequest{})
+
+	if err != nil {
+		return rollbacks, fmt.Errorf("cannot get texts: %w", err)
+	}
+
+	for _, protoText := range texts.GetTexts() {
+		entry := "text/" + protoText.GetId()
+
+		if progress.Done[entry] {
+			continue
+		}
+
+		text, err := c.openText(protoText)
+
+		if err != nil {
+			return rollbacks, fmt.Errorf("cannot open text %s: %w", protoText.GetId(), err)
+		}
+
+		if err := newClient.UpdateText(ctx, text.ID, text.Name, text.Text, text.Meta); err != nil {
+			return rollbacks, fmt.Errorf("cannot re-seal text %s: %w", text.ID, err)
+		}
+
+		rollbacks = append(rollbacks, rollbackOp{entry: entry, fn: func() error {
+			return c.UpdateText(ctx, text.ID, text.Name, text.Text, text.Meta)
+		}})
+
+		if err := progress.markDone(progressPath, entry); err != nil {
+			return rollbacks, err
+		}
+	}
+
+	files, err := c.grpc.GetFiles(ctx, &proto.GetFilesRequest{})
+
+	if err != nil {
+		return rollbacks, fmt.Errorf("cannot get files: %w", err)
+	}
+
+	for _, protoFile := range files.GetFileInfo() {
+		entry := "file/" + protoFile.GetId()
+
+		if progress.Done[entry] {
+			continue
+		}
+
+		file, err := c.openFile(protoFile)
+
+		if err != nil {
+			return rollbacks, fmt.Errorf("cannot open file %s: %w", protoFile.GetId(), err)
+		}
+
+		if err := reuploadFile(ctx, c, newClient, file.ID, file.Name, file.Meta); err != nil {
+			return rollbacks, fmt.Errorf("cannot re-seal file %s: %w", file.ID, err)
+		}
+
+		rollbacks = append(rollbacks, rollbackOp{entry: entry, fn: func() error {
+			return reuploadFile(ctx, newClient, c, file.ID, file.Name, file.Meta)
+		}})
+
+		if err := progress.markDone(progressPath, entry); err != nil {
+			return rollbacks, err
+		}
+	}
+
+	return rollbacks, nil
+}
+
+// reuploadFile downloads the file content with the from client's key into
+// a temporary file and uploads it back with the to client's key.
+func reuploadFile(ctx context.Context, from, to *Client, id, name, meta string) (retErr error) {
+	tmp, err := os.CreateTemp("", "gophkeepermigrate")
+
+	if err != nil {
+		return fmt.Errorf("cannot create temporary file: %w", err)
+	}
+
+	tmpPath := tmp.Name()
+
+	defer func() {
+		retErr = errors.Join(retErr, os.Remove(tmpPath))
+	}()
+
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("cannot close temporary file: %w", err)
+	}
+
+	if err := from.GetFile(ctx, id, tmpPath); err != nil {
+		return fmt.Errorf("cannot download file content: %w", err)
+	}
+
+	if err := to.UpdateFile(ctx, id, name, tmpPath, meta); err != nil {
+		return fmt.Errorf("cannot upload file content: %w", err)
+	}
+
+	return nil
+}
+
+// rollbackMigration re-seals the entries migrated in this run with the
+// current key, newest first, and removes the progress file. Progress is
+// persisted after each entry, so a failed rollback can still be finished
+// forward by running MigrateKey again.
+func (c *Client) rollbackMigration(progress *migrationProgress, progressPath string, rollbacks []rollbackOp) error {
+	for i := len(rollbacks) - 1; i >= 0; i-- {
+		if err := rollbacks[i].fn(); err != nil {
+			return fmt.Errorf("cannot roll back %s: %w", rollbacks[i].entry, err)
+		}
+
+		if err := progress.markUndone(progressPath, rollbacks[i].entry); err != nil {
+			return err
+		}
+	}
+
+	if err := os.Remove(progressPath); err != nil {
+		return fmt.Errorf("cannot remove migration progress file: %w", err)
+	}
+
+	return nil
+}
+
+// writeKeyFile writes the key next to path and renames it into place, so
+// the key file is either the old one or the complete new one.
+func writeKeyFile(path string, key []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp")
+
+	if err != nil {
+		return fmt.Errorf("cannot create temporary key file: %w", err)
+	}
+
+	if _, err := tmp.Write(key); err != nil {
+		return errors.Join(fmt.Errorf("cannot write key: %w", err), tmp.Close(), os.Remove(tmp.Name()))
+	}
+
+	if err := tmp.Chmod(0o600); err != nil {
+		return errors.Join(fmt.Errorf("cannot restrict key file permissions: %w", err), tmp.Close(), os.Remove(tmp.Name()))
+	}
+
+	if err := tmp.Close(); err != nil {
+		return errors.Join(fmt.Errorf("cannot close temporary key file: %w", err), os.Remove(tmp.Name()))
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return errors.Join(fmt.Errorf("cannot move key file into place: %w", err), os.Remove(tmp.Name()))
+	}
+
+	return nil
+}
@@ -0,0 +1,238 @@
+//go:build unit
+
+package client
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Tomap-Tomap/GophKeeper/crypto"
+	proto "github.com/Tomap-Tomap/GophKeeper/proto/gophkeeper/v1"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// sealTestPassword builds the server-side view of a password entry sealed
+// with the client's current key.
+func sealTestPassword(t *testing.T, c *Client, id, name, login, password, meta string) *proto.Password {
+	t.Helper()
+
+	sealed, err := c.sealPassword(name, login, password, meta)
+	require.NoError(t, err)
+
+	return &proto.Password{
+		Id:       id,
+		Name:     sealed.Name,
+		Login:    sealed.Login,
+		Password: sealed.Password,
+		Meta:     sealed.Meta,
+	}
+}
+
+func TestMigrateKey(t *testing.T) {
+	oldCrypter := newOfflineTestCrypter(t)
+	newKeyPath := filepath.Join(t.TempDir(), "key.aes")
+
+	addr, serverMock := startOfflineTestServer(t)
+	defer serverMock.AssertExpectations(t)
+
+	client, err := New(oldCrypter, addr)
+	require.NoError(t, err)
+	defer client.Close()
+
+	sealedText, err := client.sealText("textName", "textText", "textMeta")
+	require.NoError(t, err)
+
+	serverMock.onGetPasswords(&proto.GetPasswordsResponse{Passwords: []*proto.Password{
+		sealTestPassword(t, client, "testPasswordID", "testName", "testLogin", "testPassword", "testMeta"),
+	}}, nil)
+	serverMock.onGetBanks(&proto.GetBanksResponse{}, nil)
+	serverMock.onGetTexts(&proto.GetTextsResponse{Texts: []*proto.Text{
+		{Id: "testTextID", Name: sealedText.Name, Text: sealedText.Text, Meta: sealedText.Meta},
+	}}, nil)
+	serverMock.onGetFiles(&proto.GetFilesResponse{}, nil)
+
+	var updatedPwd *proto.UpdatePasswordRequest
+	var updatedText *proto.UpdateTextRequest
+
+	serverMock.On("UpdatePassword", mock.Anything).Return(&proto.UpdatePasswordResponse{}, nil).Once().Run(func(args mock.Arguments) {
+		updatedPwd = args.Get(0).(*proto.UpdatePasswordRequest)
+	})
+	serverMock.On("UpdateText", mock.Anything).Return(&proto.UpdateTextResponse{}, nil).Once().Run(func(args mock.Arguments) {
+		updatedText = args.Get(0).(*proto.UpdateTextRequest)
+	})
+
+	require.NoError(t, client.MigrateKey(context.Background(), newKeyPath))
+
+	t.Run("entries are sealed with the key written to the new path", func(t *testing.T) {
+		newCrypter, err := crypto.NewCrypterByFile(newKeyPath)
+		require.NoError(t, err)
+
+		require.Equal(t, "testPasswordID", updatedPwd.GetId())
+
+		opened, err := newCrypter.OpenStringsWithoutNonce([]string{
+			updatedPwd.GetName(), updatedPwd.GetLogin(), updatedPwd.GetPassword(), updatedPwd.GetMeta(),
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"testName", "testLogin", "testPassword", "testMeta"}, opened)
+
+		require.Equal(t, "testTextID", updatedText.GetId())
+
+		opened, err = newCrypter.OpenStringsWithoutNonce([]string{
+			updatedText.GetName(), updatedText.GetText(), updatedText.GetMeta(),
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"textName", "textText", "textMeta"}, opened)
+	})
+
+	t.Run("old key no longer opens the entries", func(t *testing.T) {
+		_, err := oldCrypter.OpenStringWithoutNonce(updatedPwd.GetPassword())
+		require.Error(t, err)
+	})
+
+	t.Run("client switched to the new key", func(t *testing.T) {
+		opened, err := client.openPassword(&proto.Password{
+			Id:       updatedPwd.GetId(),
+			Name:     updatedPwd.GetName(),
+			Login:    updatedPwd.GetLogin(),
+			Password: updatedPwd.GetPassword(),
+			Meta:     updatedPwd.GetMeta(),
+		})
+		require.NoError(t, err)
+		require.Equal(t, "testPassword", opened.Password)
+	})
+
+	t.Run("progress file is removed", func(t *testing.T) {
+		_, err := os.Stat(newKeyPath + migrationProgressSuffix)
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
+
+func TestMigrateKeyKeepsFieldPadding(t *testing.T) {
+	oldCrypter := newOfflineTestCrypter(t)
+	newKeyPath := filepath.Join(t.TempDir(), "key.aes")
+
+	addr, serverMock := startOfflineTestServer(t)
+	defer serverMock.AssertExpectations(t)
+
+	client, err := New(oldCrypter, addr, WithFieldPadding())
+	require.NoError(t, err)
+	defer client.Close()
+
+	serverMock.onGetPasswords(&proto.GetPasswordsResponse{}, nil)
+	serverMock.onGetBanks(&proto.GetBanksResponse{}, nil)
+	serverMock.onGetTexts(&proto.GetTextsResponse{}, nil)
+	serverMock.onGetFiles(&proto.GetFilesResponse{}, nil)
+
+	require.NoError(t, client.MigrateKey(context.Background(), newKeyPath))
+
+	require.IsType(t, &paddingCrypter{}, client.crypter)
+}
+
+func TestMigrateKeyRollback(t *testing.T) {
+	oldCrypter := newOfflineTestCrypter(t)
+	newKeyPath := filepath.Join(t.TempDir(), "key.aes")
+
+	addr, serverMock := startOfflineTestServer(t)
+	defer serverMock.AssertExpectations(t)
+
+	client, err := New(oldCrypter, addr)
+	require.NoError(t, err)
+	defer client.Close()
+
+	serverMock.onGetPasswords(&proto.GetPasswordsResponse{Passwords: []*proto.Password{
+		sealTestPassword(t, client, "testPasswordID1", "testName1", "testLogin1", "testPassword1", "testMeta1"),
+		sealTestPassword(t, client, "testPasswordID2", "testName2", "testLogin2", "testPassword2", "testMeta2"),
+	}}, nil)
+
+	var rolledBack *proto.UpdatePasswordRequest
+
+	serverMock.On("UpdatePassword", mock.Anything).Return(&proto.UpdatePasswordResponse{}, nil).Once()
+	serverMock.On("UpdatePassword", mock.Anything).Return(nil, status.Error(codes.Internal, "update failed")).Once()
+	serverMock.On("UpdatePassword", mock.Anything).Return(&proto.UpdatePasswordResponse{}, nil).Once().Run(func(args mock.Arguments) {
+		rolledBack = args.Get(0).(*proto.UpdatePasswordRequest)
+	})
+
+	err = client.MigrateKey(context.Background(), newKeyPath)
+	require.ErrorContains(t, err, "all entries were rolled back")
+
+	t.Run("migrated entry is sealed with the old key again", func(t *testing.T) {
+		require.Equal(t, "testPasswordID1", rolledBack.GetId())
+
+		opened, err := oldCrypter.OpenStringsWithoutNonce([]string{
+			rolledBack.GetName(), rolledBack.GetLogin(), rolledBack.GetPassword(), rolledBack.GetMeta(),
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"testName1", "testLogin1", "testPassword1", "testMeta1"}, opened)
+	})
+
+	t.Run("no key or progress file is left behind", func(t *testing.T) {
+		_, err := os.Stat(newKeyPath)
+		require.ErrorIs(t, err, os.ErrNotExist)
+
+		_, err = os.Stat(newKeyPath + migrationProgressSuffix)
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
+
+func TestMigrateKeyResume(t *testing.T) {
+	oldCrypter := newOfflineTestCrypter(t)
+	newKeyPath := filepath.Join(t.TempDir(), "key.aes")
+
+	addr, serverMock := startOfflineTestServer(t)
+	defer serverMock.AssertExpectations(t)
+
+	client, err := New(oldCrypter, addr)
+	require.NoError(t, err)
+	defer client.Close()
+
+	serverMock.onGetPasswords(&proto.GetPasswordsResponse{Passwords: []*proto.Password{
+		sealTestPassword(t, client, "testPasswordID1", "testName1", "testLogin1", "testPassword1", "testMeta1"),
+		sealTestPassword(t, client, "testPasswordID2", "testName2", "testLogin2", "testPassword2", "testMeta2"),
+	}}, nil)
+
+	var firstRun *proto.UpdatePasswordRequest
+
+	serverMock.On("UpdatePassword", mock.Anything).Return(&proto.UpdatePasswordResponse{}, nil).Once().Run(func(args mock.Arguments) {
+		firstRun = args.Get(0).(*proto.UpdatePasswordRequest)
+	})
+	serverMock.On("UpdatePassword", mock.Anything).Return(nil, status.Error(codes.Internal, "update failed")).Twice()
+
+	err = client.MigrateKey(context.Background(), newKeyPath)
+	require.ErrorContains(t, err, "cannot roll back")
+
+	t.Run("failed rollback keeps the progress file", func(t *testing.T) {
+		_, err := os.Stat(newKeyPath + migrationProgressSuffix)
+		require.NoError(t, err)
+	})
+
+	var secondRun *proto.UpdatePasswordRequest
+
+	serverMock.On("UpdatePassword", mock.Anything).Return(&proto.UpdatePasswordResponse{}, nil).Once().Run(func(args mock.Arguments) {
+		secondRun = args.Get(0).(*proto.UpdatePasswordRequest)
+	})
+	serverMock.onGetBanks(&proto.GetBanksResponse{}, nil)
+	serverMock.onGetTexts(&proto.GetTextsResponse{}, nil)
+	serverMock.onGetFiles(&proto.GetFilesResponse{}, nil)
+
+	require.NoError(t, client.MigrateKey(context.Background(), newKeyPath))
+
+	t.Run("resume finishes the remaining entry with the same key", func(t *testing.T) {
+		require.Equal(t, "testPasswordID1", firstRun.GetId())
+		require.Equal(t, "testPasswordID2", secondRun.GetId())
+
+		newCrypter, err := crypto.NewCrypterByFile(newKeyPath)
+		require.NoError(t, err)
+
+		opened, err := newCrypter.OpenStringsWithoutNonce([]string{firstRun.GetPassword(), secondRun.GetPassword()})
+		require.NoError(t, err)
+		require.Equal(t, []string{"testPassword1", "testPassword2"}, opened)
+
+		_, err = os.Stat(newKeyPath + migrationProgressSuffix)
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
@@ -0,0 +1,288 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	proto "github.com/Tomap-Tomap/GophKeeper/proto/gophkeeper/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// ErrNoOfflineQueue is returned by Sync when the client was created without
+// WithOfflineQueue.
+var ErrNoOfflineQueue = errors.New("offline queue is not configured")
+
+// queuedOp is one write that failed with Unavailable and waits for replay.
+// The request is kept in wire form, so replay sends exactly the bytes the
+// original call would have sent.
+type queuedOp struct {
+	Method   string    `json:"method"`
+	Request  []byte    `json:"request"`
+	QueuedAt time.Time `json:"queued_at"`
+}
+
+// queueableMethod knows how to rebuild the request and response messages of
+// one queueable RPC during replay.
+type queueableMethod struct {
+	newReq func() protobuf.Message
+	newRes func() protobuf.Message
+}
+
+// queueableMethods lists the unary write RPCs the offline queue may hold.
+// File RPCs stream their content and are deliberately not queueable.
+var queueableMethods = map[string]queueableMethod{
+	proto.GophKeeperService_CreatePassword_FullMethodName: {
+		newReq: func() protobuf.Message { return new(proto.CreatePasswordRequest) },
+		newRes: func() protobuf.Message { return new(proto.CreatePasswordResponse) },
+	},
+	proto.GophKeeperService_UpdatePassword_FullMethodName: {
+		newReq: func() protobuf.Message { return new(proto.UpdatePasswordRequest) },
+		newRes: func() protobuf.Message { return new(proto.UpdatePasswordResponse) },
+	},
+	proto.GophKeeperService_DeletePassword_FullMethodName: {
+		newReq: func() protobuf.Message { return new(proto.DeletePasswordRequest) },
+		newRes: func() protobuf.Message { return new(proto.DeletePasswordResponse) },
+	},
+	proto.GophKeeperService_CreateBank_FullMethodName: {
+		newReq: func() protobuf.Message { return new(proto.CreateBankRequest) },
+		newRes: func() protobuf.Message { return new(proto.CreateBankResponse) },
+	},
+	proto.GophKeeperService_UpdateBank_FullMethodName: {
+		newReq: func() protobuf.Message { return new(proto.UpdateBankRequest) },
+		newRes: func() protobuf.Message { return new(proto.UpdateBankResponse) },
+	},
+	proto.GophKeeperService_DeleteBank_FullMethodName: {
+		newReq: func() protobuf.Message { return new(proto.DeleteBankRequest) },
+		newRes: func() protobuf.Message { return new(proto.DeleteBankResponse) },
+	},
+	proto.GophKeeperService_CreateText_FullMethodName: {
+		newReq: func() protobuf.Message { return new(proto.CreateTextRequest) },
+		newRes: func() protobuf.Message { return new(proto.CreateTextResponse) },
+	},
+	proto.GophKeeperService_UpdateText_FullMethodName: {
+		newReq: func() protobuf.Message { return new(proto.UpdateTextRequest) },
+		newRes: func() protobuf.Message { return new(proto.UpdateTextResponse) },
+	},
+	proto.GophKeeperService_DeleteText_FullMethodName: {
+		newReq: func() protobuf.Message { return new(proto.DeleteTextRequest) },
+		newRes: func() protobuf.Message { return new(proto.DeleteTextResponse) },
+	},
+}
+
+// replayMarker marks contexts of Sync replays, so a replay that fails with
+// Unavailable surfaces the error instead of being queued again.
+type replayMarker struct{}
+
+// isReplayConflict reports whether a replay was rejected because the entry
+// changed meanwhile. Besides the canonical NotFound and AlreadyExists
+// codes, the server reports an update or delete of a missing entry as
+// Unknown with an "unknown …ID" message (e.g. "unknown PasswordID"), so
+// that wording counts as a conflict too instead of wedging the queue.
+func isReplayConflict(err error) bool {
+	st := status.Convert(err)
+
+	switch st.Code() {
+	case codes.NotFound, codes.AlreadyExists:
+		return true
+	case codes.Unknown:
+		return strings.HasPrefix(st.Message(), "unknown ")
+	}
+
+	return false
+}
+
+// offlineQueue persists failed writes in an encrypted file and replays them
+// in order. Request fields are already sealed by the Crypter when they reach
+// the queue; the file encryption additionally hides methods and timestamps.
+type offlineQueue struct {
+	path    string
+	crypter Crypter
+	mu      sync.Mutex
+}
+
+// load reads the queued operations. A missing file means an empty queue.
+func (q *offlineQueue) load() ([]queuedOp, error) {
+	sealed, err := os.ReadFile(q.path)
+
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot read offline queue: %w", err)
+	}
+
+	data, err := q.crypter.OpenStringWithoutNonce(string(sealed))
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot open offline queue: %w", err)
+	}
+
+	var ops []queuedOp
+
+	err = json.Unmarshal([]byte(data), &ops)
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot unmarshal offline queue: %w", err)
+	}
+
+	return ops, nil
+}
+
+// store writes the queued operations back. An empty queue removes the file.
+func (q *offlineQueue) store(ops []queuedOp) error {
+	if len(ops) == 0 {
+		err := os.Remove(q.path)
+
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("cannot remove offline queue: %w", err)
+		}
+
+		return nil
+	}
+
+	data, err := json.Marshal(ops)
+
+	if err != nil {
+		return fmt.Errorf("cannot marshal offline queue: %w", err)
+	}
+
+	sealed, err := q.crypter.SealStringWithoutNonce(string(data))
+
+	if err != nil {
+		return fmt.Errorf("cannot seal offline queue: %w", err)
+	}
+
+	err = os.WriteFile(q.path, []byte(sealed), 0o600)
+
+	if err != nil {
+		return fmt.Errorf("cannot write offline queue: %w", err)
+	}
+
+	return nil
+}
+
+// enqueue appends one operation to the persisted queue.
+func (q *offlineQueue) enqueue(method string, req protobuf.Message) error {
+	data, err := protobuf.Marshal(req)
+
+	if err != nil {
+		return fmt.Errorf("cannot marshal request: %w", err)
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	ops, err := q.load()
+
+	if err != nil {
+		return err
+	}
+
+	ops = append(ops, queuedOp{
+		Method:   method,
+		Request:  data,
+		QueuedAt: time.Now().UTC(),
+	})
+
+	return q.store(ops)
+}
+
+// interceptor queues write RPCs that fail with Unavailable and reports them
+// as succeeded, so the caller can keep working offline. Every other outcome
+// passes through unchanged.
+func (q *offlineQueue) interceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	err := invoker(ctx, method, req, reply, cc, opts...)
+
+	if status.Code(err) != codes.Unavailable || ctx.Value(replayMarker{}) != nil {
+		return err
+	}
+
+	if _, ok := queueableMethods[method]; !ok {
+		return err
+	}
+
+	msg, ok := req.(protobuf.Message)
+
+	if !ok {
+		return err
+	}
+
+	qErr := q.enqueue(method, msg)
+
+	if qErr != nil {
+		return errors.Join(err, qErr)
+	}
+
+	return nil
+}
+
+// Sync replays queued offline writes in the order they were made. Replays
+// rejected because the entry changed meanwhile (see isReplayConflict)
+// are dropped and reported as conflicts. Any other replay failure stops
+// the sync, keeps the failed and remaining operations queued and returns the
+// error alongside the conflicts found so far.
+func (c *Client) Sync(ctx context.Context) ([]string, error) {
+	if c.offline == nil {
+		return nil, ErrNoOfflineQueue
+	}
+
+	c.offline.mu.Lock()
+	defer c.offline.mu.Unlock()
+
+	ops, err := c.offline.load()
+
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = context.WithValue(ctx, replayMarker{}, true)
+
+	var conflicts []string
+
+	for i, op := range ops {
+		method, ok := queueableMethods[op.Method]
+
+		if !ok {
+			return conflicts, errors.Join(
+				fmt.Errorf("unknown queued method %s", op.Method),
+				c.offline.store(ops[i:]),
+			)
+		}
+
+		req := method.newReq()
+
+		err = protobuf.Unmarshal(op.Request, req)
+
+		if err != nil {
+			return conflicts, errors.Join(
+				fmt.Errorf("cannot unmarshal queued %s request: %w", op.Method, err),
+				c.offline.store(ops[i:]),
+			)
+		}
+
+		err = c.conn.Invoke(ctx, op.Method, req, method.newRes())
+
+		switch {
+		case err == nil:
+		case isReplayConflict(err):
+			conflicts = append(conflicts, fmt.Sprintf("%s: %s", op.Method, status.Convert(err).Message()))
+		default:
+			return conflicts, errors.Join(
+				fmt.Errorf("cannot replay %s: %w", op.Method, err),
+				c.offline.store(ops[i:]),
+			)
+		}
+	}
+
+	return conflicts, c.offline.store(nil)
+}
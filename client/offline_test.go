@@ -0,0 +1,192 @@
+//go:build unit
+
+package client
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Tomap-Tomap/GophKeeper/crypto"
+	proto "github.com/Tomap-Tomap/GophKeeper/proto/gophkeeper/v1"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// unreachableAddr refuses connections, so every RPC against it fails with
+// Unavailable.
+const unreachableAddr = "localhost:1"
+
+// newOfflineTestCrypter builds a real crypter, so queued requests and the
+// queue file are actually sealed instead of matched against mock arguments.
+func newOfflineTestCrypter(t *testing.T) *crypto.Crypter {
+	t.Helper()
+
+	crypter, _, err := crypto.NewCrypter(32, t.TempDir())
+	require.NoError(t, err)
+
+	return crypter
+}
+
+// startOfflineTestServer serves a GophKeeperServerMockedObject on a free
+// port and stops it when the test ends.
+func startOfflineTestServer(t *testing.T) (string, *GophKeeperServerMockedObject) {
+	t.Helper()
+
+	lis, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer()
+	gpmo := new(GophKeeperServerMockedObject)
+
+	proto.RegisterGophKeeperServiceServer(server, gpmo)
+
+	go func() {
+		_ = server.Serve(lis)
+	}()
+
+	t.Cleanup(server.Stop)
+
+	return lis.Addr().String(), gpmo
+}
+
+func TestOfflineQueue(t *testing.T) {
+	queuePath := filepath.Join(t.TempDir(), "queue")
+	crypter := newOfflineTestCrypter(t)
+
+	offlineClient, err := New(crypter, unreachableAddr, WithOfflineQueue(queuePath))
+	require.NoError(t, err)
+	defer offlineClient.Close()
+
+	t.Run("writes are queued while offline", func(t *testing.T) {
+		require.NoError(t, offlineClient.CreatePassword(context.Background(), "testName", "testLogin", "testPassword", "testMeta"))
+		require.NoError(t, offlineClient.DeleteText(context.Background(), "testTextID"))
+
+		data, err := os.ReadFile(queuePath)
+		require.NoError(t, err)
+		require.NotContains(t, string(data), "CreatePassword")
+	})
+
+	t.Run("reads still fail while offline", func(t *testing.T) {
+		_, err := offlineClient.GetAllPasswords(context.Background())
+		require.Error(t, err)
+	})
+
+	t.Run("sync against an unavailable server keeps the queue", func(t *testing.T) {
+		_, err := offlineClient.Sync(context.Background())
+		require.ErrorContains(t, err, "cannot replay")
+
+		_, err = os.Stat(queuePath)
+		require.NoError(t, err)
+	})
+
+	t.Run("sync replays the queue in order", func(t *testing.T) {
+		addr, serverMock := startOfflineTestServer(t)
+
+		var replayed []string
+
+		serverMock.On("CreatePassword", mock.Anything).Return(&proto.CreatePasswordResponse{}, nil).Once().Run(func(_ mock.Arguments) {
+			replayed = append(replayed, "CreatePassword")
+		})
+		serverMock.On("DeleteText", mock.Anything).Return(nil, nil).Once().Run(func(_ mock.Arguments) {
+			replayed = append(replayed, "DeleteText")
+		})
+		defer serverMock.AssertExpectations(t)
+
+		onlineClient, err := New(crypter, addr, WithOfflineQueue(queuePath))
+		require.NoError(t, err)
+		defer onlineClient.Close()
+
+		conflicts, err := onlineClient.Sync(context.Background())
+		require.NoError(t, err)
+		require.Empty(t, conflicts)
+		require.Equal(t, []string{"CreatePassword", "DeleteText"}, replayed)
+
+		_, err = os.Stat(queuePath)
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
+
+func TestOfflineQueueConflict(t *testing.T) {
+	queuePath := filepath.Join(t.TempDir(), "queue")
+	crypter := newOfflineTestCrypter(t)
+
+	offlineClient, err := New(crypter, unreachableAddr, WithOfflineQueue(queuePath))
+	require.NoError(t, err)
+
+	require.NoError(t, offlineClient.DeletePassword(context.Background(), "testPasswordID"))
+	require.NoError(t, offlineClient.Close())
+
+	addr, serverMock := startOfflineTestServer(t)
+	// The server reports a delete of a missing entry as Unknown with an
+	// "unknown …ID" message, not NotFound; mock the real wording.
+	serverMock.On("DeletePassword", mock.Anything).Return(nil, status.Error(codes.Unknown, "unknown PasswordID testPasswordID")).Once()
+	defer serverMock.AssertExpectations(t)
+
+	onlineClient, err := New(crypter, addr, WithOfflineQueue(queuePath))
+	require.NoError(t, err)
+	defer onlineClient.Close()
+
+	conflicts, err := onlineClient.Sync(context.Background())
+	require.NoError(t, err)
+	require.Len(t, conflicts, 1)
+	require.Contains(t, conflicts[0], "unknown PasswordID")
+
+	_, err = os.Stat(queuePath)
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestOfflineQueueReplayFailure(t *testing.T) {
+	queuePath := filepath.Join(t.TempDir(), "queue")
+	crypter := newOfflineTestCrypter(t)
+
+	offlineClient, err := New(crypter, unreachableAddr, WithOfflineQueue(queuePath))
+	require.NoError(t, err)
+
+	require.NoError(t, offlineClient.CreateText(context.Background(), "testName", "testText", "testMeta"))
+	require.NoError(t, offlineClient.DeleteBank(context.Background(), "testBankID"))
+	require.NoError(t, offlineClient.Close())
+
+	addr, serverMock := startOfflineTestServer(t)
+
+	onlineClient, err := New(crypter, addr, WithOfflineQueue(queuePath))
+	require.NoError(t, err)
+	defer onlineClient.Close()
+
+	t.Run("failed replay keeps the failed and remaining operations", func(t *testing.T) {
+		serverMock.On("CreateText", mock.Anything).Return(nil, status.Error(codes.Internal, "test error")).Once()
+
+		_, err := onlineClient.Sync(context.Background())
+		require.ErrorContains(t, err, "cannot replay")
+
+		_, err = os.Stat(queuePath)
+		require.NoError(t, err)
+	})
+
+	t.Run("next sync replays both operations", func(t *testing.T) {
+		serverMock.On("CreateText", mock.Anything).Return(&proto.CreateTextResponse{}, nil).Once()
+		serverMock.On("DeleteBank", mock.Anything).Return(nil, nil).Once()
+		defer serverMock.AssertExpectations(t)
+
+		conflicts, err := onlineClient.Sync(context.Background())
+		require.NoError(t, err)
+		require.Empty(t, conflicts)
+
+		_, err = os.Stat(queuePath)
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
+
+func TestSyncWithoutOfflineQueue(t *testing.T) {
+	client, err := New(new(CrypterMockedObject), "testAddr")
+	require.NoError(t, err)
+	defer client.Close()
+
+	_, err = client.Sync(context.Background())
+	require.ErrorIs(t, err, ErrNoOfflineQueue)
+}
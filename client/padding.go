@@ -0,0 +1,97 @@
+package client
+
+import "strings"
+
+// padBucketSize is the bucket the padded plaintext length is rounded up to.
+const padBucketSize = 16
+
+// paddingMarker prefixes padded plaintext so that data sealed before padding
+// was enabled can still be opened unchanged.
+const paddingMarker = '\x01'
+
+// paddingCrypter decorates a Crypter by padding string fields to the next
+// padBucketSize multiple before sealing, so ciphertext length does not reveal
+// the plaintext length. All other operations delegate to the wrapped Crypter.
+type paddingCrypter struct {
+	Crypter
+}
+
+// newPaddingCrypter wraps the given Crypter with field-length padding.
+func newPaddingCrypter(crypter Crypter) *paddingCrypter {
+	return &paddingCrypter{
+		Crypter: crypter,
+	}
+}
+
+// SealStringWithoutNonce pads the string to the next bucket before sealing it.
+func (pc *paddingCrypter) SealStringWithoutNonce(str string) (string, error) {
+	return pc.Crypter.SealStringWithoutNonce(padString(str))
+}
+
+// OpenStringWithoutNonce opens the string and strips the padding when the
+// plaintext carries the padding marker.
+func (pc *paddingCrypter) OpenStringWithoutNonce(encryptStr string) (string, error) {
+	openStr, err := pc.Crypter.OpenStringWithoutNonce(encryptStr)
+
+	if err != nil {
+		return "", err
+	}
+
+	return unpadString(openStr), nil
+}
+
+// SealStringsWithoutNonce pads every string to the next bucket before sealing
+// the batch.
+func (pc *paddingCrypter) SealStringsWithoutNonce(strs []string) ([]string, error) {
+	padded := make([]string, len(strs))
+
+	for i, str := range strs {
+		padded[i] = padString(str)
+	}
+
+	return pc.Crypter.SealStringsWithoutNonce(padded)
+}
+
+// OpenStringsWithoutNonce opens the batch and strips the padding from every
+// element that carries the padding marker.
+func (pc *paddingCrypter) OpenStringsWithoutNonce(encryptStrs []string) ([]string, error) {
+	opened, err := pc.Crypter.OpenStringsWithoutNonce(encryptStrs)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for i, str := range opened {
+		opened[i] = unpadString(str)
+	}
+
+	return opened, nil
+}
+
+// padString prefixes str with paddingMarker and appends pad bytes, each
+// holding the pad count, up to the next padBucketSize multiple.
+func padString(str string) string {
+	padCount := padBucketSize - (len(str)+1)%padBucketSize
+
+	if padCount == 0 {
+		padCount = padBucketSize
+	}
+
+	return string(paddingMarker) + str + strings.Repeat(string(byte(padCount)), padCount)
+}
+
+// unpadString reverses padString. Strings without the marker are returned
+// unchanged, so data sealed before padding was enabled still opens.
+func unpadString(str string) string {
+	if len(str) == 0 || str[0] != paddingMarker {
+		return str
+	}
+
+	padCount := int(str[len(str)-1])
+
+	if padCount < 1 || padCount > padBucketSize || padCount > len(str)-1 {
+		return str
+	}
+
+	return str[1 : len(str)-padCount]
+}
@@ -0,0 +1,81 @@
+//go:build unit
+
+package client
+
+import (
+	"testing"
+
+	"github.com/Tomap-Tomap/GophKeeper/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_padString(t *testing.T) {
+	t.Run("pads to bucket multiple", func(t *testing.T) {
+		for _, str := range []string{"", "a", "shortPassword16", "exactlyBucketLen", "longerThanOneBucketPassword"} {
+			padded := padString(str)
+
+			require.Zero(t, len(padded)%padBucketSize)
+			require.Equal(t, str, unpadString(padded))
+		}
+	})
+
+	t.Run("unpadded string unchanged", func(t *testing.T) {
+		require.Equal(t, "testPassword", unpadString("testPassword"))
+	})
+
+	t.Run("invalid pad count unchanged", func(t *testing.T) {
+		str := string(paddingMarker) + "test" + string(byte(padBucketSize+1))
+		require.Equal(t, str, unpadString(str))
+	})
+}
+
+func TestPaddingCrypter(t *testing.T) {
+	crypter, _, err := crypto.NewCrypter(32, t.TempDir())
+	require.NoError(t, err)
+
+	pc := newPaddingCrypter(crypter)
+
+	t.Run("equal length ciphertext", func(t *testing.T) {
+		shortEnc, err := pc.SealStringWithoutNonce("short")
+		require.NoError(t, err)
+
+		longEnc, err := pc.SealStringWithoutNonce("muchLongerPwd")
+		require.NoError(t, err)
+
+		require.Len(t, longEnc, len(shortEnc))
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		enc, err := pc.SealStringWithoutNonce("testPassword")
+		require.NoError(t, err)
+
+		got, err := pc.OpenStringWithoutNonce(enc)
+		require.NoError(t, err)
+		require.Equal(t, "testPassword", got)
+	})
+
+	t.Run("opens data sealed without padding", func(t *testing.T) {
+		enc, err := crypter.SealStringWithoutNonce("testPassword")
+		require.NoError(t, err)
+
+		got, err := pc.OpenStringWithoutNonce(enc)
+		require.NoError(t, err)
+		require.Equal(t, "testPassword", got)
+	})
+
+	t.Run("open error", func(t *testing.T) {
+		_, err := pc.OpenStringWithoutNonce("notHex")
+		require.Error(t, err)
+	})
+}
+
+func TestWithFieldPadding(t *testing.T) {
+	client, err := New(new(CrypterMockedObject), "testAddr", WithFieldPadding())
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, client.Close())
+	}()
+
+	require.IsType(t, &paddingCrypter{}, client.crypter)
+}
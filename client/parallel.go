@@ -0,0 +1,53 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// mapParallel applies fn to every element of in across at most workers
+// goroutines, preserving input order in the result. Per-element errors are
+// joined in input order. A cancelled ctx stops dispatching further elements
+// and returns ctx.Err() instead of a partial result.
+func mapParallel[In, Out any](ctx context.Context, workers int, in []In, fn func(In) (Out, error)) ([]Out, error) {
+	if workers < 1 {
+		workers = 1
+	}
+
+	out := make([]Out, len(in))
+	errs := make([]error, len(in))
+	sem := make(chan struct{}, workers)
+
+	var wg sync.WaitGroup
+
+	for i := range in {
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func(i int) {
+			defer wg.Done()
+			defer func() {
+				<-sem
+			}()
+
+			if errs[i] = ctx.Err(); errs[i] != nil {
+				return
+			}
+
+			out[i], errs[i] = fn(in[i])
+		}(i)
+	}
+
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return out, errors.Join(errs...)
+}
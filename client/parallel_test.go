@@ -0,0 +1,142 @@
+//go:build unit
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/Tomap-Tomap/GophKeeper/crypto"
+	proto "github.com/Tomap-Tomap/GophKeeper/proto/gophkeeper/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_mapParallel(t *testing.T) {
+	t.Run("preserves order", func(t *testing.T) {
+		in := make([]int, 1000)
+
+		for i := range in {
+			in[i] = i
+		}
+
+		out, err := mapParallel(context.Background(), 8, in, func(v int) (string, error) {
+			return strconv.Itoa(v), nil
+		})
+
+		require.NoError(t, err)
+		require.Len(t, out, len(in))
+
+		for i, v := range out {
+			require.Equal(t, strconv.Itoa(i), v)
+		}
+	})
+
+	t.Run("aggregates errors", func(t *testing.T) {
+		in := []int{1, 2, 3, 4}
+
+		_, err := mapParallel(context.Background(), 2, in, func(v int) (int, error) {
+			if v%2 == 0 {
+				return 0, fmt.Errorf("cannot open %d", v)
+			}
+
+			return v, nil
+		})
+
+		require.ErrorContains(t, err, "cannot open 2")
+		require.ErrorContains(t, err, "cannot open 4")
+	})
+
+	t.Run("cancelled context stops mapping promptly", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var calls atomic.Int64
+
+		in := make([]int, 1000)
+
+		out, err := mapParallel(ctx, 1, in, func(int) (int, error) {
+			if calls.Add(1) == 10 {
+				cancel()
+			}
+
+			return 0, nil
+		})
+
+		require.ErrorIs(t, err, context.Canceled)
+		require.Nil(t, out)
+		require.Less(t, calls.Load(), int64(len(in)))
+	})
+
+	t.Run("bounds concurrency", func(t *testing.T) {
+		var current, max atomic.Int64
+
+		in := make([]int, 100)
+
+		_, err := mapParallel(context.Background(), 2, in, func(int) (int, error) {
+			cur := current.Add(1)
+			defer current.Add(-1)
+
+			for {
+				old := max.Load()
+				if cur <= old || max.CompareAndSwap(old, cur) {
+					break
+				}
+			}
+
+			return 0, nil
+		})
+
+		require.NoError(t, err)
+		require.LessOrEqual(t, max.Load(), int64(2))
+	})
+}
+
+func benchmarkGetAllPasswordsDecrypt(b *testing.B, workers int) {
+	crypter, _, err := crypto.NewCrypter(32, b.TempDir())
+	require.NoError(b, err)
+
+	client := &Client{
+		crypter:        crypter,
+		decryptWorkers: workers,
+	}
+
+	protoPwds := make([]*proto.Password, 1000)
+
+	for i := range protoPwds {
+		name, err := crypter.SealStringWithoutNonce(fmt.Sprintf("testName%d", i))
+		require.NoError(b, err)
+		login, err := crypter.SealStringWithoutNonce("testLogin")
+		require.NoError(b, err)
+		password, err := crypter.SealStringWithoutNonce("testPassword")
+		require.NoError(b, err)
+		meta, err := crypter.SealStringWithoutNonce("testMeta")
+		require.NoError(b, err)
+
+		protoPwds[i] = &proto.Password{
+			Name:     name,
+			Login:    login,
+			Password: password,
+			Meta:     meta,
+		}
+	}
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		pwds, err := mapParallel(context.Background(), client.decryptWorkers, protoPwds, client.openPassword)
+		require.NoError(b, err)
+		require.Len(b, pwds, len(protoPwds))
+	}
+}
+
+func BenchmarkGetAllPasswordsDecryptSequential(b *testing.B) {
+	benchmarkGetAllPasswordsDecrypt(b, 1)
+}
+
+func BenchmarkGetAllPasswordsDecryptParallel(b *testing.B) {
+	benchmarkGetAllPasswordsDecrypt(b, runtime.GOMAXPROCS(0))
+}
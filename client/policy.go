@@ -0,0 +1,72 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Entry type names accepted by WithEntryPolicy.
+const (
+	EntryPassword = "password"
+	EntryBank     = "bank"
+	EntryText     = "text"
+	EntryFile     = "file"
+)
+
+// FieldPolicy validates a plaintext field value before it is sealed, so
+// required-field rules can be enforced while the server still cannot read
+// the data.
+type FieldPolicy func(value string) error
+
+// NonEmpty requires the field to contain non-whitespace content.
+func NonEmpty() FieldPolicy {
+	return func(value string) error {
+		if strings.TrimSpace(value) == "" {
+			return errors.New("must not be empty")
+		}
+
+		return nil
+	}
+}
+
+// ValidURL requires the field to parse as an absolute URL.
+func ValidURL() FieldPolicy {
+	return func(value string) error {
+		u, err := url.Parse(value)
+
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return errors.New("must be a valid URL")
+		}
+
+		return nil
+	}
+}
+
+// policies holds the configured checks per entry type and field name.
+type policies map[string]map[string][]FieldPolicy
+
+func (p policies) add(entryType, field string, check FieldPolicy) {
+	if p[entryType] == nil {
+		p[entryType] = make(map[string][]FieldPolicy)
+	}
+
+	p[entryType][field] = append(p[entryType][field], check)
+}
+
+// check runs every check configured for the entry type against the given
+// plaintext fields and aggregates the failures.
+func (p policies) check(entryType string, fields map[string]string) error {
+	var errs []error
+
+	for field, checks := range p[entryType] {
+		for _, check := range checks {
+			if err := check(fields[field]); err != nil {
+				errs = append(errs, fmt.Errorf("%s %s: %w", entryType, field, err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,122 @@
+//go:build unit
+
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFieldPolicies(t *testing.T) {
+	tests := []struct {
+		name      string
+		check     FieldPolicy
+		value     string
+		expectErr string
+	}{
+		{
+			name:  "non empty accepts content",
+			check: NonEmpty(),
+			value: "testMeta",
+		},
+		{
+			name:      "non empty rejects blank",
+			check:     NonEmpty(),
+			value:     "   ",
+			expectErr: "must not be empty",
+		},
+		{
+			name:  "valid url accepts absolute url",
+			check: ValidURL(),
+			value: "https://example.com/login",
+		},
+		{
+			name:      "valid url rejects relative",
+			check:     ValidURL(),
+			value:     "example.com",
+			expectErr: "must be a valid URL",
+		},
+		{
+			name:      "valid url rejects garbage",
+			check:     ValidURL(),
+			value:     "://",
+			expectErr: "must be a valid URL",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.check(tt.value)
+
+			if tt.expectErr != "" {
+				require.ErrorContains(t, err, tt.expectErr)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestPoliciesCheck(t *testing.T) {
+	p := make(policies)
+	p.add(EntryPassword, "meta", NonEmpty())
+	p.add(EntryPassword, "meta", ValidURL())
+	p.add(EntryPassword, "name", NonEmpty())
+
+	t.Run("passing entry", func(t *testing.T) {
+		err := p.check(EntryPassword, map[string]string{
+			"name": "testName",
+			"meta": "https://example.com",
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("failing entry aggregates errors", func(t *testing.T) {
+		err := p.check(EntryPassword, map[string]string{
+			"name": "",
+			"meta": "",
+		})
+		require.ErrorContains(t, err, "password name: must not be empty")
+		require.ErrorContains(t, err, "password meta: must not be empty")
+		require.ErrorContains(t, err, "password meta: must be a valid URL")
+	})
+
+	t.Run("unconfigured type passes", func(t *testing.T) {
+		require.NoError(t, p.check(EntryText, map[string]string{
+			"name": "",
+		}))
+	})
+
+	t.Run("nil policies pass", func(t *testing.T) {
+		var none policies
+
+		require.NoError(t, none.check(EntryPassword, map[string]string{
+			"name": "",
+		}))
+	})
+}
+
+func TestWithEntryPolicy(t *testing.T) {
+	client, err := New(
+		new(CrypterMockedObject),
+		"testAddr",
+		WithEntryPolicy(EntryPassword, "meta", ValidURL()),
+		WithEntryPolicy(EntryText, "meta", NonEmpty()),
+	)
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, client.Close())
+	}()
+
+	err = client.CreatePassword(context.Background(), "testName", "testLogin", "testPassword", "not a url")
+	require.ErrorContains(t, err, "invalid password entry")
+	require.ErrorContains(t, err, "password meta: must be a valid URL")
+
+	err = client.CreateText(context.Background(), "testName", "testText", "")
+	require.ErrorContains(t, err, "invalid text entry")
+	require.ErrorContains(t, err, "text meta: must not be empty")
+}
@@ -0,0 +1,98 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Backoff bounds for the reconnect supervisor: the wait doubles from the
+// base on every failed attempt and never exceeds the cap.
+const (
+	reconnectBaseDelay = 100 * time.Millisecond
+	reconnectMaxDelay  = 5 * time.Second
+)
+
+// ReconnectStatus is one state change of the reconnect supervisor, passed
+// to the notify callback so a TUI can show what is happening.
+type ReconnectStatus struct {
+	// Attempt counts retries for the current call, starting at one.
+	Attempt int
+	// Wait is the backoff slept before the attempt.
+	Wait time.Duration
+	// Err is the Unavailable error that triggered the retry, or nil when
+	// Recovered is set.
+	Err error
+	// Recovered reports that the call went through after retrying.
+	Recovered bool
+}
+
+// reconnector retries unary calls that fail with Unavailable, backing off
+// exponentially between attempts.
+type reconnector struct {
+	notify      func(ReconnectStatus)
+	maxAttempts int
+}
+
+// report invokes the notify callback if one is configured.
+func (r *reconnector) report(s ReconnectStatus) {
+	if r.notify != nil {
+		r.notify(s)
+	}
+}
+
+// wait returns the backoff before the given attempt.
+func (r *reconnector) wait(attempt int) time.Duration {
+	wait := reconnectBaseDelay << (attempt - 1)
+
+	if wait > reconnectMaxDelay {
+		return reconnectMaxDelay
+	}
+
+	return wait
+}
+
+// interceptor retries the call while the server is unavailable. The auth
+// token lives in the token interceptor further down the chain, so a
+// recovered session continues without a re-login.
+func (r *reconnector) interceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	err := invoker(ctx, method, req, reply, cc, opts...)
+
+	if status.Code(err) != codes.Unavailable {
+		return err
+	}
+
+	for attempt := 1; attempt <= r.maxAttempts; attempt++ {
+		wait := r.wait(attempt)
+
+		r.report(ReconnectStatus{
+			Attempt: attempt,
+			Wait:    wait,
+			Err:     err,
+		})
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(wait):
+		}
+
+		err = invoker(ctx, method, req, reply, cc, opts...)
+
+		if status.Code(err) != codes.Unavailable {
+			if err == nil {
+				r.report(ReconnectStatus{
+					Attempt:   attempt,
+					Recovered: true,
+				})
+			}
+
+			return err
+		}
+	}
+
+	return err
+}
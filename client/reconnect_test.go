@@ -0,0 +1,110 @@
+//go:build unit
+
+package client
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	proto "github.com/Tomap-Tomap/GophKeeper/proto/gophkeeper/v1"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+func TestReconnect(t *testing.T) {
+	lis, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+
+	addr := lis.Addr().String()
+
+	gpmo := new(GophKeeperServerMockedObject)
+	gpmo.On("DeleteText", mock.Anything).Return(nil, nil)
+
+	server := grpc.NewServer()
+	proto.RegisterGophKeeperServiceServer(server, gpmo)
+
+	go func() {
+		_ = server.Serve(lis)
+	}()
+
+	var mu sync.Mutex
+	var statuses []ReconnectStatus
+
+	client, err := New(newOfflineTestCrypter(t), addr, WithReconnect(5, func(s ReconnectStatus) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		statuses = append(statuses, s)
+	}))
+	require.NoError(t, err)
+	defer client.Close()
+
+	require.NoError(t, client.DeleteText(context.Background(), "testTextID"))
+
+	server.Stop()
+
+	type restartResult struct {
+		server *grpc.Server
+		err    error
+	}
+
+	restarted := make(chan restartResult, 1)
+
+	go func() {
+		time.Sleep(300 * time.Millisecond)
+
+		lis, err := net.Listen("tcp", addr)
+
+		if err != nil {
+			restarted <- restartResult{err: err}
+			return
+		}
+
+		server := grpc.NewServer()
+		proto.RegisterGophKeeperServiceServer(server, gpmo)
+
+		go func() {
+			_ = server.Serve(lis)
+		}()
+
+		restarted <- restartResult{server: server}
+	}()
+
+	require.NoError(t, client.DeleteText(context.Background(), "testTextID"))
+
+	result := <-restarted
+	require.NoError(t, result.err)
+	t.Cleanup(result.server.Stop)
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	require.NotEmpty(t, statuses)
+
+	for _, s := range statuses[:len(statuses)-1] {
+		require.False(t, s.Recovered)
+		require.Error(t, s.Err)
+		require.NotZero(t, s.Wait)
+	}
+
+	last := statuses[len(statuses)-1]
+	require.True(t, last.Recovered)
+	require.NoError(t, last.Err)
+}
+
+func TestReconnectGivesUp(t *testing.T) {
+	var attempts int
+
+	client, err := New(newOfflineTestCrypter(t), unreachableAddr, WithReconnect(2, func(s ReconnectStatus) {
+		attempts++
+	}))
+	require.NoError(t, err)
+	defer client.Close()
+
+	require.Error(t, client.DeleteText(context.Background(), "testTextID"))
+	require.Equal(t, 2, attempts)
+}
@@ -0,0 +1,123 @@
+//go:build unit
+
+package client
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+
+	proto "github.com/Tomap-Tomap/GophKeeper/proto/gophkeeper/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// renameTestServer serves a fixed file listing and records every
+// UpdateFileInfo call, so tests can assert which entries a bulk rename
+// touched.
+type renameTestServer struct {
+	proto.UnimplementedGophKeeperServiceServer
+
+	mu      sync.Mutex
+	files   []*proto.File
+	updated map[string]*proto.UpdateFileInfoRequest
+}
+
+func (s *renameTestServer) GetFiles(_ context.Context, _ *proto.GetFilesRequest) (*proto.GetFilesResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return &proto.GetFilesResponse{FileInfo: s.files}, nil
+}
+
+func (s *renameTestServer) UpdateFileInfo(_ context.Context, req *proto.UpdateFileInfoRequest) (*proto.UpdateFileInfoResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.updated[req.Id] = req
+
+	return &proto.UpdateFileInfoResponse{Id: req.Id}, nil
+}
+
+func startRenameTestServer(t *testing.T) (string, *renameTestServer) {
+	t.Helper()
+
+	lis, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer()
+	rename := &renameTestServer{updated: make(map[string]*proto.UpdateFileInfoRequest)}
+
+	proto.RegisterGophKeeperServiceServer(server, rename)
+
+	go func() {
+		_ = server.Serve(lis)
+	}()
+
+	t.Cleanup(server.Stop)
+
+	return lis.Addr().String(), rename
+}
+
+func TestRenameFilesByPattern(t *testing.T) {
+	addr, server := startRenameTestServer(t)
+
+	client, err := New(newOfflineTestCrypter(t), addr)
+	require.NoError(t, err)
+	defer client.Close()
+
+	sealEntry := func(id, name string) *proto.File {
+		sealed, err := client.sealFile(name, "testMeta")
+		require.NoError(t, err)
+
+		return &proto.File{Id: id, Name: sealed.Name, Meta: sealed.Meta}
+	}
+
+	server.files = []*proto.File{
+		sealEntry("id1", "report-jan.txt"),
+		sealEntry("id2", "report-feb.txt"),
+		sealEntry("id3", "notes.txt"),
+	}
+
+	t.Run("only matching files are renamed", func(t *testing.T) {
+		renamed, err := client.RenameFilesByPattern(context.Background(), "^report-", "archive-")
+		require.NoError(t, err)
+		require.Equal(t, 2, renamed)
+
+		server.mu.Lock()
+		defer server.mu.Unlock()
+
+		require.Len(t, server.updated, 2)
+		require.NotContains(t, server.updated, "id3")
+
+		name, err := client.crypter.OpenStringWithoutNonce(server.updated["id1"].Name)
+		require.NoError(t, err)
+		require.Equal(t, "archive-jan.txt", name)
+
+		name, err = client.crypter.OpenStringWithoutNonce(server.updated["id2"].Name)
+		require.NoError(t, err)
+		require.Equal(t, "archive-feb.txt", name)
+	})
+
+	t.Run("no matches leaves everything untouched", func(t *testing.T) {
+		server.mu.Lock()
+		server.updated = make(map[string]*proto.UpdateFileInfoRequest)
+		server.mu.Unlock()
+
+		renamed, err := client.RenameFilesByPattern(context.Background(), "^missing-", "other-")
+		require.NoError(t, err)
+		require.Zero(t, renamed)
+
+		server.mu.Lock()
+		defer server.mu.Unlock()
+
+		require.Empty(t, server.updated)
+	})
+
+	t.Run("invalid pattern", func(t *testing.T) {
+		renamed, err := client.RenameFilesByPattern(context.Background(), "(", "x")
+		require.ErrorContains(t, err, "cannot compile pattern")
+		require.Zero(t, renamed)
+	})
+}
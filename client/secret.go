@@ -0,0 +1,102 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	proto "github.com/Tomap-Tomap/GophKeeper/proto/gophkeeper/v1"
+	"github.com/Tomap-Tomap/GophKeeper/storage"
+)
+
+// secretMask is what a SecretString prints instead of its value.
+const secretMask = "***"
+
+// SecretString wraps a secret so it cannot leak through logging by accident.
+// Formatting a SecretString with the fmt package yields only a mask; the
+// plaintext is available through an explicit Reveal call.
+type SecretString struct {
+	value string
+}
+
+// NewSecretString wraps the given plaintext in a SecretString.
+func NewSecretString(value string) SecretString {
+	return SecretString{value: value}
+}
+
+// String masks the secret, so %v and %s print the mask.
+func (s SecretString) String() string {
+	return secretMask
+}
+
+// GoString masks the secret, so %#v prints the mask too.
+func (s SecretString) GoString() string {
+	return secretMask
+}
+
+// Reveal returns the wrapped plaintext.
+func (s SecretString) Reveal() string {
+	return s.value
+}
+
+// SecretPassword is a password entry whose secret is wrapped in a
+// SecretString. It mirrors storage.Password otherwise.
+type SecretPassword struct {
+	ID       string
+	Name     string
+	Login    string
+	Password SecretString
+	Meta     string
+	UpdateAt time.Time
+}
+
+// newSecretPassword wraps the password field of the opened entry.
+func newSecretPassword(pwd storage.Password) SecretPassword {
+	return SecretPassword{
+		ID:       pwd.ID,
+		Name:     pwd.Name,
+		Login:    pwd.Login,
+		Password: NewSecretString(pwd.Password),
+		Meta:     pwd.Meta,
+		UpdateAt: pwd.UpdateAt,
+	}
+}
+
+// GetSecretPassword retrieves one password entry with its secret wrapped in
+// a SecretString. Callers opt into masked returns by using the Secret
+// retrieval methods instead of GetAllPasswords.
+func (c *Client) GetSecretPassword(ctx context.Context, id string) (SecretPassword, error) {
+	res, err := c.grpc.GetPassword(ctx, &proto.GetPasswordRequest{
+		Id: id,
+	})
+
+	if err != nil {
+		return SecretPassword{}, fmt.Errorf("cannot get password: %w", err)
+	}
+
+	pwd, err := c.openPassword(res.GetPassword())
+
+	if err != nil {
+		return SecretPassword{}, fmt.Errorf("cannot open password data: %w", err)
+	}
+
+	return newSecretPassword(pwd), nil
+}
+
+// GetAllSecretPasswords retrieves all stored passwords with their secrets
+// wrapped in SecretStrings.
+func (c *Client) GetAllSecretPasswords(ctx context.Context) ([]SecretPassword, error) {
+	pwds, err := c.GetAllPasswords(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	secrets := make([]SecretPassword, 0, len(pwds))
+
+	for _, v := range pwds {
+		secrets = append(secrets, newSecretPassword(v))
+	}
+
+	return secrets, nil
+}
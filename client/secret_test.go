@@ -0,0 +1,132 @@
+//go:build unit
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	proto "github.com/Tomap-Tomap/GophKeeper/proto/gophkeeper/v1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestSecretString(t *testing.T) {
+	secret := NewSecretString("plaintext")
+
+	t.Run("fmt never prints the plaintext", func(t *testing.T) {
+		for _, verb := range []string{"%v", "%+v", "%#v", "%s"} {
+			assert.Equal(t, secretMask, fmt.Sprintf(verb, secret))
+		}
+	})
+
+	t.Run("reveal returns the plaintext", func(t *testing.T) {
+		assert.Equal(t, "plaintext", secret.Reveal())
+	})
+
+	t.Run("wrapping struct prints the mask", func(t *testing.T) {
+		pwd := SecretPassword{Login: "testLogin", Password: secret}
+
+		s := fmt.Sprintf("%+v", pwd)
+		assert.NotContains(t, s, "plaintext")
+		assert.Contains(t, s, secretMask)
+	})
+}
+
+func (suite *ClientTestSuite) TestGetSecretPassword() {
+	require := suite.Require()
+
+	req := &proto.GetPasswordRequest{
+		Id: suite.testPasswordID,
+	}
+
+	res := &proto.GetPasswordResponse{
+		Password: &proto.Password{
+			Id:       suite.testPasswordID,
+			Name:     suite.testName,
+			Login:    suite.testLogin,
+			Password: suite.testPassword,
+			Meta:     suite.testMeta,
+			UpdateAt: timestamppb.New(suite.testUpdateAt),
+		},
+	}
+
+	suite.Run("service error", func() {
+		suite.serverMock.onGetPassword(req, nil, errTest)
+
+		pwd, err := suite.client.GetSecretPassword(context.Background(), suite.testPasswordID)
+		require.ErrorContains(err, "cannot get password")
+		suite.Empty(pwd)
+	})
+
+	suite.Run("cannot open password data", func() {
+		suite.serverMock.onGetPassword(req, res, nil)
+
+		suite.crypterMock.onOpenStringWithoutNonce(suite.testName, "", errTest)
+		suite.crypterMock.onOpenStringWithoutNonce(suite.testLogin, "", errTest)
+		suite.crypterMock.onOpenStringWithoutNonce(suite.testPassword, "", errTest)
+		suite.crypterMock.onOpenStringWithoutNonce(suite.testMeta, "", errTest)
+
+		pwd, err := suite.client.GetSecretPassword(context.Background(), suite.testPasswordID)
+		require.ErrorContains(err, "cannot open password data")
+		suite.Empty(pwd)
+	})
+
+	suite.Run("positive test", func() {
+		suite.serverMock.onGetPassword(req, res, nil)
+
+		suite.crypterMock.onOpenStringWithoutNonce(suite.testName, suite.testName, nil)
+		suite.crypterMock.onOpenStringWithoutNonce(suite.testLogin, suite.testLogin, nil)
+		suite.crypterMock.onOpenStringWithoutNonce(suite.testPassword, suite.testPassword, nil)
+		suite.crypterMock.onOpenStringWithoutNonce(suite.testMeta, suite.testMeta, nil)
+
+		pwd, err := suite.client.GetSecretPassword(context.Background(), suite.testPasswordID)
+		require.NoError(err)
+
+		suite.Equal(suite.testLogin, pwd.Login)
+		suite.Equal(suite.testPassword, pwd.Password.Reveal())
+		suite.Equal(secretMask, fmt.Sprint(pwd.Password))
+	})
+}
+
+func (suite *ClientTestSuite) TestGetAllSecretPasswords() {
+	require := suite.Require()
+
+	reses := &proto.GetPasswordsResponse{
+		Passwords: []*proto.Password{
+			{
+				Id:       suite.testPasswordID,
+				Name:     suite.testName,
+				Login:    suite.testLogin,
+				Password: suite.testPassword,
+				Meta:     suite.testMeta,
+				UpdateAt: timestamppb.New(suite.testUpdateAt),
+			},
+		},
+	}
+
+	suite.Run("service error", func() {
+		suite.serverMock.onGetPasswords(nil, errTest)
+
+		pwds, err := suite.client.GetAllSecretPasswords(context.Background())
+		require.ErrorContains(err, "cannot get passwords")
+		suite.Nil(pwds)
+	})
+
+	suite.Run("positive test", func() {
+		suite.serverMock.onGetPasswords(reses, nil)
+
+		suite.crypterMock.onOpenStringWithoutNonce(suite.testName, suite.testName, nil)
+		suite.crypterMock.onOpenStringWithoutNonce(suite.testLogin, suite.testLogin, nil)
+		suite.crypterMock.onOpenStringWithoutNonce(suite.testPassword, suite.testPassword, nil)
+		suite.crypterMock.onOpenStringWithoutNonce(suite.testMeta, suite.testMeta, nil)
+
+		pwds, err := suite.client.GetAllSecretPasswords(context.Background())
+		require.NoError(err)
+		require.Len(pwds, 1)
+
+		suite.Equal(suite.testPassword, pwds[0].Password.Reveal())
+		suite.Equal(secretMask, fmt.Sprint(pwds[0].Password))
+	})
+}
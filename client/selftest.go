@@ -0,0 +1,85 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	proto "github.com/Tomap-Tomap/GophKeeper/proto/gophkeeper/v1"
+)
+
+// Fixed content of the throwaway entry created by SelfTest. The entry is
+// created as a fresh row, matched only by the ID the server returned and
+// deleted before SelfTest returns, so real data is never touched.
+const (
+	selfTestName     = "gophkeeper-selftest"
+	selfTestLogin    = "selftest"
+	selfTestPassword = "selftest-password"
+	selfTestMeta     = "temporary self test entry"
+)
+
+// SelfTest verifies connectivity and crypto end-to-end: it seals and creates
+// a throwaway password entry with a known value, reads it back, checks the
+// decrypted fields match and deletes it. It returns the names of the steps
+// that completed ("seal", "create", "read", "verify", "delete") alongside
+// the error of the step that failed, which pinpoints misconfigured key
+// files, a wrong server address or an expired token in one call.
+func (c *Client) SelfTest(ctx context.Context) (steps []string, err error) {
+	sealed, err := c.sealPassword(selfTestName, selfTestLogin, selfTestPassword, selfTestMeta)
+
+	if err != nil {
+		return steps, fmt.Errorf("cannot seal password: %w", err)
+	}
+
+	steps = append(steps, "seal")
+
+	created, err := c.grpc.CreatePassword(ctx, &proto.CreatePasswordRequest{
+		Name:     sealed.Name,
+		Login:    sealed.Login,
+		Password: sealed.Password,
+		Meta:     sealed.Meta,
+	})
+
+	if err != nil {
+		return steps, fmt.Errorf("cannot create password: %w", err)
+	}
+
+	steps = append(steps, "create")
+
+	defer func() {
+		_, derr := c.grpc.DeletePassword(ctx, &proto.DeletePasswordRequest{
+			Id: created.GetId(),
+		})
+
+		if derr != nil {
+			err = errors.Join(err, fmt.Errorf("cannot delete password: %w", derr))
+			return
+		}
+
+		steps = append(steps, "delete")
+	}()
+
+	res, err := c.grpc.GetPassword(ctx, &proto.GetPasswordRequest{
+		Id: created.GetId(),
+	})
+
+	if err != nil {
+		return steps, fmt.Errorf("cannot get password: %w", err)
+	}
+
+	steps = append(steps, "read")
+
+	pwd, err := c.openPassword(res.GetPassword())
+
+	if err != nil {
+		return steps, fmt.Errorf("cannot open password data: %w", err)
+	}
+
+	if pwd.Name != selfTestName || pwd.Login != selfTestLogin || pwd.Password != selfTestPassword || pwd.Meta != selfTestMeta {
+		return steps, errors.New("decrypted entry does not match the stored value")
+	}
+
+	steps = append(steps, "verify")
+
+	return steps, nil
+}
@@ -0,0 +1,101 @@
+//go:build unit
+
+package client
+
+import (
+	"context"
+
+	proto "github.com/Tomap-Tomap/GophKeeper/proto/gophkeeper/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (suite *ClientTestSuite) TestSelfTest() {
+	require := suite.Require()
+
+	createReq := &proto.CreatePasswordRequest{
+		Name:     selfTestName,
+		Login:    selfTestLogin,
+		Password: selfTestPassword,
+		Meta:     selfTestMeta,
+	}
+
+	getReq := &proto.GetPasswordRequest{
+		Id: suite.testPasswordID,
+	}
+
+	getRes := &proto.GetPasswordResponse{
+		Password: &proto.Password{
+			Id:       suite.testPasswordID,
+			Name:     selfTestName,
+			Login:    selfTestLogin,
+			Password: selfTestPassword,
+			Meta:     selfTestMeta,
+			UpdateAt: timestamppb.New(suite.testUpdateAt),
+		},
+	}
+
+	deleteReq := &proto.DeletePasswordRequest{
+		Id: suite.testPasswordID,
+	}
+
+	sealSelfTestEntry := func(retErr error) {
+		suite.crypterMock.onSealStringWithoutNonce(selfTestName, selfTestName, retErr)
+		suite.crypterMock.onSealStringWithoutNonce(selfTestLogin, selfTestLogin, retErr)
+		suite.crypterMock.onSealStringWithoutNonce(selfTestPassword, selfTestPassword, retErr)
+		suite.crypterMock.onSealStringWithoutNonce(selfTestMeta, selfTestMeta, retErr)
+	}
+
+	suite.Run("seal password error", func() {
+		suite.crypterMock.onSealStringWithoutNonce(selfTestName, "", errTest)
+		suite.crypterMock.onSealStringWithoutNonce(selfTestLogin, "", errTest)
+		suite.crypterMock.onSealStringWithoutNonce(selfTestPassword, "", errTest)
+		suite.crypterMock.onSealStringWithoutNonce(selfTestMeta, "", errTest)
+
+		steps, err := suite.client.SelfTest(context.Background())
+		require.ErrorContains(err, "cannot seal password")
+		suite.Empty(steps)
+	})
+
+	suite.Run("create error", func() {
+		sealSelfTestEntry(nil)
+		suite.serverMock.onCreatePassword(createReq, nil, errTest)
+
+		steps, err := suite.client.SelfTest(context.Background())
+		require.ErrorContains(err, "cannot create password")
+		suite.Equal([]string{"seal"}, steps)
+	})
+
+	suite.Run("open error still deletes the entry", func() {
+		sealSelfTestEntry(nil)
+		suite.serverMock.onCreatePassword(createReq, &proto.CreatePasswordResponse{Id: suite.testPasswordID}, nil)
+		suite.serverMock.onGetPassword(getReq, getRes, nil)
+
+		suite.crypterMock.onOpenStringWithoutNonce(selfTestName, "", errTest)
+		suite.crypterMock.onOpenStringWithoutNonce(selfTestLogin, "", errTest)
+		suite.crypterMock.onOpenStringWithoutNonce(selfTestPassword, "", errTest)
+		suite.crypterMock.onOpenStringWithoutNonce(selfTestMeta, "", errTest)
+
+		suite.serverMock.onDeletePassword(deleteReq, nil)
+
+		steps, err := suite.client.SelfTest(context.Background())
+		require.ErrorContains(err, "cannot open password data")
+		suite.Equal([]string{"seal", "create", "read", "delete"}, steps)
+	})
+
+	suite.Run("positive test", func() {
+		sealSelfTestEntry(nil)
+		suite.serverMock.onCreatePassword(createReq, &proto.CreatePasswordResponse{Id: suite.testPasswordID}, nil)
+		suite.serverMock.onGetPassword(getReq, getRes, nil)
+
+		suite.crypterMock.onOpenStringWithoutNonce(selfTestName, selfTestName, nil)
+		suite.crypterMock.onOpenStringWithoutNonce(selfTestLogin, selfTestLogin, nil)
+		suite.crypterMock.onOpenStringWithoutNonce(selfTestPassword, selfTestPassword, nil)
+		suite.crypterMock.onOpenStringWithoutNonce(selfTestMeta, selfTestMeta, nil)
+
+		suite.serverMock.onDeletePassword(deleteReq, nil)
+
+		steps, err := suite.client.SelfTest(context.Background())
+		require.NoError(err)
+		suite.Equal([]string{"seal", "create", "read", "verify", "delete"}, steps)
+	})
+}
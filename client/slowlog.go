@@ -0,0 +1,38 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+// WithSlowCallLog makes the client log every unary call that takes longer
+// than threshold with the method name and duration, helping users diagnose
+// a slow server or network. A nil log falls back to the package logger; a
+// non-positive threshold disables the instrumentation.
+func WithSlowCallLog(log *zap.Logger, threshold time.Duration) Option {
+	return func(o *options) {
+		o.slowCallLog = log
+		o.slowCallThreshold = threshold
+	}
+}
+
+// slowCallUnary logs unary calls whose total duration exceeds threshold.
+func slowCallUnary(log *zap.Logger, threshold time.Duration) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		start := time.Now()
+
+		err := invoker(ctx, method, req, reply, cc, opts...)
+
+		if elapsed := time.Since(start); elapsed > threshold {
+			log.Warn("Slow call",
+				zap.String("method", method),
+				zap.Duration("duration", elapsed),
+			)
+		}
+
+		return err
+	}
+}
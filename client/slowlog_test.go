@@ -0,0 +1,84 @@
+//go:build unit
+
+package client
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	proto "github.com/Tomap-Tomap/GophKeeper/proto/gophkeeper/v1"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/grpc"
+)
+
+// slowTestServer delays GetChunkSize, so calls through the client exceed a
+// short slow-call threshold.
+type slowTestServer struct {
+	proto.UnimplementedGophKeeperServiceServer
+
+	delay time.Duration
+}
+
+func (s *slowTestServer) GetChunkSize(_ context.Context, _ *proto.GetChunkSizeRequest) (*proto.GetChunkSizeResponse, error) {
+	time.Sleep(s.delay)
+
+	return &proto.GetChunkSizeResponse{Size: 1024}, nil
+}
+
+func startSlowTestServer(t *testing.T, delay time.Duration) string {
+	t.Helper()
+
+	lis, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer()
+
+	proto.RegisterGophKeeperServiceServer(server, &slowTestServer{delay: delay})
+
+	go func() {
+		_ = server.Serve(lis)
+	}()
+
+	t.Cleanup(server.Stop)
+
+	return lis.Addr().String()
+}
+
+func TestWithSlowCallLog(t *testing.T) {
+	addr := startSlowTestServer(t, 50*time.Millisecond)
+
+	t.Run("slow call is logged", func(t *testing.T) {
+		core, logs := observer.New(zap.WarnLevel)
+
+		client, err := New(newOfflineTestCrypter(t), addr, WithSlowCallLog(zap.New(core), 10*time.Millisecond))
+		require.NoError(t, err)
+		defer client.Close()
+
+		_, err = client.getChunkSize(context.Background())
+		require.NoError(t, err)
+
+		entries := logs.FilterMessage("Slow call").All()
+		require.Len(t, entries, 1)
+
+		fields := entries[0].ContextMap()
+		require.Equal(t, proto.GophKeeperService_GetChunkSize_FullMethodName, fields["method"])
+		require.GreaterOrEqual(t, fields["duration"].(time.Duration), 50*time.Millisecond)
+	})
+
+	t.Run("call under the threshold is not logged", func(t *testing.T) {
+		core, logs := observer.New(zap.WarnLevel)
+
+		client, err := New(newOfflineTestCrypter(t), addr, WithSlowCallLog(zap.New(core), 5*time.Second))
+		require.NoError(t, err)
+		defer client.Close()
+
+		_, err = client.getChunkSize(context.Background())
+		require.NoError(t, err)
+
+		require.Empty(t, logs.FilterMessage("Slow call").All())
+	})
+}
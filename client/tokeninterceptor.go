@@ -0,0 +1,151 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	proto "github.com/Tomap-Tomap/GophKeeper/proto/gophkeeper/v1"
+	"golang.org/x/sync/singleflight"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const tokenHeader = "authorization"
+
+type tokenInterceptor struct {
+	mu    sync.RWMutex
+	token string
+	store TokenStore
+
+	// refresh mints a fresh access token after an Unauthenticated
+	// response; nil disables the refresh-and-retry flow. Calls racing
+	// on the same stale token share one refresh via sf.
+	refresh func(ctx context.Context) (string, error)
+	sf      singleflight.Group
+}
+
+func newTokenInterceptor() *tokenInterceptor {
+	return &tokenInterceptor{}
+}
+
+func (ti *tokenInterceptor) setToken(token string) {
+	ti.mu.Lock()
+	defer ti.mu.Unlock()
+
+	ti.token = fmt.Sprintf("Bearer %s", token)
+}
+
+// currentToken reads the token under the lock, so in-flight calls see
+// either the old or the new token during a swap, never a torn value.
+func (ti *tokenInterceptor) currentToken() string {
+	ti.mu.RLock()
+	defer ti.mu.RUnlock()
+
+	return ti.token
+}
+
+func (ti *tokenInterceptor) saveToken(token string) error {
+	ti.setToken(token)
+
+	if ti.store == nil {
+		return nil
+	}
+
+	if err := ti.store.Save(token); err != nil {
+		return fmt.Errorf("cannot save token: %w", err)
+	}
+
+	return nil
+}
+
+func (ti *tokenInterceptor) clearToken() error {
+	ti.mu.Lock()
+	ti.token = ""
+	ti.mu.Unlock()
+
+	if ti.store == nil {
+		return nil
+	}
+
+	if err := ti.store.Clear(); err != nil {
+		return fmt.Errorf("cannot clear token: %w", err)
+	}
+
+	return nil
+}
+
+// refreshOnce swaps the stale token for a freshly minted one. Calls that
+// raced on the same stale token share one refresh call, and a call whose
+// token was already swapped by another refresh skips minting entirely.
+func (ti *tokenInterceptor) refreshOnce(ctx context.Context, staleToken string) error {
+	_, err, _ := ti.sf.Do("refresh", func() (any, error) {
+		if ti.currentToken() != staleToken {
+			return nil, nil
+		}
+
+		token, err := ti.refresh(ctx)
+
+		if err != nil {
+			return nil, fmt.Errorf("cannot refresh token: %w", err)
+		}
+
+		return nil, ti.saveToken(token)
+	})
+
+	return err
+}
+
+// invokeWithToken invokes the call with the given token attached.
+func (ti *tokenInterceptor) invokeWithToken(ctx context.Context, token, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if len(token) != 0 {
+		ctx = metadata.AppendToOutgoingContext(
+			ctx,
+			tokenHeader, token,
+		)
+	}
+
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+func (ti *tokenInterceptor) interceptorAddTokenUnary(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	token := ti.currentToken()
+
+	err := ti.invokeWithToken(ctx, token, method, req, reply, cc, invoker, opts...)
+
+	if ti.refresh != nil && status.Code(err) == codes.Unauthenticated {
+		if rErr := ti.refreshOnce(ctx, token); rErr != nil {
+			return errors.Join(err, rErr)
+		}
+
+		err = ti.invokeWithToken(ctx, ti.currentToken(), method, req, reply, cc, invoker, opts...)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	switch r := reply.(type) {
+	case *proto.AuthResponse:
+		err = ti.saveToken(r.GetToken())
+	case *proto.RegisterResponse:
+		err = ti.saveToken(r.GetToken())
+	default:
+	}
+
+	return err
+}
+
+func (ti *tokenInterceptor) interceptorAddTokenStream(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	if token := ti.currentToken(); len(token) != 0 {
+		ctx = metadata.AppendToOutgoingContext(
+			ctx,
+			tokenHeader, token,
+		)
+	}
+
+	return streamer(ctx, desc, cc, method, opts...)
+}
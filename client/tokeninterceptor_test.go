@@ -0,0 +1,96 @@
+//go:build unit
+
+package client
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+
+	proto "github.com/Tomap-Tomap/GophKeeper/proto/gophkeeper/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// authEchoTestServer answers Register and Auth with fixed tokens and
+// accepts DeleteText regardless of the token, so concurrent token swaps
+// and interceptor reads can run against the real client chain.
+type authEchoTestServer struct {
+	proto.UnimplementedGophKeeperServiceServer
+}
+
+func (s *authEchoTestServer) Register(_ context.Context, _ *proto.RegisterRequest) (*proto.RegisterResponse, error) {
+	return &proto.RegisterResponse{Token: "registerToken"}, nil
+}
+
+func (s *authEchoTestServer) Auth(_ context.Context, _ *proto.AuthRequest) (*proto.AuthResponse, error) {
+	return &proto.AuthResponse{Token: "authToken"}, nil
+}
+
+func (s *authEchoTestServer) DeleteText(_ context.Context, _ *proto.DeleteTextRequest) (*proto.DeleteTextResponse, error) {
+	return &proto.DeleteTextResponse{}, nil
+}
+
+func startAuthEchoTestServer(t *testing.T) string {
+	t.Helper()
+
+	lis, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer()
+
+	proto.RegisterGophKeeperServiceServer(server, &authEchoTestServer{})
+
+	go func() {
+		_ = server.Serve(lis)
+	}()
+
+	t.Cleanup(server.Stop)
+
+	return lis.Addr().String()
+}
+
+// TestTokenInterceptorConcurrency hammers the shared tokenInterceptor with
+// concurrent Register and SignIn token swaps and interceptor reads. It is
+// meant to run under the race detector, where any unguarded access to the
+// token field fails the run.
+func TestTokenInterceptorConcurrency(t *testing.T) {
+	addr := startAuthEchoTestServer(t)
+
+	client, err := New(newOfflineTestCrypter(t), addr)
+	require.NoError(t, err)
+	defer client.Close()
+
+	const workers = 10
+
+	var wg sync.WaitGroup
+	errs := make([]error, workers*3)
+
+	for i := 0; i < workers; i++ {
+		wg.Add(3)
+
+		go func(i int) {
+			defer wg.Done()
+			errs[i*3] = client.Register(context.Background(), "testLogin", "testPassword")
+		}(i)
+
+		go func(i int) {
+			defer wg.Done()
+			errs[i*3+1] = client.SignIn(context.Background(), "testLogin", "testPassword")
+		}(i)
+
+		go func(i int) {
+			defer wg.Done()
+			errs[i*3+2] = client.DeleteText(context.Background(), "testTextID")
+		}(i)
+	}
+
+	wg.Wait()
+
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+
+	require.Contains(t, []string{"Bearer registerToken", "Bearer authToken"}, client.ti.currentToken())
+}
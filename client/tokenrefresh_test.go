@@ -0,0 +1,121 @@
+//go:build unit
+
+package client
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	proto "github.com/Tomap-Tomap/GophKeeper/proto/gophkeeper/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// refreshTestServer rejects DeleteText with Unauthenticated unless the
+// call carries the fresh token, so the test can drive the client's
+// refresh-and-retry flow.
+type refreshTestServer struct {
+	proto.UnimplementedGophKeeperServiceServer
+}
+
+func (s *refreshTestServer) DeleteText(ctx context.Context, _ *proto.DeleteTextRequest) (*proto.DeleteTextResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	if vals := md.Get(tokenHeader); len(vals) == 0 || vals[0] != "Bearer freshToken" {
+		return nil, status.Error(codes.Unauthenticated, "stale token")
+	}
+
+	return &proto.DeleteTextResponse{}, nil
+}
+
+func startRefreshTestServer(t *testing.T) string {
+	t.Helper()
+
+	lis, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer()
+
+	proto.RegisterGophKeeperServiceServer(server, &refreshTestServer{})
+
+	go func() {
+		_ = server.Serve(lis)
+	}()
+
+	t.Cleanup(server.Stop)
+
+	return lis.Addr().String()
+}
+
+func TestWithTokenRefresh(t *testing.T) {
+	addr := startRefreshTestServer(t)
+
+	t.Run("concurrent stale calls share one refresh", func(t *testing.T) {
+		var refreshes atomic.Int64
+
+		client, err := New(newOfflineTestCrypter(t), addr, WithTokenRefresh(func(_ context.Context) (string, error) {
+			refreshes.Add(1)
+			return "freshToken", nil
+		}))
+		require.NoError(t, err)
+		defer client.Close()
+
+		client.ti.setToken("staleToken")
+
+		const callers = 20
+
+		var wg sync.WaitGroup
+		errs := make([]error, callers)
+
+		for i := 0; i < callers; i++ {
+			wg.Add(1)
+
+			go func(i int) {
+				defer wg.Done()
+				errs[i] = client.DeleteText(context.Background(), "testTextID")
+			}(i)
+		}
+
+		wg.Wait()
+
+		for _, err := range errs {
+			require.NoError(t, err)
+		}
+
+		require.EqualValues(t, 1, refreshes.Load())
+	})
+
+	t.Run("refresh error joins the original one", func(t *testing.T) {
+		errRefresh := errors.New("refresh broke")
+
+		client, err := New(newOfflineTestCrypter(t), addr, WithTokenRefresh(func(_ context.Context) (string, error) {
+			return "", errRefresh
+		}))
+		require.NoError(t, err)
+		defer client.Close()
+
+		client.ti.setToken("staleToken")
+
+		err = client.DeleteText(context.Background(), "testTextID")
+		require.ErrorIs(t, err, errRefresh)
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("no refresh configured keeps the error", func(t *testing.T) {
+		client, err := New(newOfflineTestCrypter(t), addr)
+		require.NoError(t, err)
+		defer client.Close()
+
+		client.ti.setToken("staleToken")
+
+		err = client.DeleteText(context.Background(), "testTextID")
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
@@ -0,0 +1,100 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// TokenStore persists the auth token between runs, so restarting the TUI
+// does not force a re-login. An OS-keyring-backed implementation can be
+// injected via WithTokenStore; FileTokenStore is the built-in fallback.
+type TokenStore interface {
+	Save(token string) error
+	Load() (string, error)
+	Clear() error
+}
+
+// FileTokenStore stores the token in a file, sealed with the client's
+// Crypter so the token never touches disk in plaintext.
+type FileTokenStore struct {
+	crypter Crypter
+	path    string
+}
+
+// DefaultTokenPath returns the well-known token file location under the
+// user's configuration directory. A login command saving the token there
+// lets subsequent non-interactive commands reuse the session without
+// re-authenticating.
+func DefaultTokenPath() (string, error) {
+	dir, err := os.UserConfigDir()
+
+	if err != nil {
+		return "", fmt.Errorf("cannot determine user config directory: %w", err)
+	}
+
+	return filepath.Join(dir, "gophkeeper", "token"), nil
+}
+
+// NewFileTokenStore creates a FileTokenStore writing to the given path.
+func NewFileTokenStore(crypter Crypter, path string) *FileTokenStore {
+	return &FileTokenStore{
+		crypter: crypter,
+		path:    path,
+	}
+}
+
+// Save seals the token and writes it to the store's file.
+func (fts *FileTokenStore) Save(token string) error {
+	sealToken, err := fts.crypter.SealStringWithoutNonce(token)
+
+	if err != nil {
+		return fmt.Errorf("cannot seal token: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(fts.path), 0o700); err != nil {
+		return fmt.Errorf("cannot create token directory: %w", err)
+	}
+
+	err = os.WriteFile(fts.path, []byte(sealToken), 0o600)
+
+	if err != nil {
+		return fmt.Errorf("cannot write token file: %w", err)
+	}
+
+	return nil
+}
+
+// Load reads and opens the persisted token. A missing file is not an
+// error; it returns an empty token.
+func (fts *FileTokenStore) Load() (string, error) {
+	sealToken, err := os.ReadFile(fts.path)
+
+	if errors.Is(err, os.ErrNotExist) {
+		return "", nil
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("cannot read token file: %w", err)
+	}
+
+	token, err := fts.crypter.OpenStringWithoutNonce(string(sealToken))
+
+	if err != nil {
+		return "", fmt.Errorf("cannot open token: %w", err)
+	}
+
+	return token, nil
+}
+
+// Clear removes the token file. A missing file is not an error.
+func (fts *FileTokenStore) Clear() error {
+	err := os.Remove(fts.path)
+
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("cannot remove token file: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,248 @@
+//go:build unit
+
+package client
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Tomap-Tomap/GophKeeper/crypto"
+	proto "github.com/Tomap-Tomap/GophKeeper/proto/gophkeeper/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+type memoryTokenStore struct {
+	token string
+
+	saveErr  error
+	loadErr  error
+	clearErr error
+}
+
+func (mts *memoryTokenStore) Save(token string) error {
+	if mts.saveErr != nil {
+		return mts.saveErr
+	}
+
+	mts.token = token
+
+	return nil
+}
+
+func (mts *memoryTokenStore) Load() (string, error) {
+	if mts.loadErr != nil {
+		return "", mts.loadErr
+	}
+
+	return mts.token, nil
+}
+
+func (mts *memoryTokenStore) Clear() error {
+	if mts.clearErr != nil {
+		return mts.clearErr
+	}
+
+	mts.token = ""
+
+	return nil
+}
+
+func TestMemoryTokenStore(t *testing.T) {
+	mts := &memoryTokenStore{}
+
+	require.NoError(t, mts.Save("testToken"))
+
+	token, err := mts.Load()
+	require.NoError(t, err)
+	require.Equal(t, "testToken", token)
+
+	require.NoError(t, mts.Clear())
+
+	token, err = mts.Load()
+	require.NoError(t, err)
+	require.Empty(t, token)
+}
+
+func TestFileTokenStore(t *testing.T) {
+	crypter, _, err := crypto.NewCrypter(32, t.TempDir())
+	require.NoError(t, err)
+
+	fts := NewFileTokenStore(crypter, filepath.Join(t.TempDir(), "token"))
+
+	t.Run("load missing file", func(t *testing.T) {
+		token, err := fts.Load()
+		require.NoError(t, err)
+		require.Empty(t, token)
+	})
+
+	t.Run("save and load", func(t *testing.T) {
+		require.NoError(t, fts.Save("testToken"))
+
+		token, err := fts.Load()
+		require.NoError(t, err)
+		require.Equal(t, "testToken", token)
+	})
+
+	t.Run("clear", func(t *testing.T) {
+		require.NoError(t, fts.Clear())
+
+		token, err := fts.Load()
+		require.NoError(t, err)
+		require.Empty(t, token)
+
+		require.NoError(t, fts.Clear())
+	})
+
+	t.Run("token file permissions", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "token")
+		permFts := NewFileTokenStore(crypter, path)
+		require.NoError(t, permFts.Save("testToken"))
+
+		info, err := os.Stat(path)
+		require.NoError(t, err)
+		require.Equal(t, fs.FileMode(0o600), info.Mode().Perm())
+	})
+
+	t.Run("creates missing directories", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "gophkeeper", "token")
+		nestedFts := NewFileTokenStore(crypter, path)
+		require.NoError(t, nestedFts.Save("testToken"))
+
+		token, err := nestedFts.Load()
+		require.NoError(t, err)
+		require.Equal(t, "testToken", token)
+	})
+
+	t.Run("open token error", func(t *testing.T) {
+		corruptFts := NewFileTokenStore(crypter, filepath.Join(t.TempDir(), "token"))
+		require.NoError(t, corruptFts.Save("testToken"))
+
+		otherCrypter, _, err := crypto.NewCrypter(32, t.TempDir())
+		require.NoError(t, err)
+
+		corruptFts.crypter = otherCrypter
+
+		_, err = corruptFts.Load()
+		require.ErrorContains(t, err, "cannot open token")
+	})
+}
+
+func TestWithTokenStore(t *testing.T) {
+	t.Run("loads persisted token", func(t *testing.T) {
+		mts := &memoryTokenStore{token: "testToken"}
+
+		client, err := New(new(CrypterMockedObject), "testAddr", WithTokenStore(mts))
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, client.Close())
+		}()
+
+		require.Equal(t, "Bearer testToken", client.ti.token)
+
+		require.NoError(t, client.Logout())
+		require.Empty(t, client.ti.token)
+		require.Empty(t, mts.token)
+	})
+
+	t.Run("load error", func(t *testing.T) {
+		mts := &memoryTokenStore{loadErr: errTest}
+
+		_, err := New(new(CrypterMockedObject), "testAddr", WithTokenStore(mts))
+		require.ErrorContains(t, err, "cannot load token")
+	})
+}
+
+func TestDefaultTokenPath(t *testing.T) {
+	configDir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", configDir)
+
+	path, err := DefaultTokenPath()
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(configDir, "gophkeeper", "token"), path)
+}
+
+func TestLoadPersistedToken(t *testing.T) {
+	t.Run("picks up a token saved by another run", func(t *testing.T) {
+		mts := &memoryTokenStore{}
+
+		client, err := New(new(CrypterMockedObject), "testAddr", WithTokenStore(mts))
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, client.Close())
+		}()
+
+		require.Empty(t, client.ti.token)
+
+		mts.token = "testToken"
+
+		require.NoError(t, client.LoadPersistedToken())
+		require.Equal(t, "Bearer testToken", client.ti.token)
+	})
+
+	t.Run("no-op without a store", func(t *testing.T) {
+		client, err := New(new(CrypterMockedObject), "testAddr")
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, client.Close())
+		}()
+
+		require.NoError(t, client.LoadPersistedToken())
+		require.Empty(t, client.ti.token)
+	})
+
+	t.Run("load error", func(t *testing.T) {
+		mts := &memoryTokenStore{}
+
+		client, err := New(new(CrypterMockedObject), "testAddr", WithTokenStore(mts))
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, client.Close())
+		}()
+
+		mts.loadErr = errTest
+
+		require.ErrorContains(t, client.LoadPersistedToken(), "cannot load token")
+	})
+}
+
+func Test_tokenInterceptor_saveToken(t *testing.T) {
+	invoker := func(context.Context, string, any, any, *grpc.ClientConn, ...grpc.CallOption) error {
+		return nil
+	}
+
+	t.Run("persists token on auth", func(t *testing.T) {
+		mts := &memoryTokenStore{}
+		ti := newTokenInterceptor()
+		ti.store = mts
+
+		err := ti.interceptorAddTokenUnary(context.Background(), "testMethod", nil, &proto.AuthResponse{Token: "testToken"}, nil, invoker)
+		require.NoError(t, err)
+		require.Equal(t, "Bearer testToken", ti.token)
+		require.Equal(t, "testToken", mts.token)
+	})
+
+	t.Run("save error", func(t *testing.T) {
+		mts := &memoryTokenStore{saveErr: errTest}
+		ti := newTokenInterceptor()
+		ti.store = mts
+
+		err := ti.interceptorAddTokenUnary(context.Background(), "testMethod", nil, &proto.RegisterResponse{Token: "testToken"}, nil, invoker)
+		require.ErrorContains(t, err, "cannot save token")
+	})
+
+	t.Run("clear error", func(t *testing.T) {
+		mts := &memoryTokenStore{clearErr: errTest}
+		ti := newTokenInterceptor()
+		ti.store = mts
+
+		require.ErrorContains(t, ti.clearToken(), "cannot clear token")
+	})
+}
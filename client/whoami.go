@@ -0,0 +1,45 @@
+package client
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// TokenInfo describes the locally stored auth token: who it was issued to
+// and when it expires.
+type TokenInfo struct {
+	UserID    string
+	ExpiresAt time.Time
+}
+
+// WhoAmI decodes the locally stored JWT and returns its subject and expiry,
+// so the TUI can show "logged in as ... (expires in ...)" without a server
+// round trip. The signature is NOT verified — the client has no signing
+// secret — so the result is display-only and must not back any security
+// decision; the server still validates the token on every request.
+func (c *Client) WhoAmI() (*TokenInfo, error) {
+	token := strings.TrimPrefix(c.ti.currentToken(), "Bearer ")
+
+	if token == "" {
+		return nil, fmt.Errorf("no token stored: not authenticated")
+	}
+
+	claims := &jwt.RegisteredClaims{}
+
+	_, _, err := jwt.NewParser().ParseUnverified(token, claims)
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode token: %w", err)
+	}
+
+	info := &TokenInfo{UserID: claims.Subject}
+
+	if claims.ExpiresAt != nil {
+		info.ExpiresAt = claims.ExpiresAt.Time
+	}
+
+	return info, nil
+}
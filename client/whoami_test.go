@@ -0,0 +1,49 @@
+//go:build unit
+
+package client
+
+import (
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+func (suite *ClientTestSuite) TestWhoAmI() {
+	require := suite.Require()
+
+	suite.Run("no token stored", func() {
+		require.NoError(suite.client.ti.clearToken())
+
+		info, err := suite.client.WhoAmI()
+		require.ErrorContains(err, "not authenticated")
+		require.Nil(info)
+	})
+
+	suite.Run("malformed token", func() {
+		suite.client.ti.setToken("not a jwt")
+
+		info, err := suite.client.WhoAmI()
+		require.ErrorContains(err, "cannot decode token")
+		require.Nil(info)
+	})
+
+	suite.Run("decodes claims without the secret", func() {
+		expiresAt := time.Now().Add(time.Hour).Truncate(time.Second)
+
+		token, err := jwt.NewWithClaims(
+			jwt.SigningMethodHS256,
+			jwt.RegisteredClaims{
+				Subject:   "testUserID",
+				ExpiresAt: jwt.NewNumericDate(expiresAt),
+			},
+		).SignedString([]byte("serverOnlySecret"))
+		require.NoError(err)
+
+		suite.client.ti.setToken(token)
+
+		info, err := suite.client.WhoAmI()
+		require.NoError(err)
+		require.Equal("testUserID", info.UserID)
+		require.True(expiresAt.Equal(info.ExpiresAt))
+	})
+}
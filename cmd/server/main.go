@@ -0,0 +1,340 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/Tomap-Tomap/GophKeeper/adminapi"
+	"github.com/Tomap-Tomap/GophKeeper/crypto"
+	"github.com/Tomap-Tomap/GophKeeper/handlers"
+	"github.com/Tomap-Tomap/GophKeeper/hasher"
+	"github.com/Tomap-Tomap/GophKeeper/logger"
+	"github.com/Tomap-Tomap/GophKeeper/metrics"
+	"github.com/Tomap-Tomap/GophKeeper/parameters"
+	proto "github.com/Tomap-Tomap/GophKeeper/proto/gophkeeper/v1"
+	"github.com/Tomap-Tomap/GophKeeper/ratelimit"
+	"github.com/Tomap-Tomap/GophKeeper/sessions"
+	"github.com/Tomap-Tomap/GophKeeper/storage"
+	"github.com/Tomap-Tomap/GophKeeper/timeout"
+	"github.com/Tomap-Tomap/GophKeeper/tokener"
+	"github.com/Tomap-Tomap/GophKeeper/tui/buildinfo"
+	"github.com/bufbuild/protovalidate-go"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
+
+	_ "google.golang.org/grpc/encoding/gzip"
+)
+
+var (
+	buildVersion string
+	buildDate    string
+	buildCommit  string
+)
+
+// keepaliveOptions builds the gRPC options that close idle and aged
+// connections and guard against abusive client pings. Zero durations
+// disable the corresponding limit.
+func keepaliveOptions(maxConnectionIdle, maxConnectionAge, minPingInterval time.Duration) []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.KeepaliveParams(keepalive.ServerParameters{
+			MaxConnectionIdle: maxConnectionIdle,
+			MaxConnectionAge:  maxConnectionAge,
+		}),
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             minPingInterval,
+			PermitWithoutStream: true,
+		}),
+	}
+}
+
+// streamLimitOptions builds the gRPC option capping how many concurrent
+// streams one HTTP/2 connection may open, so an abusive or misconfigured
+// client cannot open unbounded streams. Zero disables the limit.
+func streamLimitOptions(maxConcurrentStreams uint) []grpc.ServerOption {
+	if maxConcurrentStreams == 0 {
+		return nil
+	}
+
+	return []grpc.ServerOption{grpc.MaxConcurrentStreams(uint32(maxConcurrentStreams))}
+}
+
+// fileStore combines the handlers' file store contract with the
+// reachability check the admin API readiness probe needs. Both backends
+// implement it.
+type fileStore interface {
+	handlers.FileStore
+	Ping(ctx context.Context) error
+}
+
+// maxRecommendedChunkSize is the default gRPC message size limit; chunks
+// above it will not fit into a single message on default clients.
+const maxRecommendedChunkSize = 4 << 20
+
+// authAttemptLimit and authLockoutWindow bound failed logins per account:
+// after authAttemptLimit failures within authLockoutWindow further attempts
+// are rejected until the window expires.
+const (
+	authAttemptLimit  = 10
+	authLockoutWindow = 15 * time.Minute
+)
+
+// validateChunkSize rejects a non-positive chunk size, which would silently
+// break file streaming, and warns when the size exceeds the gRPC message
+// size limit.
+func validateChunkSize(size int) error {
+	if size <= 0 {
+		return fmt.Errorf("chunk size must be positive, got %d", size)
+	}
+
+	if size > maxRecommendedChunkSize {
+		logger.Log.Warn("Chunk size exceeds the default grpc message size limit", zap.Int("size", size))
+	}
+
+	return nil
+}
+
+// watchReload re-reads the parameters and applies the hot-reloadable
+// settings every time a value arrives on reload, which the caller
+// subscribes to SIGHUP. Only the log level is hot-reloadable; everything
+// else (DSN, addresses, secrets, timeouts, keepalive limits) requires a
+// restart. Returns when ctx is done.
+func watchReload(ctx context.Context, reload <-chan os.Signal) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-reload:
+			p := parameters.ParseFlagsServer()
+
+			if err := logger.SetLevel(p.LogLevel); err != nil {
+				logger.Log.Warn("Cannot apply new log level", zap.Error(err))
+			} else {
+				logger.Log.Info("Applied new log level", zap.String("level", p.LogLevel))
+			}
+		}
+	}
+}
+
+func main() {
+	p := parameters.ParseFlagsServer()
+
+	bi := buildinfo.New(buildVersion, buildDate, buildCommit)
+
+	if p.Version {
+		fmt.Println(bi)
+		return
+	}
+
+	if err := logger.Initialize(p.LogLevel, "stderr"); err != nil {
+		panic(err)
+	}
+
+	if p.StrictLogRedaction {
+		logger.EnableStrictRedaction(strings.EqualFold(p.LogLevel, "DEBUG"), p.TokenSecret, p.AdminToken)
+	}
+
+	logger.Log.Info("Build info", zap.String("build", bi.String()))
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM, syscall.SIGQUIT)
+	defer cancel()
+
+	eg, egCtx := errgroup.WithContext(ctx)
+
+	execMode, err := storage.ParseQueryExecMode(p.QueryExecMode)
+
+	if err != nil {
+		logger.Log.Fatal("Invalid query exec mode", zap.Error(err))
+	}
+
+	var storageOpts []storage.StorageOption
+
+	if p.ReadDSN != "" {
+		storageOpts = append(storageOpts, storage.WithReadReplicaDSN(p.ReadDSN))
+	}
+
+	if p.DBWarmup {
+		storageOpts = append(storageOpts, storage.WithWarmup())
+	}
+
+	s, err := storage.NewStorageWithConfig(egCtx, p.DSN, execMode, storageOpts...)
+
+	if err != nil {
+		logger.Log.Fatal("Cannot create new DB storage", zap.Error(err))
+	}
+
+	if p.ServerStorageKey != "" {
+		pc, err := crypto.NewCrypterByFile(p.ServerStorageKey, crypto.WithDeterministicSealing())
+
+		if err != nil {
+			logger.Log.Fatal("Cannot create storage key crypter", zap.Error(err))
+		}
+
+		s.SetPathCrypter(pc)
+	}
+
+	h := hasher.NewHasher()
+	t := tokener.NewTokener([]byte(p.TokenSecret), time.Duration(time.Duration(p.TokenDuration)*time.Minute))
+
+	if p.ChallengeKeyPath != "" {
+		keyPEM, err := os.ReadFile(p.ChallengeKeyPath)
+
+		if err != nil {
+			logger.Log.Fatal("Cannot read challenge key", zap.Error(err))
+		}
+
+		key, err := tokener.ParsePrivateKeyPEM(keyPEM)
+
+		if err != nil {
+			logger.Log.Fatal("Cannot parse challenge key", zap.Error(err))
+		}
+
+		t.SetChallengeKey(key)
+	}
+
+	if err := validateChunkSize(int(p.ChunkSize)); err != nil {
+		logger.Log.Fatal("Invalid chunk size", zap.Error(err))
+	}
+
+	var fs fileStore
+
+	switch p.FileBackend {
+	case "db":
+		fs = storage.NewDBFileStorage(s, int(p.ChunkSize))
+	case "disk":
+		fs = storage.NewFileStorage(p.PathToFileStorage, int(p.ChunkSize))
+	default:
+		logger.Log.Fatal("Unknown file backend", zap.String("backend", p.FileBackend))
+	}
+
+	var rl ratelimit.Store
+
+	switch p.RateLimitBackend {
+	case "memory":
+		rl = ratelimit.NewMemoryStore(authLockoutWindow)
+	case "db":
+		rl = ratelimit.NewDBStore(s, authLockoutWindow)
+	default:
+		logger.Log.Fatal("Unknown rate limit backend", zap.String("backend", p.RateLimitBackend))
+	}
+
+	listen, err := net.Listen("tcp", p.GRPCAddr)
+	if err != nil {
+		logger.Log.Fatal("Cannot create listener", zap.Error(err))
+	}
+
+	validator, err := protovalidate.New()
+
+	if err != nil {
+		logger.Log.Fatal("Cannot create validator", zap.Error(err))
+	}
+
+	v := handlers.NewValidator(validator)
+
+	m, err := metrics.NewInterceptor(prometheus.DefaultRegisterer)
+
+	if err != nil {
+		logger.Log.Fatal("Cannot create metrics interceptor", zap.Error(err))
+	}
+
+	to := timeout.NewInterceptor(
+		time.Duration(p.RequestTimeout)*time.Second,
+		time.Duration(p.StreamRequestTimeout)*time.Second,
+	)
+
+	se := sessions.NewInterceptor(s, time.Duration(p.TokenDuration)*time.Minute)
+
+	gsOpts := append(
+		keepaliveOptions(
+			time.Duration(p.MaxConnectionIdle)*time.Second,
+			time.Duration(p.MaxConnectionAge)*time.Second,
+			time.Duration(p.MinPingInterval)*time.Second,
+		),
+		grpc.ChainUnaryInterceptor(
+			logger.UnaryInterceptorLogger,
+			m.UnaryServerInterceptor,
+			to.UnaryServerInterceptor,
+			t.UnaryServerInterceptor,
+			se.UnaryServerInterceptor,
+			v.UnaryServerInterceptor,
+		),
+		grpc.ChainStreamInterceptor(
+			logger.StreamInterceptorLogger,
+			to.StreamServerInterceptor,
+			t.StreamServerInterceptor,
+			se.StreamServerInterceptor,
+		),
+	)
+
+	gsOpts = append(gsOpts, streamLimitOptions(p.MaxConcurrentStreams)...)
+
+	gs := grpc.NewServer(gsOpts...)
+
+	gk := handlers.NewGophKeeperHandler(s, h, t, fs, *storage.NewRetryPolicy(3, 5, 3), 75, p.AdminToken, p.AllowEmptyFiles, int(p.ListLimit))
+	gk.SetRateLimiter(rl, authAttemptLimit)
+
+	if p.IntegrityScanInterval > 0 {
+		gk.StartIntegrityScan(egCtx, time.Duration(p.IntegrityScanInterval)*time.Minute)
+	}
+
+	proto.RegisterGophKeeperServiceServer(gs, gk)
+
+	eg.Go(func() error {
+		err := gs.Serve(listen)
+		if err != nil && err != http.ErrServerClosed {
+			return err
+		}
+		return nil
+	})
+
+	admin := adminapi.New(s, h, fs, p.AdminToken, strings.Split(p.AdminOrigins, ","))
+
+	if p.AdminAPIAddr != "" {
+		adminServer := &http.Server{
+			Addr:    p.AdminAPIAddr,
+			Handler: admin.Handler(),
+		}
+
+		eg.Go(func() error {
+			err := adminServer.ListenAndServe()
+			if err != nil && err != http.ErrServerClosed {
+				return err
+			}
+			return nil
+		})
+
+		eg.Go(func() error {
+			<-egCtx.Done()
+			return adminServer.Shutdown(context.Background())
+		})
+	}
+
+	eg.Go(func() error {
+		<-egCtx.Done()
+		admin.SetDraining()
+		gs.GracefulStop()
+		return nil
+	})
+
+	reload := make(chan os.Signal, 1)
+	signal.Notify(reload, syscall.SIGHUP)
+
+	defer signal.Stop(reload)
+
+	eg.Go(func() error {
+		return watchReload(egCtx, reload)
+	})
+
+	if err := eg.Wait(); err != nil {
+		logger.Log.Fatal("Server closed with unexpected error", zap.Error(err))
+	}
+}
@@ -0,0 +1,178 @@
+//go:build unit
+
+package main
+
+import (
+	"context"
+	"net"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/Tomap-Tomap/GophKeeper/logger"
+	proto "github.com/Tomap-Tomap/GophKeeper/proto/gophkeeper/v1"
+	"github.com/Tomap-Tomap/GophKeeper/tui/buildinfo"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+func TestKeepaliveOptions(t *testing.T) {
+	lis, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer(keepaliveOptions(200*time.Millisecond, 0, time.Second)...)
+
+	go func() {
+		_ = server.Serve(lis)
+	}()
+
+	defer server.Stop()
+
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, conn.Close())
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn.Connect()
+
+	for state := conn.GetState(); state != connectivity.Ready; state = conn.GetState() {
+		require.True(t, conn.WaitForStateChange(ctx, state), "connection never became ready")
+	}
+
+	// The server must close the connection once it stays idle beyond
+	// MaxConnectionIdle, sending the client back out of the ready state.
+	require.True(t, conn.WaitForStateChange(ctx, connectivity.Ready), "idle connection was not closed")
+	require.NotEqual(t, connectivity.Ready, conn.GetState())
+}
+
+// blockingTestServer parks GetChunkSize until release is closed, signalling
+// started for every call that reaches the handler, so the test can tell
+// queued streams from running ones.
+type blockingTestServer struct {
+	proto.UnimplementedGophKeeperServiceServer
+
+	started chan struct{}
+	release chan struct{}
+}
+
+func (s *blockingTestServer) GetChunkSize(_ context.Context, _ *proto.GetChunkSizeRequest) (*proto.GetChunkSizeResponse, error) {
+	s.started <- struct{}{}
+	<-s.release
+
+	return &proto.GetChunkSizeResponse{Size: 1}, nil
+}
+
+func TestStreamLimitOptions(t *testing.T) {
+	require.Nil(t, streamLimitOptions(0))
+
+	lis, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+
+	started := make(chan struct{}, 2)
+	release := make(chan struct{})
+
+	server := grpc.NewServer(streamLimitOptions(1)...)
+	proto.RegisterGophKeeperServiceServer(server, &blockingTestServer{started: started, release: release})
+
+	go func() {
+		_ = server.Serve(lis)
+	}()
+
+	defer server.Stop()
+
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, conn.Close())
+	}()
+
+	client := proto.NewGophKeeperServiceClient(conn)
+
+	firstDone := make(chan error, 1)
+
+	go func() {
+		_, err := client.GetChunkSize(context.Background(), &proto.GetChunkSizeRequest{})
+		firstDone <- err
+	}()
+
+	select {
+	case <-started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("first call never reached the handler")
+	}
+
+	// With the only stream slot taken, the second call queues on the
+	// connection and never reaches the handler before its deadline.
+	ctx, cancel := context.WithTimeout(context.Background(), 300*time.Millisecond)
+	defer cancel()
+
+	_, err = client.GetChunkSize(ctx, &proto.GetChunkSizeRequest{})
+	require.Equal(t, codes.DeadlineExceeded, status.Code(err))
+	require.Empty(t, started)
+
+	close(release)
+	require.NoError(t, <-firstDone)
+
+	// Once the slot is free again, calls go straight through.
+	_, err = client.GetChunkSize(context.Background(), &proto.GetChunkSizeRequest{})
+	require.NoError(t, err)
+}
+
+func TestVersionFormatting(t *testing.T) {
+	bi := buildinfo.New("v1.0.0", "26.08.2026", "abc1234")
+	require.Equal(t, "Build version: v1.0.0; Build date: 26.08.2026; Build commit: abc1234;", bi.String())
+
+	bi = buildinfo.New("", "", "")
+	require.Equal(t, "Build version: N/A; Build date: N/A; Build commit: N/A;", bi.String())
+}
+
+func TestValidateChunkSize(t *testing.T) {
+	require.ErrorContains(t, validateChunkSize(0), "chunk size must be positive")
+	require.ErrorContains(t, validateChunkSize(-1), "chunk size must be positive")
+	require.NoError(t, validateChunkSize(1024))
+	require.NoError(t, validateChunkSize(maxRecommendedChunkSize+1))
+}
+
+func TestWatchReload(t *testing.T) {
+	require.NoError(t, logger.Initialize("INFO", filepath.Join(t.TempDir(), "server.log")))
+	require.False(t, logger.Log.Core().Enabled(zapcore.DebugLevel))
+
+	t.Setenv("LOG_LEVEL", "DEBUG")
+
+	reload := make(chan os.Signal, 1)
+	signal.Notify(reload, syscall.SIGHUP)
+
+	defer signal.Stop(reload)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	done := make(chan error)
+
+	go func() {
+		done <- watchReload(ctx, reload)
+	}()
+
+	require.NoError(t, syscall.Kill(syscall.Getpid(), syscall.SIGHUP))
+
+	require.Eventually(t, func() bool {
+		return logger.Log.Core().Enabled(zapcore.DebugLevel)
+	}, 5*time.Second, 10*time.Millisecond, "log level was not reloaded")
+
+	cancel()
+	require.NoError(t, <-done)
+	require.NoError(t, logger.SetLevel("INFO"))
+}
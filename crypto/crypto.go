@@ -0,0 +1,581 @@
+// Package crypto implements AES encryption and decryption for strings and bytes.
+// It leverages Go's crypto/aes and crypto/cipher packages to provide secure encoding and decoding.
+// The package supports adding the nonce at the beginning or end of the data for flexibility.
+package crypto
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// NonceLocation defines a type for enumerating Nonce locations.
+type NonceLocation int
+
+// Enumeration of Nonce locations.
+const (
+	AtEnd   NonceLocation = iota // Nonce is located at the end of the data.
+	AtFront                      // Nonce is located at the beginning of the data.
+)
+
+// ErrUnknownLocation is returned when an unknown NonceLocation is selected.
+var ErrUnknownLocation = errors.New("unknown nonce location selected")
+
+// StringEncoding defines a type for enumerating sealed string encodings.
+type StringEncoding int
+
+// Enumeration of sealed string encodings. Raw bytes are deliberately not
+// offered while the storage columns remain TEXT.
+const (
+	EncodingHex       StringEncoding = iota // Sealed strings are hex-encoded (the default).
+	EncodingBase64                          // Sealed strings are standard base64-encoded.
+	EncodingBase64URL                       // Sealed strings are base64url-encoded.
+)
+
+// Header bytes recorded in front of a sealed string so that
+// OpenStringWithoutNonce can reverse whatever encoding was used. They are
+// chosen outside the hex alphabet: a string without a recognized header is
+// a legacy value sealed before encodings existed and is treated as hex.
+const (
+	headerHex       = "h"
+	headerBase64    = "s"
+	headerBase64URL = "u"
+)
+
+// Option configures a Crypter.
+type Option func(*Crypter)
+
+// WithStringEncoding sets the encoding used by SealStringWithoutNonce.
+func WithStringEncoding(encoding StringEncoding) Option {
+	return func(c *Crypter) {
+		c.encoding = encoding
+	}
+}
+
+// WithDeterministicSealing makes SealStringWithoutNonce derive the nonce
+// from the plaintext (SIV style, HMAC over the value) instead of drawing a
+// random one. Equal plaintexts then produce equal ciphertexts, which enables
+// server-side equality matching and unique constraints on sealed columns but
+// reveals to the server which values repeat. Randomized sealing stays the
+// default; opening handles strings sealed in either mode.
+func WithDeterministicSealing() Option {
+	return func(c *Crypter) {
+		c.deterministic = true
+	}
+}
+
+// Crypter provides AES encryption and decryption for strings and bytes.
+// It wraps the cipher.AEAD interface.
+type Crypter struct {
+	aesgcm   cipher.AEAD
+	encoding StringEncoding
+
+	deterministic bool
+	nonceKey      []byte
+}
+
+// NewCrypter creates a new AES key, saves it in the specified folder,
+// and returns a new Crypter instance along with the path to the AES key.
+func NewCrypter(keySize int, folderToSave string, opts ...Option) (crypter *Crypter, pathToKey string, err error) {
+	b := make([]byte, keySize)
+
+	_, err = rand.Read(b)
+
+	if err != nil {
+		return nil, "", fmt.Errorf("cannot generate secret key: %w", err)
+	}
+
+	pathToKey = filepath.Join(folderToSave, "key.aes")
+	file, err := os.Create(pathToKey)
+
+	if err != nil {
+		return nil, "", fmt.Errorf("cannot create key file: %w", err)
+	}
+
+	defer func() {
+		err = errors.Join(err, file.Close())
+	}()
+
+	_, err = file.Write(b)
+
+	if err != nil {
+		return nil, "", fmt.Errorf("cannot write secret key: %w", err)
+	}
+
+	aesgcm, err := getAEAD(b)
+
+	if err != nil {
+		return nil, "", fmt.Errorf("cannot create AEAD: %w", err)
+	}
+
+	crypter = &Crypter{
+		aesgcm: aesgcm,
+	}
+
+	for _, opt := range opts {
+		opt(crypter)
+	}
+
+	if crypter.deterministic {
+		crypter.nonceKey = b
+	}
+
+	return crypter, pathToKey, nil
+}
+
+// NewCrypterByFile creates a new Crypter instance using an AES key stored in the provided file path.
+func NewCrypterByFile(pathToKey string, opts ...Option) (crypter *Crypter, err error) {
+	file, err := os.Open(pathToKey)
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot open secret key: %w", err)
+	}
+
+	defer func() {
+		err = errors.Join(err, file.Close())
+	}()
+
+	key, err := io.ReadAll(file)
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot read secret key: %w", err)
+	}
+
+	aesgcm, err := getAEAD(key)
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot create AEAD: %w", err)
+	}
+
+	crypter = &Crypter{
+		aesgcm: aesgcm,
+	}
+
+	for _, opt := range opts {
+		opt(crypter)
+	}
+
+	if crypter.deterministic {
+		crypter.nonceKey = key
+	}
+
+	return crypter, nil
+}
+
+// NewCrypterByKey creates a new Crypter instance from a raw AES key, such as
+// an unwrapped per-entry data key. Nothing is written to disk.
+func NewCrypterByKey(key []byte, opts ...Option) (*Crypter, error) {
+	aesgcm, err := getAEAD(key)
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot create AEAD: %w", err)
+	}
+
+	crypter := &Crypter{
+		aesgcm: aesgcm,
+	}
+
+	for _, opt := range opts {
+		opt(crypter)
+	}
+
+	if crypter.deterministic {
+		crypter.nonceKey = key
+	}
+
+	return crypter, nil
+}
+
+// GenerateDataKey generates a random AES key of the given size for envelope
+// encryption. The caller seals the entry with it and stores it wrapped with
+// the master key via WrapKey.
+func GenerateDataKey(keySize int) ([]byte, error) {
+	key := make([]byte, keySize)
+
+	_, err := rand.Read(key)
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot generate data key: %w", err)
+	}
+
+	return key, nil
+}
+
+// GenerateNonce generates a new nonce for encryption and decryption.
+func (c *Crypter) GenerateNonce() ([]byte, error) {
+	nonce := make([]byte, c.aesgcm.NonceSize())
+	_, err := rand.Read(nonce)
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot generate nonce: %w", err)
+	}
+
+	return nonce, nil
+}
+
+// NonceSize returns the size of the nonce required for Seal and Open operations.
+func (c *Crypter) NonceSize() int {
+	return c.aesgcm.NonceSize()
+}
+
+// SealString encrypts and authenticates the given string and returns the encrypted string.
+func (c *Crypter) SealString(str string, nonce []byte) (string, error) {
+	return hex.EncodeToString(c.aesgcm.Seal(nil, nonce, []byte(str), nil)), nil
+}
+
+// SealStringWithoutNonce encrypts the string and appends the nonce at the end, returning the result.
+// The result is encoded with the configured StringEncoding, recorded in a leading header byte.
+// In deterministic mode the nonce is derived from the plaintext instead of drawn at random.
+func (c *Crypter) SealStringWithoutNonce(str string) (string, error) {
+	var nonce []byte
+	var err error
+
+	if c.deterministic {
+		nonce = c.deriveNonce(str)
+	} else {
+		nonce, err = c.GenerateNonce()
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("cannot generate nonce: %w", err)
+	}
+
+	enc, err := c.SealString(str, nonce)
+
+	if err != nil {
+		return "", fmt.Errorf("cannot seal data: %w", err)
+	}
+
+	res, err := c.AddNonceInString(enc, nonce, AtEnd)
+
+	if err != nil {
+		return "", fmt.Errorf("cannot add nonce to end result: %w", err)
+	}
+
+	res, err = c.encodeSealed(res)
+
+	if err != nil {
+		return "", fmt.Errorf("cannot encode sealed string: %w", err)
+	}
+
+	return versionPrefix(Version1) + res, nil
+}
+
+// SealStringsWithoutNonce seals every string in the slice with
+// SealStringWithoutNonce. All elements are processed even when some fail;
+// the errors are joined, each one reporting the index of the failed element.
+func (c *Crypter) SealStringsWithoutNonce(strs []string) ([]string, error) {
+	sealed := make([]string, len(strs))
+
+	var errs error
+
+	for i, str := range strs {
+		s, err := c.SealStringWithoutNonce(str)
+
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("cannot seal string at index %d: %w", i, err))
+			continue
+		}
+
+		sealed[i] = s
+	}
+
+	if errs != nil {
+		return nil, errs
+	}
+
+	return sealed, nil
+}
+
+// SealBytes encrypts and authenticates the given byte slice, returning the encrypted bytes.
+func (c *Crypter) SealBytes(b, nonce []byte) []byte {
+	return c.aesgcm.Seal(nil, nonce, b, nil)
+}
+
+// OpenString decrypts and authenticates the given encrypted string, returning the original string.
+func (c *Crypter) OpenString(encryptStr string, nonce []byte) (string, error) {
+	d, err := hex.DecodeString(encryptStr)
+
+	if err != nil {
+		return "", fmt.Errorf("cannot decode string: %w", err)
+	}
+
+	src, err := c.aesgcm.Open(nil, nonce, d, nil)
+
+	if err != nil {
+		return "", fmt.Errorf("cannot open string: %w", err)
+	}
+
+	return string(src), nil
+}
+
+// OpenStringWithoutNonce decrypts and authenticates data with the nonce at the end, returning the original string.
+// The open routine is picked from the registry by the crypto version prefix,
+// so strings sealed under any known version can be opened.
+func (c *Crypter) OpenStringWithoutNonce(encryptStr string) (string, error) {
+	version, rest := splitVersion(encryptStr)
+
+	open, ok := openFuncs[version]
+
+	if !ok {
+		return "", fmt.Errorf("unknown crypto version %d", version)
+	}
+
+	return open(c, rest)
+}
+
+// openV1 opens the Version1 wire format, which legacy strings share:
+// encoding header, ciphertext, nonce at the end. The encoding is taken
+// from the header byte, so strings sealed with any StringEncoding can be
+// opened.
+func openV1(c *Crypter, encryptStr string) (string, error) {
+	encryptStr, err := decodeSealed(encryptStr)
+
+	if err != nil {
+		return "", fmt.Errorf("cannot decode sealed string: %w", err)
+	}
+
+	data, nonce, err := c.GetNonceFromString(encryptStr, AtEnd)
+
+	if err != nil {
+		return "", fmt.Errorf("cannot get nonce at the end of data: %w", err)
+	}
+
+	dec, err := c.OpenString(data, nonce)
+
+	if err != nil {
+		return "", fmt.Errorf("cannot open data: %w", err)
+	}
+
+	return dec, nil
+}
+
+// OpenStringsWithoutNonce opens every string in the slice with
+// OpenStringWithoutNonce. All elements are processed even when some fail;
+// the errors are joined, each one reporting the index of the failed element.
+func (c *Crypter) OpenStringsWithoutNonce(encryptStrs []string) ([]string, error) {
+	opened := make([]string, len(encryptStrs))
+
+	var errs error
+
+	for i, encryptStr := range encryptStrs {
+		s, err := c.OpenStringWithoutNonce(encryptStr)
+
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("cannot open string at index %d: %w", i, err))
+			continue
+		}
+
+		opened[i] = s
+	}
+
+	if errs != nil {
+		return nil, errs
+	}
+
+	return opened, nil
+}
+
+// OpenBytes decrypts and authenticates the given encrypted bytes, returning the original bytes.
+func (c *Crypter) OpenBytes(enctyptB []byte, nonce []byte) ([]byte, error) {
+	src, err := c.aesgcm.Open(nil, nonce, enctyptB, nil)
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot open bytes: %w", err)
+	}
+
+	return src, nil
+}
+
+// WrapKey seals a raw data key with this crypter's key, returning a printable
+// wrapped form that can be stored alongside the ciphertext it protects.
+func (c *Crypter) WrapKey(key []byte) (string, error) {
+	wrapped, err := c.SealStringWithoutNonce(string(key))
+
+	if err != nil {
+		return "", fmt.Errorf("cannot wrap key: %w", err)
+	}
+
+	return wrapped, nil
+}
+
+// UnwrapKey reverses WrapKey, returning the raw data key.
+func (c *Crypter) UnwrapKey(wrapped string) ([]byte, error) {
+	key, err := c.OpenStringWithoutNonce(wrapped)
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot unwrap key: %w", err)
+	}
+
+	return []byte(key), nil
+}
+
+// AddNonceInString appends or prepends the nonce to the string depending on the specified location.
+func (c *Crypter) AddNonceInString(str string, nonce []byte, location NonceLocation) (string, error) {
+	d, err := hex.DecodeString(str)
+
+	if err != nil {
+		return "", fmt.Errorf("cannot decode string: %w", err)
+	}
+
+	switch location {
+	case AtFront:
+		res := append(nonce, d...)
+		return hex.EncodeToString(res), nil
+	case AtEnd:
+		res := append(d, nonce...)
+		return hex.EncodeToString(res), nil
+	}
+
+	return "", ErrUnknownLocation
+}
+
+// AddNonceInBytes appends or prepends the nonce to the byte slice depending on the specified location.
+func (c *Crypter) AddNonceInBytes(b []byte, nonce []byte, location NonceLocation) ([]byte, error) {
+	switch location {
+	case AtFront:
+		res := append(nonce, b...)
+		return res, nil
+	case AtEnd:
+		res := append(b, nonce...)
+		return res, nil
+	}
+
+	return nil, ErrUnknownLocation
+}
+
+// GetNonceFromString extracts the nonce from the string and returns the string without the nonce along with the nonce itself.
+func (c *Crypter) GetNonceFromString(str string, location NonceLocation) (string, []byte, error) {
+	d, err := hex.DecodeString(str)
+
+	if err != nil {
+		return "", nil, fmt.Errorf("cannot decode string: %w", err)
+	}
+
+	if len(d) < c.aesgcm.NonceSize() {
+		return "", nil, errors.New("data too short to contain nonce")
+	}
+
+	switch location {
+	case AtFront:
+		nonce := d[:c.aesgcm.NonceSize()]
+		d = d[c.aesgcm.NonceSize():]
+		return hex.EncodeToString(d), nonce, nil
+	case AtEnd:
+		nonce := d[len(d)-c.aesgcm.NonceSize():]
+		d = d[:len(d)-c.aesgcm.NonceSize()]
+
+		return hex.EncodeToString(d), nonce, nil
+	}
+
+	return "", nil, ErrUnknownLocation
+}
+
+// GetNonceFromBytes extracts the nonce from the byte slice and returns the nonce, the bytes without the nonce,
+// and the number of remaining bytes required to complete the nonce if the original slice was too short.
+func (c *Crypter) GetNonceFromBytes(b []byte, nonceSize int, location NonceLocation) ([]byte, []byte, int, error) {
+	if len(b) == nonceSize {
+		return b, nil, 0, nil
+	}
+
+	if len(b) < nonceSize {
+		return b, nil, nonceSize - len(b), nil
+	}
+
+	switch location {
+	case AtFront:
+		nonce := b[:nonceSize]
+		b = b[nonceSize:]
+
+		return nonce, b, 0, nil
+	case AtEnd:
+		nonce := b[len(b)-nonceSize:]
+		b = b[:len(b)-nonceSize]
+
+		return nonce, b, 0, nil
+	}
+
+	return nil, nil, 0, ErrUnknownLocation
+}
+
+// encodeSealed re-encodes the hex string produced by the seal pipeline with
+// the configured StringEncoding and prepends the matching header byte.
+func (c *Crypter) encodeSealed(str string) (string, error) {
+	d, err := hex.DecodeString(str)
+
+	if err != nil {
+		return "", fmt.Errorf("cannot decode string: %w", err)
+	}
+
+	switch c.encoding {
+	case EncodingHex:
+		return headerHex + hex.EncodeToString(d), nil
+	case EncodingBase64:
+		return headerBase64 + base64.StdEncoding.EncodeToString(d), nil
+	case EncodingBase64URL:
+		return headerBase64URL + base64.URLEncoding.EncodeToString(d), nil
+	}
+
+	return "", fmt.Errorf("unknown string encoding %d", c.encoding)
+}
+
+// decodeSealed reverses the encoding recorded in the header byte, returning
+// the hex string the open pipeline works with. Strings without a recognized
+// header are legacy hex values and are returned as is.
+func decodeSealed(str string) (string, error) {
+	switch {
+	case strings.HasPrefix(str, headerHex):
+		return str[len(headerHex):], nil
+	case strings.HasPrefix(str, headerBase64):
+		d, err := base64.StdEncoding.DecodeString(str[len(headerBase64):])
+
+		if err != nil {
+			return "", fmt.Errorf("cannot decode base64 string: %w", err)
+		}
+
+		return hex.EncodeToString(d), nil
+	case strings.HasPrefix(str, headerBase64URL):
+		d, err := base64.URLEncoding.DecodeString(str[len(headerBase64URL):])
+
+		if err != nil {
+			return "", fmt.Errorf("cannot decode base64url string: %w", err)
+		}
+
+		return hex.EncodeToString(d), nil
+	}
+
+	return str, nil
+}
+
+// deriveNonce derives the sealing nonce from the plaintext with an HMAC
+// keyed by the AES key, so equal plaintexts seal to equal ciphertexts.
+func (c *Crypter) deriveNonce(str string) []byte {
+	mac := hmac.New(sha256.New, c.nonceKey)
+	mac.Write([]byte(str))
+
+	return mac.Sum(nil)[:c.aesgcm.NonceSize()]
+}
+
+func getAEAD(key []byte) (cipher.AEAD, error) {
+	aesblock, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	aesgcm, err := cipher.NewGCM(aesblock)
+	if err != nil {
+		return nil, err
+	}
+
+	return aesgcm, nil
+}
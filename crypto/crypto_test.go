@@ -0,0 +1,637 @@
+//go:build unit
+
+package crypto
+
+import (
+	"crypto/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type CryptoTestSuite struct {
+	suite.Suite
+	crypter         *Crypter
+	tempDir         string
+	errDir          string
+	keyPath         string
+	testKey         []byte
+	testNonce       []byte
+	testNonceForErr []byte
+	testMessage     string
+	testBytes       []byte
+}
+
+func (suite *CryptoTestSuite) SetupSuite() {
+	require := suite.Require()
+
+	tempDir, err := os.MkdirTemp("", "test")
+	require.NoError(err)
+	suite.tempDir = tempDir
+	suite.errDir = "№*?;"
+	suite.testMessage = "hello world"
+	suite.testBytes = []byte("hello world")
+	suite.testKey = make([]byte, 32) // AES-256
+	_, err = rand.Read(suite.testKey)
+	require.NoError(err)
+
+	suite.testNonce = make([]byte, 12) // GCM standard nonce length
+	_, err = rand.Read(suite.testNonce)
+	require.NoError(err)
+
+	suite.testNonceForErr = make([]byte, 12) // GCM standard nonce length
+	_, err = rand.Read(suite.testNonceForErr)
+	require.NoError(err)
+
+	crypter, keyPath, err := NewCrypter(len(suite.testKey), suite.tempDir)
+	require.NoError(err)
+
+	suite.keyPath = keyPath
+	suite.crypter = crypter
+}
+
+func (suite *CryptoTestSuite) TearDownSuite() {
+	err := os.Remove(suite.keyPath)
+	suite.Require().NoError(err)
+}
+
+func (suite *CryptoTestSuite) TestNewCrypter() {
+	assert := suite.Require()
+
+	suite.Run("positive test", func() {
+		newCrypter, _, err := NewCrypter(32, suite.tempDir)
+		assert.NoError(err)
+		assert.NotNil(newCrypter)
+	})
+
+	suite.Run("cannot create key file", func() {
+		newCrypter, _, err := NewCrypter(32, suite.errDir)
+		assert.ErrorContains(err, "cannot create key file")
+		assert.Nil(newCrypter)
+	})
+
+	suite.Run("cannot create AEAD", func() {
+		tempDir, err := os.MkdirTemp("", "testErr")
+		assert.NoError(err)
+		newCrypter, _, err := NewCrypter(0, tempDir)
+		assert.ErrorContains(err, "cannot create AEAD")
+		assert.Nil(newCrypter)
+		err = os.Remove(filepath.Join(tempDir, "key.aes"))
+		assert.NoError(err)
+	})
+}
+
+func (suite *CryptoTestSuite) TestNewCrypterByFile() {
+	assert := suite.Require()
+
+	suite.Run("positive test", func() {
+		newCrypter, err := NewCrypterByFile(suite.keyPath)
+		assert.NoError(err)
+		assert.NotNil(newCrypter)
+	})
+
+	suite.Run("cannot open secret key", func() {
+		newCrypter, err := NewCrypterByFile(suite.errDir)
+		assert.ErrorContains(err, "cannot open secret key")
+		assert.Nil(newCrypter)
+	})
+
+}
+
+func (suite *CryptoTestSuite) TestGenerateNonce() {
+	assert := suite.Require()
+	nonce, err := suite.crypter.GenerateNonce()
+	assert.NoError(err)
+	assert.NotEmpty(nonce)
+}
+
+func (suite *CryptoTestSuite) TestNonceSize() {
+	assert := suite.Require()
+	size := suite.crypter.NonceSize()
+	assert.Equal(suite.crypter.aesgcm.NonceSize(), size)
+}
+
+func (suite *CryptoTestSuite) TestSealString() {
+	assert := suite.Require()
+
+	encrypted, err := suite.crypter.SealString(suite.testMessage, suite.testNonce)
+	assert.NoError(err)
+	assert.NotEmpty(encrypted)
+}
+
+func (suite *CryptoTestSuite) TestSealStringWithoutNonce() {
+	assert := suite.Require()
+	encrypted, err := suite.crypter.SealStringWithoutNonce(suite.testMessage)
+	assert.NoError(err)
+	assert.NotEmpty(encrypted)
+}
+
+func (suite *CryptoTestSuite) TestOpenString() {
+	assert := suite.Require()
+
+	suite.Run("positive test", func() {
+		encrypted, err := suite.crypter.SealString(suite.testMessage, suite.testNonce)
+		assert.NoError(err)
+
+		decrypted, err := suite.crypter.OpenString(encrypted, suite.testNonce)
+		assert.NoError(err)
+		assert.Equal(suite.testMessage, decrypted)
+	})
+
+	suite.Run("cannot decode string", func() {
+		decrypted, err := suite.crypter.OpenString("#@!", suite.testNonce)
+		assert.ErrorContains(err, "cannot decode string")
+		assert.Equal("", decrypted)
+	})
+
+	suite.Run("cannot open string", func() {
+		encrypted, err := suite.crypter.SealString(suite.testMessage, suite.testNonce)
+		assert.NoError(err)
+
+		decrypted, err := suite.crypter.OpenString(encrypted, suite.testNonceForErr)
+		assert.ErrorContains(err, "cannot open string")
+		assert.Equal("", decrypted)
+	})
+}
+
+func (suite *CryptoTestSuite) TestOpenStringWithoutNonce() {
+	assert := suite.Require()
+	encrypted, err := suite.crypter.SealStringWithoutNonce(suite.testMessage)
+	assert.NoError(err)
+
+	decrypted, err := suite.crypter.OpenStringWithoutNonce(encrypted)
+	assert.NoError(err)
+	assert.Equal(suite.testMessage, decrypted)
+}
+
+func (suite *CryptoTestSuite) TestSealStringsWithoutNonce() {
+	assert := suite.Require()
+
+	strs := []string{"first", "second", "third"}
+
+	sealed, err := suite.crypter.SealStringsWithoutNonce(strs)
+	assert.NoError(err)
+	assert.Len(sealed, len(strs))
+
+	opened, err := suite.crypter.OpenStringsWithoutNonce(sealed)
+	assert.NoError(err)
+	assert.Equal(strs, opened)
+}
+
+func (suite *CryptoTestSuite) TestOpenStringsWithoutNonceFailingIndex() {
+	assert := suite.Require()
+
+	sealed, err := suite.crypter.SealStringsWithoutNonce([]string{"first", "second", "third"})
+	assert.NoError(err)
+
+	sealed[1] = "#@!"
+
+	opened, err := suite.crypter.OpenStringsWithoutNonce(sealed)
+	assert.ErrorContains(err, "cannot open string at index 1")
+	assert.NotContains(err.Error(), "index 0")
+	assert.NotContains(err.Error(), "index 2")
+	assert.Nil(opened)
+}
+
+func (suite *CryptoTestSuite) TestWrapUnwrapKey() {
+	assert := suite.Require()
+
+	suite.Run("round trip", func() {
+		dataKey, err := GenerateDataKey(32)
+		assert.NoError(err)
+		assert.Len(dataKey, 32)
+
+		wrapped, err := suite.crypter.WrapKey(dataKey)
+		assert.NoError(err)
+		assert.NotContains(wrapped, string(dataKey))
+
+		unwrapped, err := suite.crypter.UnwrapKey(wrapped)
+		assert.NoError(err)
+		assert.Equal(dataKey, unwrapped)
+	})
+
+	suite.Run("data key crypter interop", func() {
+		dataKey, err := GenerateDataKey(32)
+		assert.NoError(err)
+
+		dataCrypter, err := NewCrypterByKey(dataKey)
+		assert.NoError(err)
+
+		sealed, err := dataCrypter.SealStringWithoutNonce(suite.testMessage)
+		assert.NoError(err)
+
+		opened, err := dataCrypter.OpenStringWithoutNonce(sealed)
+		assert.NoError(err)
+		assert.Equal(suite.testMessage, opened)
+
+		_, err = suite.crypter.OpenStringWithoutNonce(sealed)
+		assert.Error(err)
+	})
+
+	suite.Run("cannot unwrap garbage", func() {
+		unwrapped, err := suite.crypter.UnwrapKey("#@!")
+		assert.ErrorContains(err, "cannot unwrap key")
+		assert.Nil(unwrapped)
+	})
+
+	suite.Run("cannot create crypter by wrong size key", func() {
+		dataCrypter, err := NewCrypterByKey([]byte("tooShort"))
+		assert.ErrorContains(err, "cannot create AEAD")
+		assert.Nil(dataCrypter)
+	})
+}
+
+func (suite *CryptoTestSuite) TestStringEncodings() {
+	assert := suite.Require()
+
+	// Other tests recreate key.aes in suite.tempDir, so use a dedicated
+	// key shared by all crypters in this test.
+	tempDir, err := os.MkdirTemp("", "testEncodings")
+	assert.NoError(err)
+
+	defaultCrypter, keyPath, err := NewCrypter(32, tempDir)
+	assert.NoError(err)
+
+	tests := []struct {
+		name     string
+		encoding StringEncoding
+		header   string
+	}{
+		{"hex", EncodingHex, headerHex},
+		{"base64", EncodingBase64, headerBase64},
+		{"base64url", EncodingBase64URL, headerBase64URL},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name+" round trip", func() {
+			crypter, err := NewCrypterByFile(keyPath, WithStringEncoding(tt.encoding))
+			assert.NoError(err)
+
+			encrypted, err := crypter.SealStringWithoutNonce(suite.testMessage)
+			assert.NoError(err)
+			assert.Equal(versionPrefix(Version1)+tt.header, encrypted[:3])
+
+			decrypted, err := crypter.OpenStringWithoutNonce(encrypted)
+			assert.NoError(err)
+			assert.Equal(suite.testMessage, decrypted)
+		})
+
+		suite.Run(tt.name+" opened by default crypter", func() {
+			crypter, err := NewCrypterByFile(keyPath, WithStringEncoding(tt.encoding))
+			assert.NoError(err)
+
+			encrypted, err := crypter.SealStringWithoutNonce(suite.testMessage)
+			assert.NoError(err)
+
+			decrypted, err := defaultCrypter.OpenStringWithoutNonce(encrypted)
+			assert.NoError(err)
+			assert.Equal(suite.testMessage, decrypted)
+		})
+	}
+
+	suite.Run("legacy string without header", func() {
+		encrypted, err := defaultCrypter.SealStringWithoutNonce(suite.testMessage)
+		assert.NoError(err)
+
+		decrypted, err := defaultCrypter.OpenStringWithoutNonce(encrypted[len(versionPrefix(Version1))+len(headerHex):])
+		assert.NoError(err)
+		assert.Equal(suite.testMessage, decrypted)
+	})
+
+	suite.Run("cannot decode base64 string", func() {
+		decrypted, err := defaultCrypter.OpenStringWithoutNonce(headerBase64 + "#@!")
+		assert.ErrorContains(err, "cannot decode sealed string")
+		assert.Equal("", decrypted)
+	})
+
+	suite.Run("unknown encoding", func() {
+		crypter, err := NewCrypterByFile(keyPath, WithStringEncoding(StringEncoding(42)))
+		assert.NoError(err)
+
+		encrypted, err := crypter.SealStringWithoutNonce(suite.testMessage)
+		assert.ErrorContains(err, "cannot encode sealed string")
+		assert.Equal("", encrypted)
+	})
+}
+
+func (suite *CryptoTestSuite) TestCryptoVersions() {
+	assert := suite.Require()
+
+	suite.Run("sealed strings carry the current version", func() {
+		encrypted, err := suite.crypter.SealStringWithoutNonce(suite.testMessage)
+		assert.NoError(err)
+		assert.Equal(versionPrefix(Version1), encrypted[:2])
+
+		decrypted, err := suite.crypter.OpenStringWithoutNonce(encrypted)
+		assert.NoError(err)
+		assert.Equal(suite.testMessage, decrypted)
+	})
+
+	suite.Run("mixed versions open side by side", func() {
+		encrypted, err := suite.crypter.SealStringWithoutNonce(suite.testMessage)
+		assert.NoError(err)
+
+		legacy := encrypted[len(versionPrefix(Version1)):]
+
+		for _, sealed := range []string{encrypted, legacy} {
+			decrypted, err := suite.crypter.OpenStringWithoutNonce(sealed)
+			assert.NoError(err)
+			assert.Equal(suite.testMessage, decrypted)
+		}
+	})
+
+	suite.Run("registered version uses its own open routine", func() {
+		version := CryptoVersion(2)
+
+		RegisterOpenFunc(version, func(c *Crypter, encryptStr string) (string, error) {
+			return openV1(c, encryptStr)
+		})
+		defer delete(openFuncs, version)
+
+		encrypted, err := suite.crypter.SealStringWithoutNonce(suite.testMessage)
+		assert.NoError(err)
+
+		relabeled := versionPrefix(version) + encrypted[len(versionPrefix(Version1)):]
+
+		decrypted, err := suite.crypter.OpenStringWithoutNonce(relabeled)
+		assert.NoError(err)
+		assert.Equal(suite.testMessage, decrypted)
+	})
+
+	suite.Run("unknown version", func() {
+		decrypted, err := suite.crypter.OpenStringWithoutNonce(versionPrefix(CryptoVersion(9)) + "deadbeef")
+		assert.ErrorContains(err, "unknown crypto version 9")
+		assert.Equal("", decrypted)
+	})
+}
+
+func (suite *CryptoTestSuite) TestDeterministicSealing() {
+	assert := suite.Require()
+
+	// Other tests recreate key.aes in suite.tempDir, so use a dedicated
+	// key shared by all crypters in this test.
+	tempDir, err := os.MkdirTemp("", "testDeterministic")
+	assert.NoError(err)
+
+	randomizedCrypter, keyPath, err := NewCrypter(32, tempDir)
+	assert.NoError(err)
+
+	deterministicCrypter, err := NewCrypterByFile(keyPath, WithDeterministicSealing())
+	assert.NoError(err)
+
+	suite.Run("identical input seals to identical ciphertext", func() {
+		first, err := deterministicCrypter.SealStringWithoutNonce(suite.testMessage)
+		assert.NoError(err)
+
+		second, err := deterministicCrypter.SealStringWithoutNonce(suite.testMessage)
+		assert.NoError(err)
+
+		assert.Equal(first, second)
+	})
+
+	suite.Run("different input seals to different ciphertext", func() {
+		first, err := deterministicCrypter.SealStringWithoutNonce(suite.testMessage)
+		assert.NoError(err)
+
+		second, err := deterministicCrypter.SealStringWithoutNonce(suite.testMessage + "2")
+		assert.NoError(err)
+
+		assert.NotEqual(first, second)
+	})
+
+	suite.Run("randomized sealing stays the default", func() {
+		first, err := randomizedCrypter.SealStringWithoutNonce(suite.testMessage)
+		assert.NoError(err)
+
+		second, err := randomizedCrypter.SealStringWithoutNonce(suite.testMessage)
+		assert.NoError(err)
+
+		assert.NotEqual(first, second)
+	})
+
+	suite.Run("modes open each other's strings", func() {
+		encrypted, err := deterministicCrypter.SealStringWithoutNonce(suite.testMessage)
+		assert.NoError(err)
+
+		decrypted, err := randomizedCrypter.OpenStringWithoutNonce(encrypted)
+		assert.NoError(err)
+		assert.Equal(suite.testMessage, decrypted)
+
+		encrypted, err = randomizedCrypter.SealStringWithoutNonce(suite.testMessage)
+		assert.NoError(err)
+
+		decrypted, err = deterministicCrypter.OpenStringWithoutNonce(encrypted)
+		assert.NoError(err)
+		assert.Equal(suite.testMessage, decrypted)
+	})
+}
+
+func (suite *CryptoTestSuite) TestAddNonceInString() {
+	assert := suite.Require()
+
+	suite.Run("test at end", func() {
+		encrypted, err := suite.crypter.SealString(suite.testMessage, suite.testNonce)
+		assert.NoError(err)
+
+		withNonce, err := suite.crypter.AddNonceInString(encrypted, suite.testNonce, AtEnd)
+		assert.NoError(err)
+		assert.NotEmpty(withNonce)
+	})
+
+	suite.Run("test at front", func() {
+		encrypted, err := suite.crypter.SealString(suite.testMessage, suite.testNonce)
+		assert.NoError(err)
+
+		withNonce, err := suite.crypter.AddNonceInString(encrypted, suite.testNonce, AtFront)
+		assert.NoError(err)
+		assert.NotEmpty(withNonce)
+	})
+
+	suite.Run("cannot decode string", func() {
+		withNonce, err := suite.crypter.AddNonceInString("$@!", suite.testNonce, AtFront)
+		assert.ErrorContains(err, "cannot decode string")
+		assert.Empty(withNonce)
+	})
+
+	suite.Run("ErrUnknownLocation", func() {
+		encrypted, err := suite.crypter.SealString(suite.testMessage, suite.testNonce)
+		assert.NoError(err)
+
+		withNonce, err := suite.crypter.AddNonceInString(encrypted, suite.testNonce, 3)
+		assert.ErrorIs(err, ErrUnknownLocation)
+		assert.Empty(withNonce)
+	})
+}
+
+func (suite *CryptoTestSuite) TestAddNonceInBytes() {
+	assert := suite.Require()
+
+	suite.Run("test at end", func() {
+		encrypted := suite.crypter.SealBytes(suite.testBytes, suite.testNonce)
+
+		withNonce, err := suite.crypter.AddNonceInBytes(encrypted, suite.testNonce, AtEnd)
+		assert.NoError(err)
+		assert.NotNil(withNonce)
+	})
+
+	suite.Run("test at front", func() {
+		encrypted := suite.crypter.SealBytes(suite.testBytes, suite.testNonce)
+
+		withNonce, err := suite.crypter.AddNonceInBytes(encrypted, suite.testNonce, AtFront)
+		assert.NoError(err)
+		assert.NotNil(withNonce)
+	})
+
+	suite.Run("ErrUnknownLocation", func() {
+		encrypted := suite.crypter.SealBytes(suite.testBytes, suite.testNonce)
+
+		withNonce, err := suite.crypter.AddNonceInBytes(encrypted, suite.testNonce, 3)
+		assert.ErrorIs(err, ErrUnknownLocation)
+		assert.Nil(withNonce)
+	})
+}
+
+func (suite *CryptoTestSuite) TestGetNonceFromString() {
+	assert := suite.Require()
+
+	suite.Run("test at end", func() {
+		encrypted, err := suite.crypter.SealString(suite.testMessage, suite.testNonce)
+		assert.NoError(err)
+
+		withNonce, err := suite.crypter.AddNonceInString(encrypted, suite.testNonce, AtEnd)
+		assert.NoError(err)
+
+		data, nonce, err := suite.crypter.GetNonceFromString(withNonce, AtEnd)
+		assert.NoError(err)
+		assert.Equal(encrypted, data)
+		assert.Equal(suite.testNonce, nonce)
+	})
+
+	suite.Run("test at front", func() {
+		encrypted, err := suite.crypter.SealString(suite.testMessage, suite.testNonce)
+		assert.NoError(err)
+
+		withNonce, err := suite.crypter.AddNonceInString(encrypted, suite.testNonce, AtFront)
+		assert.NoError(err)
+
+		data, nonce, err := suite.crypter.GetNonceFromString(withNonce, AtFront)
+		assert.NoError(err)
+		assert.Equal(encrypted, data)
+		assert.Equal(suite.testNonce, nonce)
+	})
+
+	suite.Run("cannot decode string", func() {
+		data, nonce, err := suite.crypter.GetNonceFromString("!#%", AtFront)
+		assert.ErrorContains(err, "cannot decode string")
+		assert.Empty(data)
+		assert.Nil(nonce)
+	})
+
+	suite.Run("data too short to contain nonce", func() {
+		data, nonce, err := suite.crypter.GetNonceFromString("687a", AtFront)
+		assert.ErrorContains(err, "data too short to contain nonce")
+		assert.Empty(data)
+		assert.Nil(nonce)
+	})
+
+	suite.Run("ErrUnknownLocation", func() {
+		encrypted, err := suite.crypter.SealString(suite.testMessage, suite.testNonce)
+		assert.NoError(err)
+
+		withNonce, err := suite.crypter.AddNonceInString(encrypted, suite.testNonce, AtFront)
+		assert.NoError(err)
+
+		data, nonce, err := suite.crypter.GetNonceFromString(withNonce, 3)
+		assert.ErrorIs(err, ErrUnknownLocation)
+		assert.Empty(data)
+		assert.Nil(nonce)
+	})
+}
+
+func (suite *CryptoTestSuite) TestGetNonceFromBytes() {
+	assert := suite.Require()
+
+	suite.Run("test at end", func() {
+		encrypted := suite.crypter.SealBytes(suite.testBytes, suite.testNonce)
+
+		withNonce, err := suite.crypter.AddNonceInBytes(encrypted, suite.testNonce, AtEnd)
+		assert.NoError(err)
+
+		nonce, data, length, err := suite.crypter.GetNonceFromBytes(withNonce, suite.crypter.NonceSize(), AtEnd)
+		assert.NoError(err)
+		assert.Equal(suite.testNonce, nonce)
+		assert.Equal(encrypted, data)
+		assert.Equal(0, length)
+	})
+
+	suite.Run("test at front", func() {
+		encrypted := suite.crypter.SealBytes(suite.testBytes, suite.testNonce)
+
+		withNonce, err := suite.crypter.AddNonceInBytes(encrypted, suite.testNonce, AtFront)
+		assert.NoError(err)
+
+		nonce, data, length, err := suite.crypter.GetNonceFromBytes(withNonce, suite.crypter.NonceSize(), AtFront)
+		assert.NoError(err)
+		assert.Equal(suite.testNonce, nonce)
+		assert.Equal(encrypted, data)
+		assert.Equal(0, length)
+	})
+
+	suite.Run("test len b equal nonceSize", func() {
+		nonce, data, length, err := suite.crypter.GetNonceFromBytes(suite.testNonce, suite.crypter.NonceSize(), AtFront)
+		assert.NoError(err)
+		assert.Equal(suite.testNonce, nonce)
+		assert.Nil(data)
+		assert.Equal(0, length)
+	})
+
+	suite.Run("test len b less nonceSize", func() {
+		testB := suite.testNonce[:len(suite.testNonce)-1]
+		expLen := 1
+		nonce, data, length, err := suite.crypter.GetNonceFromBytes(testB, suite.crypter.NonceSize(), AtFront)
+		assert.NoError(err)
+		assert.Equal(testB, nonce)
+		assert.Nil(data)
+		assert.Equal(expLen, length)
+	})
+
+	suite.Run("ErrUnknownLocation", func() {
+		encrypted := suite.crypter.SealBytes(suite.testBytes, suite.testNonce)
+
+		withNonce, err := suite.crypter.AddNonceInBytes(encrypted, suite.testNonce, AtEnd)
+		assert.NoError(err)
+
+		nonce, data, length, err := suite.crypter.GetNonceFromBytes(withNonce, suite.crypter.NonceSize(), 3)
+		assert.ErrorIs(err, ErrUnknownLocation)
+		assert.Nil(nonce)
+		assert.Nil(data)
+		assert.Equal(0, length)
+	})
+}
+
+func (suite *CryptoTestSuite) TestOpenBytes() {
+	assert := suite.Require()
+
+	suite.Run("positive test", func() {
+		encrypted := suite.crypter.SealBytes([]byte(suite.testMessage), suite.testNonce)
+		decrypted, err := suite.crypter.OpenBytes(encrypted, suite.testNonce)
+
+		assert.NoError(err)
+		assert.Equal([]byte(suite.testMessage), decrypted)
+	})
+
+	suite.Run("cannot open bytes", func() {
+		encrypted := suite.crypter.SealBytes([]byte(suite.testMessage), suite.testNonce)
+		decrypted, err := suite.crypter.OpenBytes(encrypted, suite.testNonceForErr)
+
+		assert.ErrorContains(err, "cannot open bytes")
+		assert.Nil(decrypted)
+	})
+
+}
+
+func TestCryptoTestSuite(t *testing.T) {
+	suite.Run(t, new(CryptoTestSuite))
+}
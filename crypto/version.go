@@ -0,0 +1,59 @@
+package crypto
+
+import "strconv"
+
+// CryptoVersion tags the seal pipeline a stored string was produced with.
+// Sealed strings carry the version as a prefix, so the pipeline can change
+// — padding, compression, cipher choices — without breaking data sealed
+// before the change.
+type CryptoVersion byte
+
+// Known crypto versions.
+const (
+	// VersionLegacy marks strings sealed before versioning existed. They
+	// carry no prefix and share the Version1 wire format.
+	VersionLegacy CryptoVersion = iota
+	// Version1 is the current pipeline: AES-GCM with the nonce at the end
+	// and an encoding header byte.
+	Version1
+)
+
+// versionMarker starts a version prefix. It sits outside both the hex
+// alphabet and the encoding header bytes, so prefixed and legacy values
+// cannot be confused.
+const versionMarker = 'v'
+
+// OpenFunc opens a sealed string produced under one crypto version. The
+// version prefix is already stripped from the argument.
+type OpenFunc func(c *Crypter, encryptStr string) (string, error)
+
+// openFuncs maps versions to their open routines. Keeping the mapping in
+// a registry lets the vault contain mixed-version entries during a
+// gradual migration: every entry is opened by the routine its prefix
+// names, whichever version new entries are sealed under.
+var openFuncs = map[CryptoVersion]OpenFunc{
+	VersionLegacy: openV1,
+	Version1:      openV1,
+}
+
+// RegisterOpenFunc adds or replaces the open routine for a version. A new
+// seal pipeline registers its routine here before any data sealed under
+// it is opened.
+func RegisterOpenFunc(version CryptoVersion, open OpenFunc) {
+	openFuncs[version] = open
+}
+
+// versionPrefix renders the prefix recorded in front of sealed strings.
+func versionPrefix(version CryptoVersion) string {
+	return string(versionMarker) + strconv.Itoa(int(version))
+}
+
+// splitVersion reads the version prefix off a sealed string. Strings
+// without one are legacy values.
+func splitVersion(encryptStr string) (CryptoVersion, string) {
+	if len(encryptStr) < 2 || encryptStr[0] != versionMarker || encryptStr[1] < '0' || encryptStr[1] > '9' {
+		return VersionLegacy, encryptStr
+	}
+
+	return CryptoVersion(encryptStr[1] - '0'), encryptStr[2:]
+}
@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE files ADD CONSTRAINT files_pathtofile_key UNIQUE (pathToFile);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE files DROP CONSTRAINT files_pathtofile_key;
+-- +goose StatementEnd
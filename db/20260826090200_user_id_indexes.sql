@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Composite (user_id, updateAt) indexes serve both the plain user_id filters
+-- of the GetAll* queries and keyset pagination/sync ordered by updateAt.
+CREATE INDEX passwords_user_id_update_at_idx ON passwords (user_id, updateAt);
+CREATE INDEX banks_user_id_update_at_idx ON banks (user_id, updateAt);
+CREATE INDEX texts_user_id_update_at_idx ON texts (user_id, updateAt);
+CREATE INDEX files_user_id_update_at_idx ON files (user_id, updateAt);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX passwords_user_id_update_at_idx;
+DROP INDEX banks_user_id_update_at_idx;
+DROP INDEX texts_user_id_update_at_idx;
+DROP INDEX files_user_id_update_at_idx;
+-- +goose StatementEnd
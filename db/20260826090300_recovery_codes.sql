@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- One-time account recovery codes. Only the hash of a code is stored; the
+-- plaintext is shown to the user once at generation and a code row is
+-- deleted as soon as it is used.
+CREATE TABLE recovery_codes (
+    id UUID NOT NULL,
+    user_id UUID REFERENCES users(id) NOT NULL,
+    code_hash CHAR(64) NOT NULL CHECK (code_hash <> ''),
+    PRIMARY KEY(id)
+);
+CREATE TRIGGER gen_uuid BEFORE INSERT ON recovery_codes
+    FOR EACH ROW EXECUTE PROCEDURE gen_uuid();
+CREATE INDEX recovery_codes_user_id_idx ON recovery_codes (user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE recovery_codes;
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Optional structured metadata next to the legacy meta string. Values are
+-- sealed client-side except designated non-sensitive keys, which stay in
+-- plaintext so the server can filter on them.
+ALTER TABLE passwords ADD COLUMN meta_json JSONB;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE passwords DROP COLUMN meta_json;
+-- +goose StatementEnd
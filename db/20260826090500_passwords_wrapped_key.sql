@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Envelope encryption: the client seals the entry fields with a per-entry
+-- data key and stores that key here, wrapped with its master key. Sharing
+-- re-wraps the data key for the recipient without re-encrypting the entry.
+-- NULL means the entry is sealed directly with the master key.
+ALTER TABLE passwords ADD COLUMN wrapped_key TEXT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE passwords DROP COLUMN wrapped_key;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Keyed hash of the plaintext password computed client-side with a client
+-- secret, so the server can group reused passwords without decrypting
+-- anything. NULL means the client did not supply a fingerprint.
+ALTER TABLE passwords ADD COLUMN password_fingerprint TEXT;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- GetReusedPasswords groups a user's entries by fingerprint.
+CREATE INDEX passwords_fingerprint_idx ON passwords (user_id, password_fingerprint) WHERE password_fingerprint IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX passwords_fingerprint_idx;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE passwords DROP COLUMN password_fingerprint;
+-- +goose StatementEnd
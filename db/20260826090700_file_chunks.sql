@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- File content for the db file backend: each stored file is a sequence of
+-- chunk rows keyed by its path and read back in seq order. Deployments
+-- with shared disk keep using the disk backend and leave this table empty.
+CREATE TABLE file_chunks (
+    file_path TEXT NOT NULL,
+    seq INTEGER NOT NULL,
+    data BYTEA NOT NULL,
+    PRIMARY KEY (file_path, seq)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE file_chunks;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Device sessions: one row per user and device id from client metadata,
+-- touched on every authenticated request. token_marker records the last
+-- revocation; tokens issued before it are rejected, so revoking a session
+-- kills its tokens without affecting the user's other devices.
+CREATE TABLE sessions (
+    user_id UUID REFERENCES users(id) ON DELETE CASCADE NOT NULL,
+    device_id VARCHAR(150) NOT NULL CHECK (device_id <> ''),
+    last_seen TIMESTAMP WITH TIME ZONE NOT NULL,
+    ip VARCHAR(45) NOT NULL DEFAULT '',
+    token_marker TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT 'epoch',
+    PRIMARY KEY(user_id, device_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE sessions;
+-- +goose StatementEnd
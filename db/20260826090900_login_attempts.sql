@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Failed login counters for the database-backed rate limiter: one row per
+-- key (hashed login), incremented on every failed attempt. window_start
+-- marks the first failure of the current window; an increment after the
+-- window has elapsed starts a fresh count. Shared by all server instances,
+-- so lockouts survive restarts and apply cluster-wide.
+CREATE TABLE login_attempts (
+    key VARCHAR(150) PRIMARY KEY,
+    count BIGINT NOT NULL DEFAULT 0,
+    window_start TIMESTAMP WITH TIME ZONE NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE login_attempts;
+-- +goose StatementEnd
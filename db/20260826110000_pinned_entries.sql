@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Favorite flag on every entry kind. Pinned entries are surfaced by the
+-- GetFavorites RPC; existing rows start unpinned.
+ALTER TABLE passwords ADD COLUMN pinned BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE files ADD COLUMN pinned BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE banks ADD COLUMN pinned BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE texts ADD COLUMN pinned BOOLEAN NOT NULL DEFAULT FALSE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE passwords DROP COLUMN pinned;
+ALTER TABLE files DROP COLUMN pinned;
+ALTER TABLE banks DROP COLUMN pinned;
+ALTER TABLE texts DROP COLUMN pinned;
+-- +goose StatementEnd
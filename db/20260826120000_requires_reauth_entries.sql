@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Reveal-requires-reauth flag on every entry kind. Marked entries only
+-- return their secret to a session whose authentication is recent;
+-- existing rows stay ungated.
+ALTER TABLE passwords ADD COLUMN requires_reauth BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE files ADD COLUMN requires_reauth BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE banks ADD COLUMN requires_reauth BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE texts ADD COLUMN requires_reauth BOOLEAN NOT NULL DEFAULT FALSE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE passwords DROP COLUMN requires_reauth;
+ALTER TABLE files DROP COLUMN requires_reauth;
+ALTER TABLE banks DROP COLUMN requires_reauth;
+ALTER TABLE texts DROP COLUMN requires_reauth;
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Backs category filters over the plaintext keys of meta_json. jsonb_path_ops
+-- only supports containment (@>), which is exactly how the category filter
+-- queries, and keeps the index smaller than the default opclass.
+CREATE INDEX passwords_meta_json_idx ON passwords USING GIN (meta_json jsonb_path_ops);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX passwords_meta_json_idx;
+-- +goose StatementEnd
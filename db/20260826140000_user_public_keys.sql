@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Discoverable public keys for sharing: a sharer fetches the recipient's
+-- key to re-wrap data keys. One key per user, replaced on re-upload.
+CREATE TABLE user_public_keys (
+    user_id UUID REFERENCES users(id) ON DELETE CASCADE NOT NULL,
+    public_key TEXT NOT NULL CHECK (public_key <> ''),
+    PRIMARY KEY(user_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE user_public_keys;
+-- +goose StatementEnd
@@ -0,0 +1,393 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Tomap-Tomap/GophKeeper/storage"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// FaultyStorage decorates a Storage with transient-failure injection, so
+// the retry and backoff paths can be exercised end-to-end without a real
+// flaky database. It is meant for tests, like storage.NoRetry.
+type FaultyStorage struct {
+	Storage
+
+	mu       sync.Mutex
+	failures map[string]int
+}
+
+// NewFaultyStorage wraps the given Storage. Without scheduled failures
+// every call passes straight through.
+func NewFaultyStorage(s Storage) *FaultyStorage {
+	return &FaultyStorage{
+		Storage:  s,
+		failures: make(map[string]int),
+	}
+}
+
+// FailNext schedules the next n calls to the named method to fail with a
+// transient connection error, the class Retry and Retry2 retry on.
+func (fs *FaultyStorage) FailNext(method string, n int) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	fs.failures[method] = n
+}
+
+// injectedError consumes one scheduled failure for the method, if any.
+func (fs *FaultyStorage) injectedError(method string) error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	if fs.failures[method] <= 0 {
+		return nil
+	}
+
+	fs.failures[method]--
+
+	return &pgconn.PgError{
+		Code:    pgerrcode.ConnectionException,
+		Message: fmt.Sprintf("injected transient failure for %s", method),
+	}
+}
+
+func (fs *FaultyStorage) CreateUser(ctx context.Context, login, loginHashed, salt, password string) (*storage.User, error) {
+	if err := fs.injectedError("CreateUser"); err != nil {
+		return nil, err
+	}
+
+	return fs.Storage.CreateUser(ctx, login, loginHashed, salt, password)
+}
+
+func (fs *FaultyStorage) CreateUserPreHashed(ctx context.Context, login, loginHashed, salt, passwordHash, algo string) (*storage.User, error) {
+	if err := fs.injectedError("CreateUserPreHashed"); err != nil {
+		return nil, err
+	}
+
+	return fs.Storage.CreateUserPreHashed(ctx, login, loginHashed, salt, passwordHash, algo)
+}
+
+func (fs *FaultyStorage) GetUser(ctx context.Context, login, loginHashed string) (*storage.User, error) {
+	if err := fs.injectedError("GetUser"); err != nil {
+		return nil, err
+	}
+
+	return fs.Storage.GetUser(ctx, login, loginHashed)
+}
+
+func (fs *FaultyStorage) GetUserByLogin(ctx context.Context, login string) (*storage.User, error) {
+	if err := fs.injectedError("GetUserByLogin"); err != nil {
+		return nil, err
+	}
+
+	return fs.Storage.GetUserByLogin(ctx, login)
+}
+
+func (fs *FaultyStorage) UpsertPublicKey(ctx context.Context, userID, publicKey string) error {
+	if err := fs.injectedError("UpsertPublicKey"); err != nil {
+		return err
+	}
+
+	return fs.Storage.UpsertPublicKey(ctx, userID, publicKey)
+}
+
+func (fs *FaultyStorage) GetPublicKey(ctx context.Context, userID string) (string, error) {
+	if err := fs.injectedError("GetPublicKey"); err != nil {
+		return "", err
+	}
+
+	return fs.Storage.GetPublicKey(ctx, userID)
+}
+
+func (fs *FaultyStorage) ReplaceRecoveryCodes(ctx context.Context, userID string, codeHashes []string) error {
+	if err := fs.injectedError("ReplaceRecoveryCodes"); err != nil {
+		return err
+	}
+
+	return fs.Storage.ReplaceRecoveryCodes(ctx, userID, codeHashes)
+}
+
+func (fs *FaultyStorage) GetRecoveryCodes(ctx context.Context, userID string) ([]storage.RecoveryCode, error) {
+	if err := fs.injectedError("GetRecoveryCodes"); err != nil {
+		return nil, err
+	}
+
+	return fs.Storage.GetRecoveryCodes(ctx, userID)
+}
+
+func (fs *FaultyStorage) DeleteRecoveryCode(ctx context.Context, id string) error {
+	if err := fs.injectedError("DeleteRecoveryCode"); err != nil {
+		return err
+	}
+
+	return fs.Storage.DeleteRecoveryCode(ctx, id)
+}
+
+func (fs *FaultyStorage) CreatePassword(ctx context.Context, userID, name, login, password, meta, wrappedKey, fingerprint string, pinned, requiresReauth bool) (*storage.Password, error) {
+	if err := fs.injectedError("CreatePassword"); err != nil {
+		return nil, err
+	}
+
+	return fs.Storage.CreatePassword(ctx, userID, name, login, password, meta, wrappedKey, fingerprint, pinned, requiresReauth)
+}
+
+func (fs *FaultyStorage) UpdatePassword(ctx context.Context, id, userID, name, login, password, meta, wrappedKey, fingerprint string, pinned, requiresReauth bool) (*storage.Password, error) {
+	if err := fs.injectedError("UpdatePassword"); err != nil {
+		return nil, err
+	}
+
+	return fs.Storage.UpdatePassword(ctx, id, userID, name, login, password, meta, wrappedKey, fingerprint, pinned, requiresReauth)
+}
+
+func (fs *FaultyStorage) GetPassword(ctx context.Context, passwordID, userID string) (*storage.Password, error) {
+	if err := fs.injectedError("GetPassword"); err != nil {
+		return nil, err
+	}
+
+	return fs.Storage.GetPassword(ctx, passwordID, userID)
+}
+
+func (fs *FaultyStorage) GetAllPassword(ctx context.Context, userID string) ([]storage.Password, error) {
+	if err := fs.injectedError("GetAllPassword"); err != nil {
+		return nil, err
+	}
+
+	return fs.Storage.GetAllPassword(ctx, userID)
+}
+
+func (fs *FaultyStorage) DeletePassword(ctx context.Context, passwordID, userID string) error {
+	if err := fs.injectedError("DeletePassword"); err != nil {
+		return err
+	}
+
+	return fs.Storage.DeletePassword(ctx, passwordID, userID)
+}
+
+func (fs *FaultyStorage) TouchPassword(ctx context.Context, passwordID, userID string) (*storage.Password, error) {
+	if err := fs.injectedError("TouchPassword"); err != nil {
+		return nil, err
+	}
+
+	return fs.Storage.TouchPassword(ctx, passwordID, userID)
+}
+
+func (fs *FaultyStorage) GetReusedPasswords(ctx context.Context, userID string) ([]storage.ReusedPasswords, error) {
+	if err := fs.injectedError("GetReusedPasswords"); err != nil {
+		return nil, err
+	}
+
+	return fs.Storage.GetReusedPasswords(ctx, userID)
+}
+
+func (fs *FaultyStorage) GetPasswordsByCategory(ctx context.Context, userID, category string) ([]storage.Password, error) {
+	if err := fs.injectedError("GetPasswordsByCategory"); err != nil {
+		return nil, err
+	}
+
+	return fs.Storage.GetPasswordsByCategory(ctx, userID, category)
+}
+
+func (fs *FaultyStorage) CreateFile(ctx context.Context, userID, name, pathToFile, meta string, pinned, requiresReauth bool) (*storage.File, error) {
+	if err := fs.injectedError("CreateFile"); err != nil {
+		return nil, err
+	}
+
+	return fs.Storage.CreateFile(ctx, userID, name, pathToFile, meta, pinned, requiresReauth)
+}
+
+func (fs *FaultyStorage) UpdateFile(ctx context.Context, id, userID, name, pathToFile, meta string, pinned, requiresReauth bool) (*storage.File, error) {
+	if err := fs.injectedError("UpdateFile"); err != nil {
+		return nil, err
+	}
+
+	return fs.Storage.UpdateFile(ctx, id, userID, name, pathToFile, meta, pinned, requiresReauth)
+}
+
+func (fs *FaultyStorage) UpdateFileInfo(ctx context.Context, fileID, userID, name, meta string, pinned, requiresReauth bool) (*storage.File, error) {
+	if err := fs.injectedError("UpdateFileInfo"); err != nil {
+		return nil, err
+	}
+
+	return fs.Storage.UpdateFileInfo(ctx, fileID, userID, name, meta, pinned, requiresReauth)
+}
+
+func (fs *FaultyStorage) GetFile(ctx context.Context, fileID, userID string) (*storage.File, error) {
+	if err := fs.injectedError("GetFile"); err != nil {
+		return nil, err
+	}
+
+	return fs.Storage.GetFile(ctx, fileID, userID)
+}
+
+func (fs *FaultyStorage) GetAllFiles(ctx context.Context, userID string) ([]storage.File, error) {
+	if err := fs.injectedError("GetAllFiles"); err != nil {
+		return nil, err
+	}
+
+	return fs.Storage.GetAllFiles(ctx, userID)
+}
+
+func (fs *FaultyStorage) ListAllFiles(ctx context.Context) ([]storage.File, error) {
+	if err := fs.injectedError("ListAllFiles"); err != nil {
+		return nil, err
+	}
+
+	return fs.Storage.ListAllFiles(ctx)
+}
+
+func (fs *FaultyStorage) DeleteFile(ctx context.Context, fileID, userID string) (*storage.File, error) {
+	if err := fs.injectedError("DeleteFile"); err != nil {
+		return nil, err
+	}
+
+	return fs.Storage.DeleteFile(ctx, fileID, userID)
+}
+
+func (fs *FaultyStorage) CreateBank(ctx context.Context, userID, name, number, cvc, owner, exp, meta string, pinned, requiresReauth bool) (*storage.Bank, error) {
+	if err := fs.injectedError("CreateBank"); err != nil {
+		return nil, err
+	}
+
+	return fs.Storage.CreateBank(ctx, userID, name, number, cvc, owner, exp, meta, pinned, requiresReauth)
+}
+
+func (fs *FaultyStorage) UpdateBank(ctx context.Context, id, userID, name, number, cvc, owner, exp, meta string, pinned, requiresReauth bool) (*storage.Bank, error) {
+	if err := fs.injectedError("UpdateBank"); err != nil {
+		return nil, err
+	}
+
+	return fs.Storage.UpdateBank(ctx, id, userID, name, number, cvc, owner, exp, meta, pinned, requiresReauth)
+}
+
+func (fs *FaultyStorage) GetBank(ctx context.Context, bankID, userID string) (*storage.Bank, error) {
+	if err := fs.injectedError("GetBank"); err != nil {
+		return nil, err
+	}
+
+	return fs.Storage.GetBank(ctx, bankID, userID)
+}
+
+func (fs *FaultyStorage) GetAllBanks(ctx context.Context, userID string) ([]storage.Bank, error) {
+	if err := fs.injectedError("GetAllBanks"); err != nil {
+		return nil, err
+	}
+
+	return fs.Storage.GetAllBanks(ctx, userID)
+}
+
+func (fs *FaultyStorage) DeleteBank(ctx context.Context, bankID, userID string) error {
+	if err := fs.injectedError("DeleteBank"); err != nil {
+		return err
+	}
+
+	return fs.Storage.DeleteBank(ctx, bankID, userID)
+}
+
+func (fs *FaultyStorage) CreateText(ctx context.Context, userID, name, text, meta string, pinned, requiresReauth bool) (*storage.Text, error) {
+	if err := fs.injectedError("CreateText"); err != nil {
+		return nil, err
+	}
+
+	return fs.Storage.CreateText(ctx, userID, name, text, meta, pinned, requiresReauth)
+}
+
+func (fs *FaultyStorage) UpdateText(ctx context.Context, id, userID, name, text, meta string, pinned, requiresReauth bool) (*storage.Text, error) {
+	if err := fs.injectedError("UpdateText"); err != nil {
+		return nil, err
+	}
+
+	return fs.Storage.UpdateText(ctx, id, userID, name, text, meta, pinned, requiresReauth)
+}
+
+func (fs *FaultyStorage) GetText(ctx context.Context, textID, userID string) (*storage.Text, error) {
+	if err := fs.injectedError("GetText"); err != nil {
+		return nil, err
+	}
+
+	return fs.Storage.GetText(ctx, textID, userID)
+}
+
+func (fs *FaultyStorage) GetAllTexts(ctx context.Context, userID, orderBy, orderDir string, limit, offset int) ([]storage.Text, error) {
+	if err := fs.injectedError("GetAllTexts"); err != nil {
+		return nil, err
+	}
+
+	return fs.Storage.GetAllTexts(ctx, userID, orderBy, orderDir, limit, offset)
+}
+
+func (fs *FaultyStorage) DeleteText(ctx context.Context, textID, userID string) error {
+	if err := fs.injectedError("DeleteText"); err != nil {
+		return err
+	}
+
+	return fs.Storage.DeleteText(ctx, textID, userID)
+}
+
+func (fs *FaultyStorage) GetRecentActivity(ctx context.Context, userID string, limit int) ([]storage.Activity, error) {
+	if err := fs.injectedError("GetRecentActivity"); err != nil {
+		return nil, err
+	}
+
+	return fs.Storage.GetRecentActivity(ctx, userID, limit)
+}
+
+func (fs *FaultyStorage) GetFavorites(ctx context.Context, userID string) (*storage.Favorites, error) {
+	if err := fs.injectedError("GetFavorites"); err != nil {
+		return nil, err
+	}
+
+	return fs.Storage.GetFavorites(ctx, userID)
+}
+
+func (fs *FaultyStorage) GetEntryIDs(ctx context.Context, userID, kind string) ([]storage.EntryID, error) {
+	if err := fs.injectedError("GetEntryIDs"); err != nil {
+		return nil, err
+	}
+
+	return fs.Storage.GetEntryIDs(ctx, userID, kind)
+}
+
+func (fs *FaultyStorage) GetVaultManifest(ctx context.Context, userID string, limit, offset int) ([]storage.ManifestEntry, error) {
+	if err := fs.injectedError("GetVaultManifest"); err != nil {
+		return nil, err
+	}
+
+	return fs.Storage.GetVaultManifest(ctx, userID, limit, offset)
+}
+
+func (fs *FaultyStorage) ListSessions(ctx context.Context, userID string) ([]storage.Session, error) {
+	if err := fs.injectedError("ListSessions"); err != nil {
+		return nil, err
+	}
+
+	return fs.Storage.ListSessions(ctx, userID)
+}
+
+func (fs *FaultyStorage) RevokeSession(ctx context.Context, userID, deviceID string) error {
+	if err := fs.injectedError("RevokeSession"); err != nil {
+		return err
+	}
+
+	return fs.Storage.RevokeSession(ctx, userID, deviceID)
+}
+
+func (fs *FaultyStorage) Maintenance(ctx context.Context) (time.Duration, error) {
+	if err := fs.injectedError("Maintenance"); err != nil {
+		return 0, err
+	}
+
+	return fs.Storage.Maintenance(ctx)
+}
+
+func (fs *FaultyStorage) ServerTime(ctx context.Context) (time.Time, error) {
+	if err := fs.injectedError("ServerTime"); err != nil {
+		return time.Time{}, err
+	}
+
+	return fs.Storage.ServerTime(ctx)
+}
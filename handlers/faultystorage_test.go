@@ -0,0 +1,77 @@
+//go:build unit
+
+package handlers
+
+import (
+	"context"
+
+	"github.com/Tomap-Tomap/GophKeeper/storage"
+)
+
+func (suite *HandlersTestSuite) TestFaultyStorageRetry() {
+	require := suite.Require()
+
+	rp := *storage.NewRetryPolicy(3, 1, 1)
+
+	createPassword := func(faulty *FaultyStorage) (*storage.Password, error) {
+		return storage.Retry2(context.Background(), rp, func() (*storage.Password, error) {
+			return faulty.CreatePassword(
+				context.Background(),
+				suite.testUserID,
+				suite.testName,
+				suite.testLogin,
+				suite.testPassword,
+				suite.testMeta,
+				emptyString,
+				emptyString,
+				false,
+				false,
+			)
+		})
+	}
+
+	suite.Run("Retry2 recovers after two injected failures", func() {
+		faulty := NewFaultyStorage(suite.storageMock)
+		faulty.FailNext("CreatePassword", 2)
+
+		wantPWD := &storage.Password{ID: suite.testPasswordID}
+		suite.storageMock.onCreatePassword(
+			suite.testUserID,
+			suite.testName,
+			suite.testLogin,
+			suite.testPassword,
+			suite.testMeta,
+			emptyString,
+			emptyString,
+			false,
+			false,
+			wantPWD,
+			nil,
+		)
+
+		gotPWD, err := createPassword(faulty)
+		require.NoError(err)
+		require.Equal(wantPWD, gotPWD)
+	})
+
+	suite.Run("failures beyond the retry budget surface", func() {
+		faulty := NewFaultyStorage(suite.storageMock)
+		faulty.FailNext("CreatePassword", 4)
+
+		gotPWD, err := createPassword(faulty)
+		require.ErrorContains(err, "injected transient failure for CreatePassword")
+		require.Nil(gotPWD)
+	})
+
+	suite.Run("other methods pass through untouched", func() {
+		faulty := NewFaultyStorage(suite.storageMock)
+		faulty.FailNext("CreatePassword", 1)
+
+		wantPWD := &storage.Password{ID: suite.testPasswordID}
+		suite.storageMock.onGetPassword(suite.testPasswordID, suite.testUserID, wantPWD, nil)
+
+		gotPWD, err := faulty.GetPassword(context.Background(), suite.testPasswordID, suite.testUserID)
+		require.NoError(err)
+		require.Equal(wantPWD, gotPWD)
+	})
+}
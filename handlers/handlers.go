@@ -0,0 +1,2543 @@
+// Package handlers defines methods and structures for a grpc server
+package handlers
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Tomap-Tomap/GophKeeper/hasher"
+	"github.com/Tomap-Tomap/GophKeeper/logger"
+	proto "github.com/Tomap-Tomap/GophKeeper/proto/gophkeeper/v1"
+	"github.com/Tomap-Tomap/GophKeeper/ratelimit"
+	"github.com/Tomap-Tomap/GophKeeper/storage"
+	"github.com/Tomap-Tomap/GophKeeper/tokener"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	userIDHeader     = "user_id"
+	tokenExpHeader   = "token_exp"
+	authAtHeader     = "auth_at"
+	adminTokenHeader = "admin_token"
+
+	// filePathRetries limits how many fresh UUID paths CreateFile tries
+	// after a unique violation on files.pathToFile.
+	filePathRetries = 3
+
+	// defaultActivityLimit caps GetRecentActivity when the request does not
+	// set a limit.
+	defaultActivityLimit = 50
+
+	// recoveryCodeCount is how many one-time codes a generation produces;
+	// recoveryCodeLength is their random length in bytes before hex encoding.
+	recoveryCodeCount  = 10
+	recoveryCodeLength = 8
+
+	// defaultReauthWindow is how recent the last password entry must be for
+	// an entry marked requires_reauth to reveal its secret.
+	defaultReauthWindow = 5 * time.Minute
+)
+
+// Storage defines methods for interacting with a storage system.
+type Storage interface {
+	CreateUser(ctx context.Context, login, loginHashed, salt, password string) (*storage.User, error)
+	CreateUserPreHashed(ctx context.Context, login, loginHashed, salt, passwordHash, algo string) (*storage.User, error)
+	GetUser(ctx context.Context, login, loginHashed string) (*storage.User, error)
+	GetUserByLogin(ctx context.Context, login string) (*storage.User, error)
+
+	UpsertPublicKey(ctx context.Context, userID, publicKey string) error
+	GetPublicKey(ctx context.Context, userID string) (string, error)
+
+	ReplaceRecoveryCodes(ctx context.Context, userID string, codeHashes []string) error
+	GetRecoveryCodes(ctx context.Context, userID string) ([]storage.RecoveryCode, error)
+	DeleteRecoveryCode(ctx context.Context, id string) error
+
+	CreatePassword(ctx context.Context, userID, name, login, password, meta, wrappedKey, fingerprint string, pinned, requiresReauth bool) (*storage.Password, error)
+	UpdatePassword(ctx context.Context, id, userID, name, login, password, meta, wrappedKey, fingerprint string, pinned, requiresReauth bool) (*storage.Password, error)
+	GetPassword(ctx context.Context, passwordID, userID string) (*storage.Password, error)
+	GetAllPassword(ctx context.Context, userID string) ([]storage.Password, error)
+	DeletePassword(ctx context.Context, passwordID, userID string) error
+	TouchPassword(ctx context.Context, passwordID, userID string) (*storage.Password, error)
+	GetReusedPasswords(ctx context.Context, userID string) ([]storage.ReusedPasswords, error)
+	GetPasswordsByCategory(ctx context.Context, userID, category string) ([]storage.Password, error)
+
+	CreateFile(ctx context.Context, userID, name, pathToFile, meta string, pinned, requiresReauth bool) (*storage.File, error)
+	UpdateFile(ctx context.Context, id, userID, name, pathToFile, meta string, pinned, requiresReauth bool) (*storage.File, error)
+	UpdateFileInfo(ctx context.Context, fileID, userID, name, meta string, pinned, requiresReauth bool) (*storage.File, error)
+	GetFile(ctx context.Context, fileID, userID string) (*storage.File, error)
+	GetAllFiles(ctx context.Context, userID string) ([]storage.File, error)
+	ListAllFiles(ctx context.Context) ([]storage.File, error)
+	DeleteFile(ctx context.Context, fileID, userID string) (*storage.File, error)
+
+	CreateBank(ctx context.Context, userID, name, number, cvc, owner, exp, meta string, pinned, requiresReauth bool) (*storage.Bank, error)
+	UpdateBank(ctx context.Context, id, userID, name, number, cvc, owner, exp, meta string, pinned, requiresReauth bool) (*storage.Bank, error)
+	GetBank(ctx context.Context, bankID, userID string) (*storage.Bank, error)
+	GetAllBanks(ctx context.Context, userID string) ([]storage.Bank, error)
+	DeleteBank(ctx context.Context, bankID, userID string) error
+
+	CreateText(ctx context.Context, userID, name, text, meta string, pinned, requiresReauth bool) (*storage.Text, error)
+	UpdateText(ctx context.Context, id, userID, name, text, meta string, pinned, requiresReauth bool) (*storage.Text, error)
+	GetText(ctx context.Context, textID, userID string) (*storage.Text, error)
+	GetAllTexts(ctx context.Context, userID, orderBy, orderDir string, limit, offset int) ([]storage.Text, error)
+	DeleteText(ctx context.Context, textID, userID string) error
+
+	GetRecentActivity(ctx context.Context, userID string, limit int) ([]storage.Activity, error)
+	GetFavorites(ctx context.Context, userID string) (*storage.Favorites, error)
+	GetEntryIDs(ctx context.Context, userID, kind string) ([]storage.EntryID, error)
+	GetVaultManifest(ctx context.Context, userID string, limit, offset int) ([]storage.ManifestEntry, error)
+	ListSessions(ctx context.Context, userID string) ([]storage.Session, error)
+	RevokeSession(ctx context.Context, userID, deviceID string) error
+
+	Maintenance(ctx context.Context) (time.Duration, error)
+	ServerTime(ctx context.Context) (time.Time, error)
+}
+
+// Hasher interface defines methods for generating salts and hashes.
+type Hasher interface {
+	GenerateSalt(len int) (string, error)
+	GenerateHash(str string) string
+	GenerateHashWithSalt(str, salt string) (string, error)
+	VerifyHashWithSalt(str, salt, hash, algo string) (bool, error)
+}
+
+// Tokener describes methods for generating tokens and answering identity
+// challenges.
+type Tokener interface {
+	GetToken(sub string) (string, error)
+	SignChallenge(nonce []byte) ([]byte, error)
+}
+
+// FileStore defines the interface for interacting with database files.
+type FileStore interface {
+	CreateDBFile(fileName string) (storage.DBFiler, error)
+	GetDBFile(fileName string) (storage.DBFiler, error)
+	DeleteDBFile(fileName string) error
+	GetChunkSize() int
+}
+
+// GophKeeperHandler is a struct that implements the functionality of a gRPC server.
+type GophKeeperHandler struct {
+	proto.UnimplementedGophKeeperServiceServer
+
+	rp storage.RetryPolicy
+	s  Storage
+	h  Hasher
+	t  Tokener
+	fs FileStore
+
+	saltLenght      int
+	adminToken      string
+	allowEmptyFiles bool
+	listLimit       int
+
+	rl        ratelimit.Store
+	authLimit int64
+
+	reauthWindow time.Duration
+
+	integrityMu     sync.Mutex
+	integrityReport *IntegrityReport
+}
+
+// defaultListLimit caps list responses when no explicit limit is configured.
+const defaultListLimit = 1000
+
+// NewGophKeeperHandler initializes a GophKeeperHandler structure.
+// An empty adminToken disables the guarded admin methods. A non-positive
+// listLimit falls back to defaultListLimit.
+func NewGophKeeperHandler(s Storage, h Hasher, t Tokener, fs FileStore, rp storage.RetryPolicy, saltLength int, adminToken string, allowEmptyFiles bool, listLimit int) *GophKeeperHandler {
+	if listLimit <= 0 {
+		listLimit = defaultListLimit
+	}
+
+	return &GophKeeperHandler{
+		s:               s,
+		h:               h,
+		rp:              rp,
+		t:               t,
+		fs:              fs,
+		saltLenght:      saltLength,
+		adminToken:      adminToken,
+		allowEmptyFiles: allowEmptyFiles,
+		listLimit:       listLimit,
+		reauthWindow:    defaultReauthWindow,
+	}
+}
+
+// SetReauthWindow overrides how recent the last password entry must be to
+// reveal an entry marked requires_reauth. A non-positive duration keeps the
+// default.
+func (gk *GophKeeperHandler) SetReauthWindow(window time.Duration) {
+	if window > 0 {
+		gk.reauthWindow = window
+	}
+}
+
+// SetRateLimiter installs a failed-attempt counter guarding Auth and the
+// number of failures allowed per window; once passed, further attempts are
+// rejected until the window expires. A nil store disables the lockout.
+func (gk *GophKeeperHandler) SetRateLimiter(rl ratelimit.Store, limit int64) {
+	gk.rl = rl
+	gk.authLimit = limit
+}
+
+// authFailure counts a failed login attempt against key and masks authErr
+// with ResourceExhausted once the attempt limit is passed. Counter errors
+// fail open: a broken limiter must not lock every user out.
+func (gk *GophKeeperHandler) authFailure(ctx context.Context, key string, authErr error) error {
+	if gk.rl == nil {
+		return authErr
+	}
+
+	count, err := gk.rl.Increment(ctx, key)
+
+	if err != nil {
+		logger.Log.Warn("Cannot count failed attempt", zap.Error(err))
+		return authErr
+	}
+
+	if count > gk.authLimit {
+		return status.Error(codes.ResourceExhausted, "too many failed attempts, try again later")
+	}
+
+	return authErr
+}
+
+// capList cuts entries down to the handler's list limit, reporting whether
+// anything was cut off, so the response can flag the truncation.
+func capList[T any](entries []T, limit int) ([]T, bool) {
+	if len(entries) > limit {
+		return entries[:limit], true
+	}
+
+	return entries, false
+}
+
+// Register handles the creation of a new user. It validates the login and password,
+// generates a salt and hash for the password, and stores the user information in the storage.
+func (gk *GophKeeperHandler) Register(ctx context.Context, req *proto.RegisterRequest) (*proto.RegisterResponse, error) {
+	login := strings.TrimSpace(req.GetLogin())
+	password := strings.TrimSpace(req.GetPassword())
+
+	loginHash := gk.h.GenerateHash(login)
+
+	salt, err := gk.h.GenerateSalt(gk.saltLenght)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, "generate salt")
+	}
+
+	passwordHash, err := gk.h.GenerateHashWithSalt(password, salt)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, "generate hash")
+	}
+
+	user, err := storage.Retry2(ctx, gk.rp, func() (*storage.User, error) {
+		return gk.s.CreateUser(ctx, login, loginHash, salt, passwordHash)
+	})
+
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrUserAlreadyExists):
+			return nil, status.Errorf(codes.AlreadyExists, "user %s already exists", login)
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	token, err := gk.t.GetToken(user.ID)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.RegisterResponse{Token: token}, nil
+}
+
+// Auth handles the retrieval of a user by their login and password. It validates the login and password,
+// generates a hash with the stored salt, and retrieves the user information from the storage.
+func (gk *GophKeeperHandler) Auth(ctx context.Context, req *proto.AuthRequest) (*proto.AuthResponse, error) {
+	login := strings.TrimSpace(req.GetLogin())
+	password := strings.TrimSpace(req.GetPassword())
+
+	loginHash := gk.h.GenerateHash(login)
+
+	user, err := storage.Retry2(ctx, gk.rp, func() (*storage.User, error) {
+		return gk.s.GetUser(ctx, login, loginHash)
+	})
+
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrUserNotFound):
+			return nil, gk.authFailure(ctx, loginHash, status.Errorf(codes.Unknown, "unknown user %s", login))
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	ok, err := gk.h.VerifyHashWithSalt(password, user.Salt, user.Password, user.Algo)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if !ok {
+		return nil, gk.authFailure(ctx, loginHash, status.Error(codes.PermissionDenied, "invalid password"))
+	}
+
+	if gk.rl != nil {
+		if err := gk.rl.Reset(ctx, loginHash); err != nil {
+			logger.Log.Warn("Cannot reset failed attempts", zap.Error(err))
+		}
+	}
+
+	token, err := gk.t.GetToken(user.ID)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.AuthResponse{Token: token}, nil
+}
+
+// Challenge signs the client's nonce with the server's challenge key so
+// the client can confirm it is talking to the expected server before
+// sending credentials. Available without authentication; fails when no
+// challenge key is configured.
+func (gk *GophKeeperHandler) Challenge(_ context.Context, req *proto.ChallengeRequest) (*proto.ChallengeResponse, error) {
+	signature, err := gk.t.SignChallenge(req.GetNonce())
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.ChallengeResponse{Signature: signature}, nil
+}
+
+// Maintenance runs VACUUM (ANALYZE) on the data tables and returns the run
+// duration, so operators can reclaim space after big deletes or purges.
+// The method is guarded by the admin token and cancellable via context.
+func (gk *GophKeeperHandler) Maintenance(ctx context.Context, _ *proto.MaintenanceRequest) (*proto.MaintenanceResponse, error) {
+	if err := gk.checkAdminToken(ctx); err != nil {
+		return nil, err
+	}
+
+	duration, err := gk.s.Maintenance(ctx)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.MaintenanceResponse{
+		Duration: durationpb.New(duration),
+	}, nil
+}
+
+// integrityScanPause is the wait between two blob checks. The scan walks
+// every stored file, so without a pause it would hammer the blob storage
+// with sequential IO.
+const integrityScanPause = 50 * time.Millisecond
+
+// IntegrityAnomaly records one files row whose blob is missing or
+// unreadable.
+type IntegrityAnomaly struct {
+	FileID string
+	UserID string
+	Reason string
+}
+
+// IntegrityReport is the outcome of one blob integrity scan.
+type IntegrityReport struct {
+	ScannedAt    time.Time
+	FilesChecked int
+	Anomalies    []IntegrityAnomaly
+}
+
+// RunIntegrityScan walks every files row and verifies its blob can be
+// opened and read through the FileStore, pausing between checks to avoid
+// an IO storm. The resulting report is cached for GetIntegrityReport.
+func (gk *GophKeeperHandler) RunIntegrityScan(ctx context.Context) (*IntegrityReport, error) {
+	files, err := storage.Retry2(ctx, gk.rp, func() ([]storage.File, error) {
+		return gk.s.ListAllFiles(ctx)
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("list files: %w", err)
+	}
+
+	report := &IntegrityReport{
+		ScannedAt:    time.Now(),
+		FilesChecked: len(files),
+	}
+
+	for i, file := range files {
+		if reason := gk.checkBlob(file.PathToFile); reason != "" {
+			report.Anomalies = append(report.Anomalies, IntegrityAnomaly{
+				FileID: file.ID,
+				UserID: file.UserID,
+				Reason: reason,
+			})
+		}
+
+		if i == len(files)-1 {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(integrityScanPause):
+		}
+	}
+
+	gk.integrityMu.Lock()
+	gk.integrityReport = report
+	gk.integrityMu.Unlock()
+
+	return report, nil
+}
+
+// checkBlob opens the blob and reads it to the end, returning an empty
+// string for a healthy blob and the failure reason otherwise.
+func (gk *GophKeeperHandler) checkBlob(pathToFile string) string {
+	file, err := gk.fs.GetDBFile(pathToFile)
+
+	if err != nil {
+		return fmt.Sprintf("cannot open blob: %s", err)
+	}
+
+	defer file.Close()
+
+	for {
+		_, err := file.GetChunk()
+
+		if errors.Is(err, io.EOF) {
+			return ""
+		}
+
+		if err != nil {
+			return fmt.Sprintf("cannot read blob: %s", err)
+		}
+	}
+}
+
+// StartIntegrityScan runs RunIntegrityScan every interval until the context
+// is cancelled. Scan failures are logged and do not stop the loop.
+func (gk *GophKeeperHandler) StartIntegrityScan(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if _, err := gk.RunIntegrityScan(ctx); err != nil {
+					logger.Log.Warn("Integrity scan failed", zap.Error(err))
+				}
+			}
+		}
+	}()
+}
+
+// GetIntegrityReport returns the result of the latest blob integrity scan,
+// running one synchronously when none has completed yet. The method is
+// guarded by the admin token.
+func (gk *GophKeeperHandler) GetIntegrityReport(ctx context.Context, _ *proto.GetIntegrityReportRequest) (*proto.GetIntegrityReportResponse, error) {
+	if err := gk.checkAdminToken(ctx); err != nil {
+		return nil, err
+	}
+
+	gk.integrityMu.Lock()
+	report := gk.integrityReport
+	gk.integrityMu.Unlock()
+
+	if report == nil {
+		var err error
+		report, err = gk.RunIntegrityScan(ctx)
+
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	anomalies := make([]*proto.IntegrityAnomaly, 0, len(report.Anomalies))
+
+	for _, anomaly := range report.Anomalies {
+		anomalies = append(anomalies, &proto.IntegrityAnomaly{
+			FileId: anomaly.FileID,
+			UserId: anomaly.UserID,
+			Reason: anomaly.Reason,
+		})
+	}
+
+	return &proto.GetIntegrityReportResponse{
+		ScannedAt:    timestamppb.New(report.ScannedAt),
+		FilesChecked: int64(report.FilesChecked),
+		Anomalies:    anomalies,
+	}, nil
+}
+
+// GenerateRecoveryCodes creates a fresh set of one-time recovery codes for
+// the authenticated user, replacing any previous set. The plaintext codes
+// are returned exactly once; only their hashes are stored.
+func (gk *GophKeeperHandler) GenerateRecoveryCodes(ctx context.Context, _ *proto.GenerateRecoveryCodesRequest) (*proto.GenerateRecoveryCodesResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	plainCodes := make([]string, 0, recoveryCodeCount)
+	codeHashes := make([]string, 0, recoveryCodeCount)
+
+	for i := 0; i < recoveryCodeCount; i++ {
+		code, err := gk.h.GenerateSalt(recoveryCodeLength)
+
+		if err != nil {
+			return nil, status.Error(codes.Internal, "generate recovery code")
+		}
+
+		plainCodes = append(plainCodes, code)
+		codeHashes = append(codeHashes, gk.h.GenerateHash(code))
+	}
+
+	err = storage.Retry(ctx, gk.rp, func() error {
+		return gk.s.ReplaceRecoveryCodes(ctx, userID, codeHashes)
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.GenerateRecoveryCodesResponse{Codes: plainCodes}, nil
+}
+
+// RecoverWithCode issues a token for a user who lost their password, given
+// the login and a valid one-time recovery code. The matched code is deleted
+// so it cannot be used twice. This recovers account access only; the
+// encrypted data still needs the user's key.
+func (gk *GophKeeperHandler) RecoverWithCode(ctx context.Context, req *proto.RecoverWithCodeRequest) (*proto.RecoverWithCodeResponse, error) {
+	login := strings.TrimSpace(req.GetLogin())
+	code := strings.TrimSpace(req.GetCode())
+
+	user, err := storage.Retry2(ctx, gk.rp, func() (*storage.User, error) {
+		return gk.s.GetUserByLogin(ctx, login)
+	})
+
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrUserNotFound):
+			return nil, status.Errorf(codes.Unknown, "unknown user %s", login)
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	storedCodes, err := storage.Retry2(ctx, gk.rp, func() ([]storage.RecoveryCode, error) {
+		return gk.s.GetRecoveryCodes(ctx, user.ID)
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	codeHash := gk.h.GenerateHash(code)
+
+	for _, stored := range storedCodes {
+		if stored.CodeHash != codeHash {
+			continue
+		}
+
+		err := storage.Retry(ctx, gk.rp, func() error {
+			return gk.s.DeleteRecoveryCode(ctx, stored.ID)
+		})
+
+		if errors.Is(err, storage.ErrRecoveryCodeNotFound) {
+			// The code was redeemed concurrently; treat it as spent.
+			break
+		}
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		token, err := gk.t.GetToken(user.ID)
+
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		return &proto.RecoverWithCodeResponse{Token: token}, nil
+	}
+
+	return nil, status.Error(codes.PermissionDenied, "invalid recovery code")
+}
+
+// UploadPublicKey stores the authenticated user's public key so other
+// users can discover it for sharing. The key must be a PEM-encoded RSA
+// public key; anything else is rejected before touching the database.
+func (gk *GophKeeperHandler) UploadPublicKey(ctx context.Context, req *proto.UploadPublicKeyRequest) (*proto.UploadPublicKeyResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	if _, err := tokener.ParsePublicKeyPEM([]byte(req.GetPublicKey())); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid public key: %s", err)
+	}
+
+	err = storage.Retry(ctx, gk.rp, func() error {
+		return gk.s.UpsertPublicKey(ctx, userID, req.GetPublicKey())
+	})
+
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrUserNotFound):
+			return nil, status.Errorf(codes.Unknown, "unknown UserID %s", userID)
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &proto.UploadPublicKeyResponse{}, nil
+}
+
+// GetPublicKey returns the public key uploaded by the user with the given
+// login, so a sharer can re-wrap data keys for that recipient.
+func (gk *GophKeeperHandler) GetPublicKey(ctx context.Context, req *proto.GetPublicKeyRequest) (*proto.GetPublicKeyResponse, error) {
+	_, err := getUserIDFromContext(ctx)
+
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	login := strings.TrimSpace(req.GetLogin())
+
+	user, err := storage.Retry2(ctx, gk.rp, func() (*storage.User, error) {
+		return gk.s.GetUserByLogin(ctx, login)
+	})
+
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrUserNotFound):
+			return nil, status.Errorf(codes.Unknown, "unknown user %s", login)
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	publicKey, err := storage.Retry2(ctx, gk.rp, func() (string, error) {
+		return gk.s.GetPublicKey(ctx, user.ID)
+	})
+
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrPublicKeyNotFound):
+			return nil, status.Errorf(codes.NotFound, "no public key for user %s", login)
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &proto.GetPublicKeyResponse{PublicKey: publicKey}, nil
+}
+
+// ImportUser handles the creation of a user migrated from another system.
+// The provided password hash, salt and algorithm marker are stored verbatim,
+// so the imported user can authenticate with the original password. An
+// algorithm the hasher cannot verify is rejected up front, because the
+// account would otherwise be created but never able to authenticate.
+// The method is guarded by the admin token.
+func (gk *GophKeeperHandler) ImportUser(ctx context.Context, req *proto.ImportUserRequest) (*proto.ImportUserResponse, error) {
+	if err := gk.checkAdminToken(ctx); err != nil {
+		return nil, err
+	}
+
+	if !hasher.SupportedAlgo(req.GetAlgo()) {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported hash algorithm %s", req.GetAlgo())
+	}
+
+	login := strings.TrimSpace(req.GetLogin())
+
+	loginHash := gk.h.GenerateHash(login)
+
+	_, err := storage.Retry2(ctx, gk.rp, func() (*storage.User, error) {
+		return gk.s.CreateUserPreHashed(ctx, login, loginHash, req.GetSalt(), req.GetPasswordHash(), req.GetAlgo())
+	})
+
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrUserAlreadyExists):
+			return nil, status.Errorf(codes.AlreadyExists, "user %s already exists", login)
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &proto.ImportUserResponse{}, nil
+}
+
+// ValidateToken reports the identity and expiry behind the presented token.
+// The auth interceptor has already verified the token by the time this
+// handler runs, so the client can use it as a cheap validity probe.
+func (gk *GophKeeperHandler) ValidateToken(ctx context.Context, _ *proto.ValidateTokenRequest) (*proto.ValidateTokenResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	res := &proto.ValidateTokenResponse{
+		UserId: userID,
+	}
+
+	if exp, ok := getTokenExpFromContext(ctx); ok {
+		res.ExpiresAt = timestamppb.New(exp)
+	}
+
+	return res, nil
+}
+
+// GetChunkSize is a gRPC handler that returns the chunk size used by the file storage system.
+// A non-positive size means the file storage is misconfigured and is reported instead of
+// silently breaking streaming on the client.
+func (gk *GophKeeperHandler) GetChunkSize(_ context.Context, _ *proto.GetChunkSizeRequest) (*proto.GetChunkSizeResponse, error) {
+	size := gk.fs.GetChunkSize()
+
+	if size <= 0 {
+		return nil, status.Error(codes.Internal, "non-positive chunk size configured")
+	}
+
+	return &proto.GetChunkSizeResponse{
+		Size: uint64(size),
+	}, nil
+}
+
+// ServerInfo reports the server's current time, read from the database clock
+// that also stamps every UpdateAt. Clients compute their offset from it so
+// "modified since" comparisons tolerate local clock skew. No authentication
+// is required.
+func (gk *GophKeeperHandler) ServerInfo(ctx context.Context, _ *proto.ServerInfoRequest) (*proto.ServerInfoResponse, error) {
+	serverTime, err := storage.Retry2(ctx, gk.rp, func() (time.Time, error) {
+		return gk.s.ServerTime(ctx)
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.ServerInfoResponse{
+		ServerTime: timestamppb.New(serverTime),
+	}, nil
+}
+
+// CreatePassword handles the creation of a new password entry for a user. It retrieves the user ID from the context,
+// and stores the password information in the storage.
+func (gk *GophKeeperHandler) CreatePassword(ctx context.Context, req *proto.CreatePasswordRequest) (*proto.CreatePasswordResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	pwd, err := storage.Retry2(ctx, gk.rp, func() (*storage.Password, error) {
+		return gk.s.CreatePassword(
+			ctx,
+			userID,
+			req.Name,
+			req.Login,
+			req.Password,
+			req.Meta,
+			req.WrappedKey,
+			req.Fingerprint,
+			req.Pinned,
+			req.RequiresReauth,
+		)
+	})
+
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrUserNotFound):
+			return nil, status.Errorf(codes.Unknown, "unknown UserID %s", userID)
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &proto.CreatePasswordResponse{Id: pwd.ID}, nil
+}
+
+// UpdatePassword handles the updating of an existing password entry for a user.
+func (gk *GophKeeperHandler) UpdatePassword(ctx context.Context, req *proto.UpdatePasswordRequest) (*proto.UpdatePasswordResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	pwd, err := storage.Retry2(ctx, gk.rp, func() (*storage.Password, error) {
+		return gk.s.UpdatePassword(
+			ctx,
+			req.Id,
+			userID,
+			req.Name,
+			req.Login,
+			req.Password,
+			req.Meta,
+			req.WrappedKey,
+			req.Fingerprint,
+			req.Pinned,
+			req.RequiresReauth,
+		)
+	})
+
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrUserNotFound):
+			return nil, status.Errorf(codes.Unknown, "unknown UserID %s", userID)
+		case errors.Is(err, storage.ErrPasswordNotFound):
+			return nil, status.Errorf(codes.Unknown, "unknown PasswordID %s", req.Id)
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &proto.UpdatePasswordResponse{Id: pwd.ID}, nil
+}
+
+// GetPassword handles the retrieval of a password entry by its ID. It retrieves the user ID from the context,
+// and fetches the password information from the storage.
+func (gk *GophKeeperHandler) GetPassword(ctx context.Context, req *proto.GetPasswordRequest) (*proto.GetPasswordResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	passwordID := strings.TrimSpace(req.GetId())
+
+	if passwordID == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty PasswordID")
+	}
+
+	pwd, err := storage.Retry2(ctx, gk.rp, func() (*storage.Password, error) {
+		return gk.s.GetPassword(
+			ctx,
+			passwordID,
+			userID,
+		)
+	})
+
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrPasswordNotFound):
+			return nil, status.Errorf(codes.Unknown, "unknown PasswordID %s", passwordID)
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	if pwd.RequiresReauth {
+		if err := gk.checkRecentAuth(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if ims := req.GetIfModifiedSince(); ims != nil && !pwd.UpdateAt.After(ims.AsTime()) {
+		return &proto.GetPasswordResponse{
+			NotModified: true,
+		}, nil
+	}
+
+	return &proto.GetPasswordResponse{
+		Password: &proto.Password{
+			Id:             pwd.ID,
+			Name:           pwd.Name,
+			Login:          pwd.Login,
+			Password:       pwd.Password,
+			Meta:           pwd.Meta,
+			WrappedKey:     pwd.WrappedKey,
+			Pinned:         pwd.Pinned,
+			RequiresReauth: pwd.RequiresReauth,
+			UpdateAt:       timestamppb.New(pwd.UpdateAt),
+		},
+	}, nil
+}
+
+// GetPasswords handles the retrieval of all password entries for a user. It retrieves the user ID from the context,
+// and fetches all password information from the storage.
+func (gk *GophKeeperHandler) GetPasswords(ctx context.Context, _ *proto.GetPasswordsRequest) (*proto.GetPasswordsResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	pwds, err := storage.Retry2(ctx, gk.rp, func() ([]storage.Password, error) {
+		return gk.s.GetAllPassword(
+			ctx,
+			userID,
+		)
+	})
+
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrUserNotFound):
+			return nil, status.Errorf(codes.Unknown, "unknown UserID %s", userID)
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	pwds, truncated := capList(pwds, gk.listLimit)
+
+	// The list carries the same sealed fields as a single fetch, so one
+	// gated entry gates the whole response.
+	for _, val := range pwds {
+		if val.RequiresReauth {
+			if err := gk.checkRecentAuth(ctx); err != nil {
+				return nil, err
+			}
+
+			break
+		}
+	}
+
+	protoPWDs := make([]*proto.Password, 0, len(pwds))
+
+	for _, val := range pwds {
+		protoPWDs = append(protoPWDs, &proto.Password{
+			Id:             val.ID,
+			Name:           val.Name,
+			Login:          val.Login,
+			Password:       val.Password,
+			Meta:           val.Meta,
+			WrappedKey:     val.WrappedKey,
+			Pinned:         val.Pinned,
+			RequiresReauth: val.RequiresReauth,
+			UpdateAt:       timestamppb.New(val.UpdateAt),
+		})
+	}
+
+	return &proto.GetPasswordsResponse{
+		Passwords: protoPWDs,
+		Truncated: truncated,
+	}, nil
+}
+
+// GetPasswordsByCategory handles a request to receive the passwords whose
+// plaintext meta_json category matches. The filter runs server-side, so
+// category views do not need to fetch and decrypt the whole vault.
+func (gk *GophKeeperHandler) GetPasswordsByCategory(ctx context.Context, req *proto.GetPasswordsByCategoryRequest) (*proto.GetPasswordsByCategoryResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	pwds, err := storage.Retry2(ctx, gk.rp, func() ([]storage.Password, error) {
+		return gk.s.GetPasswordsByCategory(
+			ctx,
+			userID,
+			req.GetCategory(),
+		)
+	})
+
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrUserNotFound):
+			return nil, status.Errorf(codes.Unknown, "unknown UserID %s", userID)
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	pwds, truncated := capList(pwds, gk.listLimit)
+
+	for _, val := range pwds {
+		if val.RequiresReauth {
+			if err := gk.checkRecentAuth(ctx); err != nil {
+				return nil, err
+			}
+
+			break
+		}
+	}
+
+	protoPWDs := make([]*proto.Password, 0, len(pwds))
+
+	for _, val := range pwds {
+		protoPWDs = append(protoPWDs, &proto.Password{
+			Id:             val.ID,
+			Name:           val.Name,
+			Login:          val.Login,
+			Password:       val.Password,
+			Meta:           val.Meta,
+			WrappedKey:     val.WrappedKey,
+			Pinned:         val.Pinned,
+			RequiresReauth: val.RequiresReauth,
+			UpdateAt:       timestamppb.New(val.UpdateAt),
+		})
+	}
+
+	return &proto.GetPasswordsByCategoryResponse{
+		Passwords: protoPWDs,
+		Truncated: truncated,
+	}, nil
+}
+
+// DeletePassword handles the deletion of a password entry by its ID. It retrieves the user ID from the context,
+// and deletes the password information from the storage.
+func (gk *GophKeeperHandler) DeletePassword(ctx context.Context, req *proto.DeletePasswordRequest) (*proto.DeletePasswordResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	err = storage.Retry(ctx, gk.rp, func() error {
+		return gk.s.DeletePassword(
+			ctx,
+			req.Id,
+			userID,
+		)
+	})
+
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrUserNotFound):
+			return nil, status.Errorf(codes.Unknown, "unknown UserID %s", userID)
+		case errors.Is(err, storage.ErrPasswordNotFound):
+			return nil, status.Errorf(codes.Unknown, "unknown PasswordID %s", req.Id)
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return nil, nil
+}
+
+// TouchPassword bumps the update time of a password entry without changing
+// its fields, so clients can mark an entry as reviewed without re-sending
+// the encrypted payload.
+func (gk *GophKeeperHandler) TouchPassword(ctx context.Context, req *proto.TouchPasswordRequest) (*proto.TouchPasswordResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	pwd, err := storage.Retry2(ctx, gk.rp, func() (*storage.Password, error) {
+		return gk.s.TouchPassword(
+			ctx,
+			req.Id,
+			userID,
+		)
+	})
+
+	if err != nil {
+		if errors.Is(err, storage.ErrPasswordNotFound) {
+			return nil, status.Errorf(codes.Unknown, "unknown PasswordID %s", req.Id)
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.TouchPasswordResponse{
+		Id:       pwd.ID,
+		UpdateAt: timestamppb.New(pwd.UpdateAt),
+	}, nil
+}
+
+// GetReusedPasswords groups the caller's password entries by their
+// client-supplied fingerprint and returns the groups stored more than
+// once. The server never sees a plaintext; it only compares the opaque
+// fingerprints.
+func (gk *GophKeeperHandler) GetReusedPasswords(ctx context.Context, _ *proto.GetReusedPasswordsRequest) (*proto.GetReusedPasswordsResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	reused, err := storage.Retry2(ctx, gk.rp, func() ([]storage.ReusedPasswords, error) {
+		return gk.s.GetReusedPasswords(ctx, userID)
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	protoReused := make([]*proto.ReusedPasswords, 0, len(reused))
+
+	for _, group := range reused {
+		protoReused = append(protoReused, &proto.ReusedPasswords{
+			Fingerprint: group.Fingerprint,
+			Ids:         group.IDs,
+		})
+	}
+
+	return &proto.GetReusedPasswordsResponse{Reused: protoReused}, nil
+}
+
+// CreateBank handles the creation of a new bank entry for a user. It retrieves the user ID from the context,
+// and stores the bank information in the storage.
+func (gk *GophKeeperHandler) CreateBank(ctx context.Context, req *proto.CreateBankRequest) (*proto.CreateBankResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	bank, err := storage.Retry2(ctx, gk.rp, func() (*storage.Bank, error) {
+		return gk.s.CreateBank(
+			ctx,
+			userID,
+			req.Name,
+			req.CardNumber,
+			req.Cvc,
+			req.Owner,
+			req.Exp,
+			req.Meta,
+			req.Pinned,
+			req.RequiresReauth,
+		)
+	})
+
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrUserNotFound):
+			return nil, status.Errorf(codes.Unknown, "unknown UserID %s", userID)
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &proto.CreateBankResponse{Id: bank.ID}, nil
+}
+
+// UpdateBank handles the updating of a bank entry for a user. It retrieves the user ID from the context,
+// and updates the bank information in the storage.
+func (gk *GophKeeperHandler) UpdateBank(ctx context.Context, req *proto.UpdateBankRequest) (*proto.UpdateBankResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	bank, err := storage.Retry2(ctx, gk.rp, func() (*storage.Bank, error) {
+		return gk.s.UpdateBank(
+			ctx,
+			req.Id,
+			userID,
+			req.Name,
+			req.CardNumber,
+			req.Cvc,
+			req.Owner,
+			req.Exp,
+			req.Meta,
+			req.Pinned,
+			req.RequiresReauth,
+		)
+	})
+
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrUserNotFound):
+			return nil, status.Errorf(codes.Unknown, "unknown UserID %s", userID)
+		case errors.Is(err, storage.ErrBankNotFound):
+			return nil, status.Errorf(codes.Unknown, "unknown BankID %s", req.Id)
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &proto.UpdateBankResponse{Id: bank.ID}, nil
+}
+
+// GetBank handles the retrieval of a bank entry by its ID. It retrieves the user ID from the context,
+// and fetches the bank information from the storage.
+func (gk *GophKeeperHandler) GetBank(ctx context.Context, req *proto.GetBankRequest) (*proto.GetBankResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	bankID := strings.TrimSpace(req.GetId())
+
+	if bankID == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty BankID")
+	}
+
+	bank, err := storage.Retry2(ctx, gk.rp, func() (*storage.Bank, error) {
+		return gk.s.GetBank(
+			ctx,
+			bankID,
+			userID,
+		)
+	})
+
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrBankNotFound):
+			return nil, status.Errorf(codes.Unknown, "unknown BankID %s", bankID)
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	if bank.RequiresReauth {
+		if err := gk.checkRecentAuth(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return &proto.GetBankResponse{
+		Bank: &proto.Bank{
+			Id:             bank.ID,
+			Name:           bank.Name,
+			CardNumber:     bank.CardNumber,
+			Cvc:            bank.CVC,
+			Owner:          bank.Owner,
+			Exp:            bank.Exp,
+			Meta:           bank.Meta,
+			Pinned:         bank.Pinned,
+			RequiresReauth: bank.RequiresReauth,
+			UpdateAt:       timestamppb.New(bank.UpdateAt),
+		},
+	}, nil
+}
+
+// GetBanks handles the retrieval of all bank entries for a user. It retrieves the user ID from the context,
+// and fetches all bank information from the storage.
+func (gk *GophKeeperHandler) GetBanks(ctx context.Context, _ *proto.GetBanksRequest) (*proto.GetBanksResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	banks, err := storage.Retry2(ctx, gk.rp, func() ([]storage.Bank, error) {
+		return gk.s.GetAllBanks(
+			ctx,
+			userID,
+		)
+	})
+
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrUserNotFound):
+			return nil, status.Errorf(codes.Unknown, "unknown UserID %s", userID)
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	banks, truncated := capList(banks, gk.listLimit)
+
+	for _, val := range banks {
+		if val.RequiresReauth {
+			if err := gk.checkRecentAuth(ctx); err != nil {
+				return nil, err
+			}
+
+			break
+		}
+	}
+
+	protoBanks := make([]*proto.Bank, 0, len(banks))
+
+	for _, val := range banks {
+		protoBanks = append(protoBanks, &proto.Bank{
+			Id:             val.ID,
+			Name:           val.Name,
+			CardNumber:     val.CardNumber,
+			Cvc:            val.CVC,
+			Owner:          val.Owner,
+			Exp:            val.Exp,
+			Meta:           val.Meta,
+			Pinned:         val.Pinned,
+			RequiresReauth: val.RequiresReauth,
+			UpdateAt:       timestamppb.New(val.UpdateAt),
+		})
+	}
+
+	return &proto.GetBanksResponse{
+		Banks:     protoBanks,
+		Truncated: truncated,
+	}, nil
+}
+
+// DeleteBank handles the deletion of a bank entry by its ID. It retrieves the user ID from the context,
+// and deletes the bank information from the storage.
+func (gk *GophKeeperHandler) DeleteBank(ctx context.Context, req *proto.DeleteBankRequest) (*proto.DeleteBankResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	err = storage.Retry(ctx, gk.rp, func() error {
+		return gk.s.DeleteBank(
+			ctx,
+			req.Id,
+			userID,
+		)
+	})
+
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrUserNotFound):
+			return nil, status.Errorf(codes.Unknown, "unknown UserID %s", userID)
+		case errors.Is(err, storage.ErrBankNotFound):
+			return nil, status.Errorf(codes.Unknown, "unknown BankID %s", req.Id)
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return nil, nil
+}
+
+// CreateText handles the creation of a new text entry for a user. It retrieves the user ID from the context,
+// and stores the text information in the storage.
+func (gk *GophKeeperHandler) CreateText(ctx context.Context, req *proto.CreateTextRequest) (*proto.CreateTextResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	text, err := storage.Retry2(ctx, gk.rp, func() (*storage.Text, error) {
+		return gk.s.CreateText(
+			ctx,
+			userID,
+			req.Name,
+			req.Text,
+			req.Meta,
+			req.Pinned,
+			req.RequiresReauth,
+		)
+	})
+
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrUserNotFound):
+			return nil, status.Errorf(codes.Unknown, "unknown UserID %s", userID)
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &proto.CreateTextResponse{Id: text.ID}, nil
+}
+
+// UpdateText handles the updating of a text entry for a user. It retrieves the user ID from the context,
+// and updates the text information in the storage.
+func (gk *GophKeeperHandler) UpdateText(ctx context.Context, req *proto.UpdateTextRequest) (*proto.UpdateTextResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	text, err := storage.Retry2(ctx, gk.rp, func() (*storage.Text, error) {
+		return gk.s.UpdateText(
+			ctx,
+			req.Id,
+			userID,
+			req.Name,
+			req.Text,
+			req.Meta,
+			req.Pinned,
+			req.RequiresReauth,
+		)
+	})
+
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrUserNotFound):
+			return nil, status.Errorf(codes.Unknown, "unknown UserID %s", userID)
+		case errors.Is(err, storage.ErrTextNotFound):
+			return nil, status.Errorf(codes.Unknown, "unknown TextID %s", req.Id)
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &proto.UpdateTextResponse{Id: text.ID}, nil
+}
+
+// GetText handles the retrieval of a text entry by its ID. It retrieves the user ID from the context,
+// and fetches the text information from the storage.
+func (gk *GophKeeperHandler) GetText(ctx context.Context, req *proto.GetTextRequest) (*proto.GetTextResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	textID := strings.TrimSpace(req.GetId())
+
+	if textID == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty TextID")
+	}
+
+	text, err := storage.Retry2(ctx, gk.rp, func() (*storage.Text, error) {
+		return gk.s.GetText(
+			ctx,
+			textID,
+			userID,
+		)
+	})
+
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrTextNotFound):
+			return nil, status.Errorf(codes.Unknown, "unknown TextID %s", textID)
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	if text.RequiresReauth {
+		if err := gk.checkRecentAuth(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return &proto.GetTextResponse{
+		Text: &proto.Text{
+			Id:             text.ID,
+			Name:           text.Name,
+			Text:           text.Text,
+			Meta:           text.Meta,
+			Pinned:         text.Pinned,
+			RequiresReauth: text.RequiresReauth,
+			UpdateAt:       timestamppb.New(text.UpdateAt),
+		},
+	}, nil
+}
+
+// GetTexts handles the retrieval of all text entries for a user. It retrieves the user ID from the context,
+// and fetches all text information from the storage.
+func (gk *GophKeeperHandler) GetTexts(ctx context.Context, req *proto.GetTextsRequest) (*proto.GetTextsResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	texts, err := storage.Retry2(ctx, gk.rp, func() ([]storage.Text, error) {
+		return gk.s.GetAllTexts(
+			ctx,
+			userID,
+			req.GetOrderBy(),
+			req.GetOrderDir(),
+			int(req.GetLimit()),
+			int(req.GetOffset()),
+		)
+	})
+
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrUserNotFound):
+			return nil, status.Errorf(codes.Unknown, "unknown UserID %s", userID)
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	texts, truncated := capList(texts, gk.listLimit)
+
+	for _, val := range texts {
+		if val.RequiresReauth {
+			if err := gk.checkRecentAuth(ctx); err != nil {
+				return nil, err
+			}
+
+			break
+		}
+	}
+
+	protoTexts := make([]*proto.Text, 0, len(texts))
+
+	for _, val := range texts {
+		protoTexts = append(protoTexts, &proto.Text{
+			Id:             val.ID,
+			Name:           val.Name,
+			Text:           val.Text,
+			Meta:           val.Meta,
+			Pinned:         val.Pinned,
+			RequiresReauth: val.RequiresReauth,
+			UpdateAt:       timestamppb.New(val.UpdateAt),
+		})
+	}
+
+	return &proto.GetTextsResponse{
+		Texts:     protoTexts,
+		Truncated: truncated,
+	}, nil
+}
+
+// DeleteText handles the deletion of a text entry by its ID. It retrieves the user ID from the context,
+// and deletes the text information from the storage.
+func (gk *GophKeeperHandler) DeleteText(ctx context.Context, req *proto.DeleteTextRequest) (*proto.DeleteTextResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	err = storage.Retry(ctx, gk.rp, func() error {
+		return gk.s.DeleteText(
+			ctx,
+			req.Id,
+			userID,
+		)
+	})
+
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrUserNotFound):
+			return nil, status.Errorf(codes.Unknown, "unknown UserID %s", userID)
+		case errors.Is(err, storage.ErrTextNotFound):
+			return nil, status.Errorf(codes.Unknown, "unknown TextID %s", req.Id)
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return nil, nil
+}
+
+// GetRecentActivity handles the retrieval of the user's entries across all
+// types, newest first. Names come back sealed for the client to open. A zero
+// limit falls back to defaultActivityLimit.
+func (gk *GophKeeperHandler) GetRecentActivity(ctx context.Context, req *proto.GetRecentActivityRequest) (*proto.GetRecentActivityResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	limit := int(req.GetLimit())
+
+	if limit == 0 {
+		limit = defaultActivityLimit
+	}
+
+	activities, err := storage.Retry2(ctx, gk.rp, func() ([]storage.Activity, error) {
+		return gk.s.GetRecentActivity(ctx, userID, limit)
+	})
+
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrUserNotFound):
+			return nil, status.Errorf(codes.Unknown, "unknown UserID %s", userID)
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	protoActivities := make([]*proto.ActivityEntry, 0, len(activities))
+
+	for _, val := range activities {
+		protoActivities = append(protoActivities, &proto.ActivityEntry{
+			Id:       val.ID,
+			Kind:     val.Kind,
+			Name:     val.Name,
+			UpdateAt: timestamppb.New(val.UpdateAt),
+		})
+	}
+
+	return &proto.GetRecentActivityResponse{
+		Activities: protoActivities,
+	}, nil
+}
+
+// GetFavorites handles the retrieval of the user's pinned entries across
+// all kinds in one call, so a favorites view needs no per-kind requests.
+func (gk *GophKeeperHandler) GetFavorites(ctx context.Context, _ *proto.GetFavoritesRequest) (*proto.GetFavoritesResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	favorites, err := storage.Retry2(ctx, gk.rp, func() (*storage.Favorites, error) {
+		return gk.s.GetFavorites(ctx, userID)
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if favoritesRequireReauth(favorites) {
+		if err := gk.checkRecentAuth(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	protoPWDs := make([]*proto.Password, 0, len(favorites.Passwords))
+
+	for _, val := range favorites.Passwords {
+		protoPWDs = append(protoPWDs, &proto.Password{
+			Id:             val.ID,
+			Name:           val.Name,
+			Login:          val.Login,
+			Password:       val.Password,
+			Meta:           val.Meta,
+			WrappedKey:     val.WrappedKey,
+			Pinned:         val.Pinned,
+			RequiresReauth: val.RequiresReauth,
+			UpdateAt:       timestamppb.New(val.UpdateAt),
+		})
+	}
+
+	protoFiles := make([]*proto.File, 0, len(favorites.Files))
+
+	for _, val := range favorites.Files {
+		protoFiles = append(protoFiles, &proto.File{
+			Id:             val.ID,
+			Name:           val.Name,
+			Meta:           val.Meta,
+			Pinned:         val.Pinned,
+			RequiresReauth: val.RequiresReauth,
+			UpdateAt:       timestamppb.New(val.UpdateAt),
+		})
+	}
+
+	protoBanks := make([]*proto.Bank, 0, len(favorites.Banks))
+
+	for _, val := range favorites.Banks {
+		protoBanks = append(protoBanks, &proto.Bank{
+			Id:             val.ID,
+			Name:           val.Name,
+			CardNumber:     val.CardNumber,
+			Cvc:            val.CVC,
+			Owner:          val.Owner,
+			Exp:            val.Exp,
+			Meta:           val.Meta,
+			Pinned:         val.Pinned,
+			RequiresReauth: val.RequiresReauth,
+			UpdateAt:       timestamppb.New(val.UpdateAt),
+		})
+	}
+
+	protoTexts := make([]*proto.Text, 0, len(favorites.Texts))
+
+	for _, val := range favorites.Texts {
+		protoTexts = append(protoTexts, &proto.Text{
+			Id:             val.ID,
+			Name:           val.Name,
+			Text:           val.Text,
+			Meta:           val.Meta,
+			Pinned:         val.Pinned,
+			RequiresReauth: val.RequiresReauth,
+			UpdateAt:       timestamppb.New(val.UpdateAt),
+		})
+	}
+
+	return &proto.GetFavoritesResponse{
+		Passwords: protoPWDs,
+		FileInfo:  protoFiles,
+		Banks:     protoBanks,
+		Texts:     protoTexts,
+	}, nil
+}
+
+// ListEntryIDs handles the existence check for the user's entries of one
+// kind. Only ids and update times come back, so a client can reconcile its
+// cache without downloading names or secrets.
+func (gk *GophKeeperHandler) ListEntryIDs(ctx context.Context, req *proto.ListEntryIDsRequest) (*proto.ListEntryIDsResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	entries, err := storage.Retry2(ctx, gk.rp, func() ([]storage.EntryID, error) {
+		return gk.s.GetEntryIDs(ctx, userID, req.GetKind())
+	})
+
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrUnknownEntryKind):
+			return nil, status.Errorf(codes.InvalidArgument, "unknown entry kind %s", req.GetKind())
+		case errors.Is(err, storage.ErrUserNotFound):
+			return nil, status.Errorf(codes.Unknown, "unknown UserID %s", userID)
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	protoEntries := make([]*proto.EntryID, 0, len(entries))
+
+	for _, val := range entries {
+		protoEntries = append(protoEntries, &proto.EntryID{
+			Id:       val.ID,
+			UpdateAt: timestamppb.New(val.UpdateAt),
+		})
+	}
+
+	return &proto.ListEntryIDsResponse{
+		Entries: protoEntries,
+	}, nil
+}
+
+// GetVaultManifest handles the bulk existence check for the user's entries
+// of every kind at once, so a sync client reconciles its whole cache with
+// a single call. The response can be paginated with limit/offset.
+func (gk *GophKeeperHandler) GetVaultManifest(ctx context.Context, req *proto.GetVaultManifestRequest) (*proto.GetVaultManifestResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	entries, err := storage.Retry2(ctx, gk.rp, func() ([]storage.ManifestEntry, error) {
+		return gk.s.GetVaultManifest(ctx, userID, int(req.GetLimit()), int(req.GetOffset()))
+	})
+
+	if err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			return nil, status.Errorf(codes.Unknown, "unknown UserID %s", userID)
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	protoEntries := make([]*proto.ManifestEntry, 0, len(entries))
+
+	for _, val := range entries {
+		protoEntries = append(protoEntries, &proto.ManifestEntry{
+			Id:       val.ID,
+			Kind:     val.Kind,
+			UpdateAt: timestamppb.New(val.UpdateAt),
+		})
+	}
+
+	return &proto.GetVaultManifestResponse{
+		Entries: protoEntries,
+	}, nil
+}
+
+// ListSessions handles listing the caller's device sessions, so users can
+// see where they are logged in.
+func (gk *GophKeeperHandler) ListSessions(ctx context.Context, _ *proto.ListSessionsRequest) (*proto.ListSessionsResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	sessions, err := storage.Retry2(ctx, gk.rp, func() ([]storage.Session, error) {
+		return gk.s.ListSessions(ctx, userID)
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	protoSessions := make([]*proto.Session, 0, len(sessions))
+
+	for _, val := range sessions {
+		protoSessions = append(protoSessions, &proto.Session{
+			DeviceId: val.DeviceID,
+			LastSeen: timestamppb.New(val.LastSeen),
+			Ip:       val.IP,
+			Revoked:  val.TokenMarker.After(time.Unix(0, 0)),
+		})
+	}
+
+	return &proto.ListSessionsResponse{
+		Sessions: protoSessions,
+	}, nil
+}
+
+// RevokeSession handles killing one device session by bumping its token
+// marker, so tokens issued for that device before the call stop working.
+// The marker is enforced by the sessions interceptor, which only sees
+// requests that carry the device-id metadata; a client that stops sending
+// its device id bypasses the check until its token expires.
+func (gk *GophKeeperHandler) RevokeSession(ctx context.Context, req *proto.RevokeSessionRequest) (*proto.RevokeSessionResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	err = storage.Retry(ctx, gk.rp, func() error {
+		return gk.s.RevokeSession(
+			ctx,
+			userID,
+			req.DeviceId,
+		)
+	})
+
+	if err != nil {
+		if errors.Is(err, storage.ErrSessionNotFound) {
+			return nil, status.Errorf(codes.Unknown, "unknown DeviceID %s", req.DeviceId)
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return nil, nil
+}
+
+// CreateFile handles the uploading of a file for a user. It retrieves the user ID from the context,
+// and stores the file information in the storage.
+func (gk *GophKeeperHandler) CreateFile(stream proto.GophKeeperService_CreateFileServer) (err error) {
+	userID, err := getUserIDFromContext(stream.Context())
+
+	if err != nil {
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	req, err := stream.Recv()
+
+	if err != nil {
+		return status.Error(codes.Unknown, "cannot receive file info")
+	}
+
+	name := req.GetFileInfo().Name
+	meta := req.GetFileInfo().Meta
+	pinned := req.GetFileInfo().Pinned
+	requiresReauth := req.GetFileInfo().RequiresReauth
+
+	fileName, err := uuid.NewRandom()
+
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	dbf, err := gk.fs.CreateDBFile(fileName.String())
+
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	defer func() {
+		err = errors.Join(err, dbf.Close())
+	}()
+
+	contentChunks := 0
+
+	for {
+		req, err := stream.Recv()
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return status.Error(codes.Unknown, "cannot receive content")
+		}
+
+		_, err = dbf.Write(req.GetContent())
+
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		contentChunks++
+	}
+
+	// The first content message carries the nonce, so a real file needs at
+	// least two of them.
+	if !gk.allowEmptyFiles && contentChunks < 2 {
+		if dErr := gk.fs.DeleteDBFile(fileName.String()); dErr != nil {
+			return status.Error(codes.Internal, dErr.Error())
+		}
+
+		return status.Error(codes.InvalidArgument, "empty file")
+	}
+
+	createFile := func() (*storage.File, error) {
+		return storage.Retry2(stream.Context(), gk.rp, func() (*storage.File, error) {
+			return gk.s.CreateFile(
+				stream.Context(),
+				userID,
+				name,
+				fileName.String(),
+				meta,
+				pinned,
+				requiresReauth,
+			)
+		})
+	}
+
+	file, err := createFile()
+
+	for attempt := 0; errors.Is(err, storage.ErrPathAlreadyExists) && attempt < filePathRetries; attempt++ {
+		newName, nErr := uuid.NewRandom()
+
+		if nErr != nil {
+			return status.Error(codes.Internal, nErr.Error())
+		}
+
+		if mErr := gk.moveDBFile(fileName.String(), newName.String()); mErr != nil {
+			return status.Error(codes.Internal, mErr.Error())
+		}
+
+		fileName = newName
+		file, err = createFile()
+	}
+
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrUserNotFound):
+			return status.Errorf(codes.Unknown, "unknown UserID %s", userID)
+		case errors.Is(err, storage.ErrPathAlreadyExists):
+			return status.Errorf(codes.AlreadyExists, "file path %s already exists", fileName)
+		default:
+			return status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return stream.SendAndClose(&proto.CreateFileResponse{
+		Id: file.ID,
+	})
+}
+
+// CreateFileStream handles the uploading of a file like CreateFile, but
+// acknowledges the total content bytes stored after every message so the
+// client can show confirmed progress and detect stalls. The final message
+// carries the new file's ID.
+func (gk *GophKeeperHandler) CreateFileStream(stream proto.GophKeeperService_CreateFileStreamServer) (err error) {
+	userID, err := getUserIDFromContext(stream.Context())
+
+	if err != nil {
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	req, err := stream.Recv()
+
+	if err != nil {
+		return status.Error(codes.Unknown, "cannot receive file info")
+	}
+
+	name := req.GetFileInfo().Name
+	meta := req.GetFileInfo().Meta
+	pinned := req.GetFileInfo().Pinned
+	requiresReauth := req.GetFileInfo().RequiresReauth
+
+	fileName, err := uuid.NewRandom()
+
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	dbf, err := gk.fs.CreateDBFile(fileName.String())
+
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	defer func() {
+		err = errors.Join(err, dbf.Close())
+	}()
+
+	contentChunks := 0
+	bytesReceived := uint64(0)
+
+	for {
+		req, err := stream.Recv()
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return status.Error(codes.Unknown, "cannot receive content")
+		}
+
+		_, err = dbf.Write(req.GetContent())
+
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		contentChunks++
+		bytesReceived += uint64(len(req.GetContent()))
+
+		err = stream.Send(&proto.CreateFileStreamResponse{
+			Data: &proto.CreateFileStreamResponse_BytesReceived{
+				BytesReceived: bytesReceived,
+			},
+		})
+
+		if err != nil {
+			return status.Error(codes.Unknown, "cannot send ack")
+		}
+	}
+
+	// The first content message carries the nonce, so a real file needs at
+	// least two of them.
+	if !gk.allowEmptyFiles && contentChunks < 2 {
+		if dErr := gk.fs.DeleteDBFile(fileName.String()); dErr != nil {
+			return status.Error(codes.Internal, dErr.Error())
+		}
+
+		return status.Error(codes.InvalidArgument, "empty file")
+	}
+
+	createFile := func() (*storage.File, error) {
+		return storage.Retry2(stream.Context(), gk.rp, func() (*storage.File, error) {
+			return gk.s.CreateFile(
+				stream.Context(),
+				userID,
+				name,
+				fileName.String(),
+				meta,
+				pinned,
+				requiresReauth,
+			)
+		})
+	}
+
+	file, err := createFile()
+
+	for attempt := 0; errors.Is(err, storage.ErrPathAlreadyExists) && attempt < filePathRetries; attempt++ {
+		newName, nErr := uuid.NewRandom()
+
+		if nErr != nil {
+			return status.Error(codes.Internal, nErr.Error())
+		}
+
+		if mErr := gk.moveDBFile(fileName.String(), newName.String()); mErr != nil {
+			return status.Error(codes.Internal, mErr.Error())
+		}
+
+		fileName = newName
+		file, err = createFile()
+	}
+
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrUserNotFound):
+			return status.Errorf(codes.Unknown, "unknown UserID %s", userID)
+		case errors.Is(err, storage.ErrPathAlreadyExists):
+			return status.Errorf(codes.AlreadyExists, "file path %s already exists", fileName)
+		default:
+			return status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return stream.Send(&proto.CreateFileStreamResponse{
+		Data: &proto.CreateFileStreamResponse_Id{
+			Id: file.ID,
+		},
+	})
+}
+
+// UpdateFile handles the updating of a file for a user. It retrieves the user ID from the context,
+// and updates the file information in the storage.
+func (gk *GophKeeperHandler) UpdateFile(stream proto.GophKeeperService_UpdateFileServer) (err error) {
+	userID, err := getUserIDFromContext(stream.Context())
+
+	if err != nil {
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	req, err := stream.Recv()
+
+	if err != nil {
+		return status.Error(codes.Unknown, "cannot receive file info")
+	}
+
+	id := req.GetFileInfo().Id
+	name := req.GetFileInfo().Name
+	meta := req.GetFileInfo().Meta
+	pinned := req.GetFileInfo().Pinned
+	requiresReauth := req.GetFileInfo().RequiresReauth
+
+	fileName, err := uuid.NewRandom()
+
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	dbf, err := gk.fs.CreateDBFile(fileName.String())
+
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	defer func() {
+		err = errors.Join(err, dbf.Close())
+	}()
+
+	for {
+		req, err := stream.Recv()
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return status.Error(codes.Unknown, "cannot receive content")
+		}
+
+		_, err = dbf.Write(req.GetContent())
+
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	file, err := storage.Retry2(stream.Context(), gk.rp, func() (*storage.File, error) {
+		return gk.s.UpdateFile(
+			stream.Context(),
+			id,
+			userID,
+			name,
+			fileName.String(),
+			meta,
+			pinned,
+			requiresReauth,
+		)
+	})
+
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrUserNotFound):
+			return status.Errorf(codes.Unknown, "unknown UserID %s", userID)
+		case errors.Is(err, storage.ErrPathAlreadyExists):
+			return status.Errorf(codes.AlreadyExists, "file path %s already exists", fileName)
+		default:
+			return status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	err = storage.RetryIf(stream.Context(), gk.rp, func(error) bool { return true }, func() error {
+		return gk.fs.DeleteDBFile(file.PathToFile)
+	})
+
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return stream.SendAndClose(&proto.UpdateFileResponse{
+		Id: file.ID,
+	})
+}
+
+// UpdateFileInfo handles the updating of a file's name and metadata without
+// touching its stored content. It retrieves the user ID from the context,
+// and updates the file information in the storage.
+func (gk *GophKeeperHandler) UpdateFileInfo(ctx context.Context, req *proto.UpdateFileInfoRequest) (*proto.UpdateFileInfoResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	file, err := storage.Retry2(ctx, gk.rp, func() (*storage.File, error) {
+		return gk.s.UpdateFileInfo(
+			ctx,
+			req.Id,
+			userID,
+			req.Name,
+			req.Meta,
+			req.Pinned,
+			req.RequiresReauth,
+		)
+	})
+
+	if err != nil {
+		if errors.Is(err, storage.ErrFileNotFound) {
+			return nil, status.Errorf(codes.Unknown, "unknown FileID %s", req.Id)
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.UpdateFileInfoResponse{
+		Id: file.ID,
+	}, nil
+}
+
+// GetFile handles the retrieval of a file by its ID. It retrieves the user ID from the context,
+// and fetches the file information from the storage.
+func (gk *GophKeeperHandler) GetFile(req *proto.GetFileRequest, stream proto.GophKeeperService_GetFileServer) (err error) {
+	userID, err := getUserIDFromContext(stream.Context())
+
+	if err != nil {
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	fileID := strings.TrimSpace(req.GetId())
+
+	if fileID == "" {
+		return status.Error(codes.InvalidArgument, "empty FileID")
+	}
+
+	file, err := storage.Retry2(stream.Context(), gk.rp, func() (*storage.File, error) {
+		return gk.s.GetFile(
+			stream.Context(),
+			fileID,
+			userID,
+		)
+	})
+
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrFileNotFound):
+			return status.Errorf(codes.Unknown, "unknown FileID %s", fileID)
+		default:
+			return status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	if file.RequiresReauth {
+		if err := gk.checkRecentAuth(stream.Context()); err != nil {
+			return err
+		}
+	}
+
+	err = stream.Send(&proto.GetFileResponse{
+		Data: &proto.GetFileResponse_FileInfo{
+			FileInfo: &proto.File{
+				Id:             file.ID,
+				Name:           file.Name,
+				Meta:           file.Meta,
+				Pinned:         file.Pinned,
+				RequiresReauth: file.RequiresReauth,
+				UpdateAt:       timestamppb.New(file.UpdateAt),
+			},
+		},
+	})
+
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	filer, err := gk.fs.GetDBFile(file.PathToFile)
+
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	defer func() {
+		err = errors.Join(err, filer.Close())
+	}()
+
+	for {
+		content, err := filer.GetChunk()
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		err = stream.Send(&proto.GetFileResponse{
+			Data: &proto.GetFileResponse_Content{
+				Content: content,
+			},
+		})
+
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return nil
+}
+
+// GetFiles handles the retrieval of all files for a user. It retrieves the user ID from the context,
+// and fetches all file information from the storage.
+func (gk *GophKeeperHandler) GetFiles(ctx context.Context, _ *proto.GetFilesRequest) (*proto.GetFilesResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	files, err := storage.Retry2(ctx, gk.rp, func() ([]storage.File, error) {
+		return gk.s.GetAllFiles(
+			ctx,
+			userID,
+		)
+	})
+
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrUserNotFound):
+			return nil, status.Errorf(codes.Unknown, "unknown UserID %s", userID)
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	files, truncated := capList(files, gk.listLimit)
+
+	protoFiles := make([]*proto.File, 0, len(files))
+
+	for _, val := range files {
+		protoFiles = append(protoFiles, &proto.File{
+			Id:             val.ID,
+			Name:           val.Name,
+			Meta:           val.Meta,
+			Pinned:         val.Pinned,
+			RequiresReauth: val.RequiresReauth,
+			UpdateAt:       timestamppb.New(val.UpdateAt),
+		})
+	}
+
+	return &proto.GetFilesResponse{
+		FileInfo:  protoFiles,
+		Truncated: truncated,
+	}, nil
+}
+
+// DeleteFile handles the deletion of a file by its ID. It retrieves the user ID from the context,
+// and deletes the file information from the storage.
+func (gk *GophKeeperHandler) DeleteFile(ctx context.Context, req *proto.DeleteFileRequest) (*proto.DeleteFileResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	file, err := storage.Retry2(ctx, gk.rp, func() (*storage.File, error) {
+		return gk.s.GetFile(
+			ctx,
+			req.Id,
+			userID,
+		)
+	})
+
+	if err != nil {
+		if errors.Is(err, storage.ErrFileNotFound) {
+			return nil, status.Errorf(codes.Unknown, "unknown FileID %s", req.Id)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// The blob goes first: if its deletion fails the row is retained, so the
+	// request can be retried without orphaning the blob on disk. A blob that
+	// is already absent counts as deleted, so a repeated request still
+	// removes the row.
+	err = storage.RetryIf(ctx, gk.rp, func(err error) bool { return !errors.Is(err, os.ErrNotExist) }, func() error {
+		return gk.fs.DeleteDBFile(file.PathToFile)
+	})
+
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	_, err = storage.Retry2(ctx, gk.rp, func() (*storage.File, error) {
+		return gk.s.DeleteFile(
+			ctx,
+			req.Id,
+			userID,
+		)
+	})
+
+	if err != nil {
+		if errors.Is(err, storage.ErrFileNotFound) {
+			return nil, status.Errorf(codes.Unknown, "unknown FileID %s", req.Id)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return nil, nil
+}
+
+// moveDBFile copies a stored blob under a new name and deletes the original.
+// FileStore has no rename primitive, so the copy goes through the regular
+// chunk API. It is used to regenerate a file path after a collision.
+func (gk *GophKeeperHandler) moveDBFile(oldName, newName string) (err error) {
+	src, err := gk.fs.GetDBFile(oldName)
+
+	if err != nil {
+		return fmt.Errorf("open file %s: %w", oldName, err)
+	}
+
+	defer func() {
+		err = errors.Join(err, src.Close())
+	}()
+
+	dst, err := gk.fs.CreateDBFile(newName)
+
+	if err != nil {
+		return fmt.Errorf("create file %s: %w", newName, err)
+	}
+
+	defer func() {
+		err = errors.Join(err, dst.Close())
+	}()
+
+	for {
+		chunk, err := src.GetChunk()
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("read file %s: %w", oldName, err)
+		}
+
+		_, err = dst.Write(chunk)
+
+		if err != nil {
+			return fmt.Errorf("write file %s: %w", newName, err)
+		}
+	}
+
+	return gk.fs.DeleteDBFile(oldName)
+}
+
+func (gk *GophKeeperHandler) checkAdminToken(ctx context.Context) error {
+	if gk.adminToken == "" {
+		return status.Error(codes.PermissionDenied, "admin methods are disabled")
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+
+	if !ok {
+		return status.Error(codes.PermissionDenied, "missing metadata")
+	}
+
+	token := md.Get(adminTokenHeader)
+
+	if len(token) == 0 || subtle.ConstantTimeCompare([]byte(token[0]), []byte(gk.adminToken)) != 1 {
+		return status.Error(codes.PermissionDenied, "invalid admin token")
+	}
+
+	return nil
+}
+
+func getUserIDFromContext(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+
+	if !ok {
+		return "", errors.New("missing metadata")
+	}
+
+	uid := md.Get(userIDHeader)
+
+	if len(uid) == 0 {
+		return "", fmt.Errorf("missing %s", userIDHeader)
+	}
+
+	if _, err := uuid.Parse(uid[0]); err != nil {
+		return "", errors.New("invalid user id")
+	}
+
+	return uid[0], nil
+}
+
+// checkRecentAuth guards the reveal of an entry marked requires_reauth.
+// Tokens are only issued after a password entry, so their issue time is the
+// moment of the last authentication; a token older than the reauth window
+// means the user must re-enter the password and obtain a fresh one.
+func (gk *GophKeeperHandler) checkRecentAuth(ctx context.Context) error {
+	authAt, ok := getAuthTimeFromContext(ctx)
+
+	if !ok || time.Since(authAt) > gk.reauthWindow {
+		return status.Error(codes.PermissionDenied, "reauth required")
+	}
+
+	return nil
+}
+
+// favoritesRequireReauth reports whether any entry in the favorites set is
+// marked requires_reauth, so GetFavorites can gate the response the same
+// way the per-kind list handlers do.
+func favoritesRequireReauth(favorites *storage.Favorites) bool {
+	for _, val := range favorites.Passwords {
+		if val.RequiresReauth {
+			return true
+		}
+	}
+
+	for _, val := range favorites.Files {
+		if val.RequiresReauth {
+			return true
+		}
+	}
+
+	for _, val := range favorites.Banks {
+		if val.RequiresReauth {
+			return true
+		}
+	}
+
+	for _, val := range favorites.Texts {
+		if val.RequiresReauth {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getAuthTimeFromContext reads the token issue time the auth interceptor put
+// in the metadata. Tokens issued before the claim existed have no value, so
+// a missing or unparseable one is reported rather than treated as an error.
+func getAuthTimeFromContext(ctx context.Context) (time.Time, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+
+	if !ok {
+		return time.Time{}, false
+	}
+
+	authAt := md.Get(authAtHeader)
+
+	if len(authAt) == 0 {
+		return time.Time{}, false
+	}
+
+	unix, err := strconv.ParseInt(authAt[0], 10, 64)
+
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return time.Unix(unix, 0), true
+}
+
+// getTokenExpFromContext reads the token expiry the auth interceptor put in
+// the metadata. The expiry is optional, so a missing or unparseable value is
+// not an error.
+func getTokenExpFromContext(ctx context.Context) (time.Time, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+
+	if !ok {
+		return time.Time{}, false
+	}
+
+	exp := md.Get(tokenExpHeader)
+
+	if len(exp) == 0 {
+		return time.Time{}, false
+	}
+
+	unix, err := strconv.ParseInt(exp[0], 10, 64)
+
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return time.Unix(unix, 0), true
+}
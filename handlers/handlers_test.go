@@ -0,0 +1,3615 @@
+//go:build unit
+
+package handlers
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	proto "github.com/Tomap-Tomap/GophKeeper/proto/gophkeeper/v1"
+	"github.com/Tomap-Tomap/GophKeeper/ratelimit"
+	"github.com/Tomap-Tomap/GophKeeper/storage"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const emptyString = ""
+
+var testError = errors.New("test")
+
+// testPublicKeyPEM generates a fresh RSA public key in PEM form.
+func testPublicKeyPEM(t *testing.T) string {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+type HandlersTestSuite struct {
+	suite.Suite
+	handler              *GophKeeperHandler
+	storageMock          *StorageMockedObject
+	hasherMock           *HasherMockedObject
+	tokenerMock          *TokenerMockedObject
+	fileStoreMock        *FileStoreMockedObject
+	streamCreateFileMock *GophKeeper_CreateFileServerMockedObject
+	streamUpdateFileMock *GophKeeper_UpdateFileServerMockedObject
+	streamGetFileMock    *GophKeeper_GetFileServerMockedObject
+
+	streamCreateFileStreamMock *GophKeeper_CreateFileStreamServerMockedObject
+
+	testIncomingContext context.Context
+
+	testUpdateAt time.Time
+
+	testLogin          string
+	testPassword       string
+	testHashedLogin    string
+	testSalt           string
+	testHashedPassword string
+	testToken          string
+	testName           string
+	testMeta           string
+	testUserID         string
+	testPasswordID     string
+	testCardNumber     string
+	testCvc            string
+	testOwner          string
+	testExp            string
+	testBankID         string
+	testText           string
+	testTextID         string
+	testFileID         string
+	testAdminToken     string
+
+	testBatch1 []byte
+	testBatch2 []byte
+
+	testSaltLength int
+}
+
+func (suite *HandlersTestSuite) SetupTest() {
+	suite.storageMock = new(StorageMockedObject)
+	suite.hasherMock = new(HasherMockedObject)
+	suite.tokenerMock = new(TokenerMockedObject)
+	suite.fileStoreMock = new(FileStoreMockedObject)
+	suite.streamCreateFileMock = new(GophKeeper_CreateFileServerMockedObject)
+	suite.streamUpdateFileMock = new(GophKeeper_UpdateFileServerMockedObject)
+	suite.streamGetFileMock = new(GophKeeper_GetFileServerMockedObject)
+	suite.streamCreateFileStreamMock = new(GophKeeper_CreateFileStreamServerMockedObject)
+
+	suite.handler = NewGophKeeperHandler(
+		suite.storageMock,
+		suite.hasherMock,
+		suite.tokenerMock,
+		suite.fileStoreMock,
+		*storage.NewRetryPolicy(3, 5, 3),
+		75,
+		"testAdminToken",
+		false,
+		defaultListLimit,
+	)
+
+	suite.testUpdateAt = time.Now()
+
+	suite.testLogin = "testLogin"
+	suite.testPassword = "testPassword"
+	suite.testHashedLogin = "testHashedLogin"
+	suite.testSalt = "testSalt"
+	suite.testHashedPassword = "testHashedPassword"
+	suite.testToken = "testToken"
+	suite.testName = "testName"
+	suite.testMeta = "testMeta"
+	suite.testUserID = "0cd68a8a-6b9a-4497-93a9-9d3cec4dba1e"
+	suite.testPasswordID = "testPasswordID"
+	suite.testCardNumber = "testCardNumber"
+	suite.testCvc = "testCvc"
+	suite.testOwner = "testOwner"
+	suite.testExp = "testExp"
+	suite.testBankID = "testBankID"
+	suite.testText = "testText"
+	suite.testTextID = "testTextID"
+	suite.testFileID = "testFileID"
+	suite.testAdminToken = "testAdminToken"
+
+	suite.testBatch1 = []byte{1, 2, 3, 4}
+	suite.testBatch2 = []byte{5, 6, 7, 8}
+
+	suite.testIncomingContext = metadata.NewIncomingContext(
+		context.Background(),
+		metadata.Pairs(userIDHeader, suite.testUserID),
+	)
+
+	suite.testSaltLength = 75
+}
+
+func (suite *HandlersTestSuite) TearDownSubTest() {
+	suite.storageMock.AssertExpectations(suite.T())
+	suite.hasherMock.AssertExpectations(suite.T())
+	suite.tokenerMock.AssertExpectations(suite.T())
+	suite.fileStoreMock.AssertExpectations(suite.T())
+	suite.streamCreateFileMock.AssertExpectations(suite.T())
+	suite.streamUpdateFileMock.AssertExpectations(suite.T())
+	suite.streamGetFileMock.AssertExpectations(suite.T())
+	suite.streamCreateFileStreamMock.AssertExpectations(suite.T())
+
+	for len(suite.storageMock.ExpectedCalls) != 0 {
+		suite.storageMock.ExpectedCalls[0].Unset()
+	}
+
+	for len(suite.hasherMock.ExpectedCalls) != 0 {
+		suite.hasherMock.ExpectedCalls[0].Unset()
+	}
+
+	for len(suite.tokenerMock.ExpectedCalls) != 0 {
+		suite.tokenerMock.ExpectedCalls[0].Unset()
+	}
+
+	for len(suite.fileStoreMock.ExpectedCalls) != 0 {
+		suite.fileStoreMock.ExpectedCalls[0].Unset()
+	}
+
+	for len(suite.streamCreateFileMock.ExpectedCalls) != 0 {
+		suite.streamCreateFileMock.ExpectedCalls[0].Unset()
+	}
+
+	for len(suite.streamUpdateFileMock.ExpectedCalls) != 0 {
+		suite.streamUpdateFileMock.ExpectedCalls[0].Unset()
+	}
+
+	for len(suite.streamGetFileMock.ExpectedCalls) != 0 {
+		suite.streamGetFileMock.ExpectedCalls[0].Unset()
+	}
+
+	for len(suite.streamCreateFileStreamMock.ExpectedCalls) != 0 {
+		suite.streamCreateFileStreamMock.ExpectedCalls[0].Unset()
+	}
+}
+
+func (suite *HandlersTestSuite) TestRegister() {
+	require := suite.Require()
+
+	positiveReq := &proto.RegisterRequest{
+		Login:    suite.testLogin,
+		Password: suite.testPassword,
+	}
+
+	suite.Run("generate salt error", func() {
+		suite.hasherMock.onGenerateHash(suite.testLogin, suite.testHashedLogin)
+		suite.hasherMock.onGenerateSalt(suite.testSaltLength, emptyString, testError)
+
+		res, err := suite.handler.Register(context.Background(), positiveReq)
+		require.ErrorContains(err, "generate salt")
+		require.Equal(status.Code(err), codes.Internal)
+		require.Nil(res)
+	})
+
+	suite.Run("generate hash error", func() {
+		suite.hasherMock.onGenerateHash(suite.testLogin, suite.testHashedLogin)
+		suite.hasherMock.onGenerateSalt(suite.testSaltLength, suite.testSalt, nil)
+		suite.hasherMock.onGenerateHashWithSalt(suite.testPassword, suite.testSalt, emptyString, testError)
+
+		res, err := suite.handler.Register(context.Background(), positiveReq)
+		require.ErrorContains(err, "generate hash")
+		require.Equal(status.Code(err), codes.Internal)
+		require.Nil(res)
+	})
+
+	suite.Run("user already exists error", func() {
+		suite.hasherMock.onGenerateHash(suite.testLogin, suite.testHashedLogin)
+		suite.hasherMock.onGenerateSalt(suite.testSaltLength, suite.testSalt, nil)
+		suite.hasherMock.onGenerateHashWithSalt(suite.testPassword, suite.testSalt, suite.testHashedPassword, nil)
+		suite.storageMock.onCreateUser(suite.testLogin, suite.testHashedLogin, suite.testSalt, suite.testHashedPassword, nil, storage.ErrUserAlreadyExists)
+
+		res, err := suite.handler.Register(context.Background(), positiveReq)
+		require.ErrorContains(err, fmt.Sprintf("user %s already exists", suite.testLogin))
+		require.Equal(status.Code(err), codes.AlreadyExists)
+		require.Nil(res)
+	})
+
+	suite.Run("database error", func() {
+		suite.hasherMock.onGenerateHash(suite.testLogin, suite.testHashedLogin)
+		suite.hasherMock.onGenerateSalt(suite.testSaltLength, suite.testSalt, nil)
+		suite.hasherMock.onGenerateHashWithSalt(suite.testPassword, suite.testSalt, suite.testHashedPassword, nil)
+		suite.storageMock.onCreateUser(suite.testLogin, suite.testHashedLogin, suite.testSalt, suite.testHashedPassword, nil, testError)
+
+		res, err := suite.handler.Register(context.Background(), positiveReq)
+		require.ErrorContains(err, testError.Error())
+		require.Equal(status.Code(err), codes.Internal)
+		require.Nil(res)
+	})
+
+	suite.Run("get suite.testToken error", func() {
+		suite.hasherMock.onGenerateHash(suite.testLogin, suite.testHashedLogin)
+		suite.hasherMock.onGenerateSalt(suite.testSaltLength, suite.testSalt, nil)
+		suite.hasherMock.onGenerateHashWithSalt(suite.testPassword, suite.testSalt, suite.testHashedPassword, nil)
+		suite.storageMock.onCreateUser(suite.testLogin, suite.testHashedLogin, suite.testSalt, suite.testHashedPassword, &storage.User{}, nil)
+		suite.tokenerMock.onGetToken(mock.Anything, emptyString, testError)
+
+		res, err := suite.handler.Register(context.Background(), positiveReq)
+		require.ErrorContains(err, testError.Error())
+		require.Equal(status.Code(err), codes.Internal)
+		require.Nil(res)
+	})
+
+	suite.Run("positive test", func() {
+		suite.hasherMock.onGenerateHash(suite.testLogin, suite.testHashedLogin)
+		suite.hasherMock.onGenerateSalt(suite.testSaltLength, suite.testSalt, nil)
+		suite.hasherMock.onGenerateHashWithSalt(suite.testPassword, suite.testSalt, suite.testHashedPassword, nil)
+		suite.storageMock.onCreateUser(suite.testLogin, suite.testHashedLogin, suite.testSalt, suite.testHashedPassword, &storage.User{}, nil)
+		suite.tokenerMock.onGetToken(mock.Anything, suite.testToken, nil)
+
+		res, err := suite.handler.Register(context.Background(), positiveReq)
+		suite.Require().NoError(err)
+		suite.Require().Equal(suite.testToken, res.Token)
+	})
+}
+
+func (suite *HandlersTestSuite) TestAuth() {
+	require := suite.Require()
+
+	positiveReq := &proto.AuthRequest{
+		Login:    suite.testLogin,
+		Password: suite.testPassword,
+	}
+
+	suite.Run("database error", func() {
+		suite.hasherMock.onGenerateHash(suite.testLogin, suite.testHashedLogin)
+		suite.storageMock.onGetUser(suite.testLogin, suite.testHashedLogin, nil, testError)
+
+		res, err := suite.handler.Auth(context.Background(), positiveReq)
+		require.ErrorContains(err, testError.Error())
+		require.Equal(status.Code(err), codes.Internal)
+		require.Nil(res)
+	})
+
+	suite.Run("user not found", func() {
+		suite.hasherMock.onGenerateHash(suite.testLogin, suite.testHashedLogin)
+		suite.storageMock.onGetUser(suite.testLogin, suite.testHashedLogin, nil, storage.ErrUserNotFound)
+
+		res, err := suite.handler.Auth(context.Background(), positiveReq)
+		require.ErrorContains(err, fmt.Sprintf("unknown user %s", suite.testLogin))
+		require.Equal(status.Code(err), codes.Unknown)
+		require.Nil(res)
+	})
+
+	suite.Run("verify error", func() {
+		suite.hasherMock.onGenerateHash(suite.testLogin, suite.testHashedLogin)
+		suite.storageMock.onGetUser(suite.testLogin, suite.testHashedLogin, &storage.User{Salt: suite.testSalt}, nil)
+		suite.hasherMock.onVerifyHashWithSalt(suite.testPassword, suite.testSalt, emptyString, emptyString, false, testError)
+
+		res, err := suite.handler.Auth(context.Background(), positiveReq)
+		require.ErrorContains(err, testError.Error())
+		require.Equal(status.Code(err), codes.Internal)
+		require.Nil(res)
+	})
+
+	suite.Run("invalid password", func() {
+		suite.hasherMock.onGenerateHash(suite.testLogin, suite.testHashedLogin)
+		suite.storageMock.onGetUser(suite.testLogin, suite.testHashedLogin, &storage.User{Salt: suite.testSalt, Password: suite.testHashedPassword}, nil)
+		suite.hasherMock.onVerifyHashWithSalt(suite.testPassword, suite.testSalt, suite.testHashedPassword, emptyString, false, nil)
+
+		res, err := suite.handler.Auth(context.Background(), positiveReq)
+		require.ErrorContains(err, "invalid password")
+		require.Equal(status.Code(err), codes.PermissionDenied)
+		require.Nil(res)
+	})
+
+	suite.Run("lockout after repeated failures", func() {
+		handler := NewGophKeeperHandler(
+			suite.storageMock,
+			suite.hasherMock,
+			suite.tokenerMock,
+			suite.fileStoreMock,
+			*storage.NewRetryPolicy(3, 5, 3),
+			75,
+			"testAdminToken",
+			false,
+			defaultListLimit,
+		)
+		handler.SetRateLimiter(ratelimit.NewMemoryStore(time.Minute), 2)
+
+		suite.hasherMock.onGenerateHash(suite.testLogin, suite.testHashedLogin)
+		suite.storageMock.onGetUser(suite.testLogin, suite.testHashedLogin, &storage.User{Salt: suite.testSalt, Password: suite.testHashedPassword}, nil)
+		suite.hasherMock.onVerifyHashWithSalt(suite.testPassword, suite.testSalt, suite.testHashedPassword, emptyString, false, nil)
+
+		for i := 0; i < 2; i++ {
+			res, err := handler.Auth(context.Background(), positiveReq)
+			require.Equal(codes.PermissionDenied, status.Code(err))
+			require.Nil(res)
+		}
+
+		res, err := handler.Auth(context.Background(), positiveReq)
+		require.ErrorContains(err, "too many failed attempts")
+		require.Equal(codes.ResourceExhausted, status.Code(err))
+		require.Nil(res)
+	})
+
+	suite.Run("get suite.testToken error", func() {
+		suite.hasherMock.onGenerateHash(suite.testLogin, suite.testHashedLogin)
+		suite.storageMock.onGetUser(suite.testLogin, suite.testHashedLogin, &storage.User{Salt: suite.testSalt, Password: suite.testHashedPassword}, nil)
+		suite.hasherMock.onVerifyHashWithSalt(suite.testPassword, suite.testSalt, suite.testHashedPassword, emptyString, true, nil)
+		suite.tokenerMock.onGetToken(mock.Anything, emptyString, testError)
+
+		res, err := suite.handler.Auth(context.Background(), positiveReq)
+		require.ErrorContains(err, testError.Error())
+		require.Equal(status.Code(err), codes.Internal)
+		require.Nil(res)
+	})
+
+	suite.Run("positive test", func() {
+		suite.hasherMock.onGenerateHash(suite.testLogin, suite.testHashedLogin)
+		suite.storageMock.onGetUser(suite.testLogin, suite.testHashedLogin, &storage.User{Salt: suite.testSalt, Password: suite.testHashedPassword}, nil)
+		suite.hasherMock.onVerifyHashWithSalt(suite.testPassword, suite.testSalt, suite.testHashedPassword, emptyString, true, nil)
+		suite.tokenerMock.onGetToken(mock.Anything, suite.testToken, nil)
+
+		res, err := suite.handler.Auth(context.Background(), positiveReq)
+		suite.Require().NoError(err)
+		suite.Require().Equal(suite.testToken, res.Token)
+	})
+
+	suite.Run("positive test imported algo", func() {
+		suite.hasherMock.onGenerateHash(suite.testLogin, suite.testHashedLogin)
+		suite.storageMock.onGetUser(suite.testLogin, suite.testHashedLogin, &storage.User{Salt: suite.testSalt, Password: suite.testHashedPassword, Algo: "bcrypt"}, nil)
+		suite.hasherMock.onVerifyHashWithSalt(suite.testPassword, suite.testSalt, suite.testHashedPassword, "bcrypt", true, nil)
+		suite.tokenerMock.onGetToken(mock.Anything, suite.testToken, nil)
+
+		res, err := suite.handler.Auth(context.Background(), positiveReq)
+		suite.Require().NoError(err)
+		suite.Require().Equal(suite.testToken, res.Token)
+	})
+}
+
+func (suite *HandlersTestSuite) TestChallenge() {
+	require := suite.Require()
+
+	suite.Run("sign error", func() {
+		nonce := []byte("testErrorNonce")
+		suite.tokenerMock.onSignChallenge(nonce, nil, testError)
+
+		res, err := suite.handler.Challenge(context.Background(), &proto.ChallengeRequest{Nonce: nonce})
+		require.ErrorContains(err, testError.Error())
+		require.Equal(status.Code(err), codes.Internal)
+		require.Nil(res)
+	})
+
+	suite.Run("positive test", func() {
+		nonce := []byte("testNonce")
+		signature := []byte("testSignature")
+		suite.tokenerMock.onSignChallenge(nonce, signature, nil)
+
+		res, err := suite.handler.Challenge(context.Background(), &proto.ChallengeRequest{Nonce: nonce})
+		require.NoError(err)
+		require.Equal(signature, res.GetSignature())
+	})
+}
+
+func (suite *HandlersTestSuite) TestMaintenance() {
+	require := suite.Require()
+
+	adminCtx := metadata.NewIncomingContext(
+		context.Background(),
+		metadata.Pairs(adminTokenHeader, suite.testAdminToken),
+	)
+
+	suite.Run("invalid admin token", func() {
+		res, err := suite.handler.Maintenance(context.Background(), &proto.MaintenanceRequest{})
+		require.Error(err)
+		require.Equal(codes.PermissionDenied, status.Code(err))
+		require.Nil(res)
+	})
+
+	suite.Run("database error", func() {
+		suite.storageMock.onMaintenance(time.Duration(0), testError)
+
+		res, err := suite.handler.Maintenance(adminCtx, &proto.MaintenanceRequest{})
+		require.ErrorContains(err, testError.Error())
+		require.Equal(codes.Internal, status.Code(err))
+		require.Nil(res)
+	})
+
+	suite.Run("positive test", func() {
+		suite.storageMock.onMaintenance(time.Second, nil)
+
+		res, err := suite.handler.Maintenance(adminCtx, &proto.MaintenanceRequest{})
+		require.NoError(err)
+		require.Equal(time.Second, res.GetDuration().AsDuration())
+	})
+}
+
+func (suite *HandlersTestSuite) TestGetIntegrityReport() {
+	require := suite.Require()
+
+	adminCtx := metadata.NewIncomingContext(
+		context.Background(),
+		metadata.Pairs(adminTokenHeader, suite.testAdminToken),
+	)
+
+	testFile := storage.File{
+		ID:         suite.testFileID,
+		UserID:     suite.testUserID,
+		PathToFile: "testPath",
+	}
+
+	suite.Run("invalid admin token", func() {
+		res, err := suite.handler.GetIntegrityReport(context.Background(), &proto.GetIntegrityReportRequest{})
+		require.Error(err)
+		require.Equal(codes.PermissionDenied, status.Code(err))
+		require.Nil(res)
+	})
+
+	suite.Run("database error", func() {
+		suite.storageMock.onListAllFilesOnce(nil, testError)
+
+		res, err := suite.handler.GetIntegrityReport(adminCtx, &proto.GetIntegrityReportRequest{})
+		require.ErrorContains(err, testError.Error())
+		require.Equal(codes.Internal, status.Code(err))
+		require.Nil(res)
+	})
+
+	suite.Run("deleted blob detected", func() {
+		suite.storageMock.onListAllFilesOnce([]storage.File{testFile}, nil)
+		suite.fileStoreMock.onGetDBFileOnce(testFile.PathToFile, nil, os.ErrNotExist)
+
+		res, err := suite.handler.GetIntegrityReport(adminCtx, &proto.GetIntegrityReportRequest{})
+		require.NoError(err)
+		require.EqualValues(1, res.GetFilesChecked())
+		require.Len(res.GetAnomalies(), 1)
+		require.Equal(testFile.ID, res.GetAnomalies()[0].GetFileId())
+		require.Equal(testFile.UserID, res.GetAnomalies()[0].GetUserId())
+		require.Contains(res.GetAnomalies()[0].GetReason(), "cannot open blob")
+	})
+
+	suite.Run("healthy blob", func() {
+		dbFilerMock := new(DBFilerMockedObject)
+		dbFilerMock.onGetChunkOnce(nil, io.EOF)
+		dbFilerMock.onClose(nil)
+
+		suite.storageMock.onListAllFilesOnce([]storage.File{testFile}, nil)
+		suite.fileStoreMock.onGetDBFileOnce(testFile.PathToFile, dbFilerMock, nil)
+
+		// Drop the report cached by the previous subtest so the handler
+		// runs a fresh scan.
+		suite.handler.integrityReport = nil
+
+		res, err := suite.handler.GetIntegrityReport(adminCtx, &proto.GetIntegrityReportRequest{})
+		require.NoError(err)
+		require.EqualValues(1, res.GetFilesChecked())
+		require.Empty(res.GetAnomalies())
+
+		dbFilerMock.AssertExpectations(suite.T())
+	})
+}
+
+func (suite *HandlersTestSuite) TestGenerateRecoveryCodes() {
+	require := suite.Require()
+
+	expectedHashes := make([]string, recoveryCodeCount)
+
+	for i := range expectedHashes {
+		expectedHashes[i] = "testCodeHash"
+	}
+
+	suite.Run("unauthenticated", func() {
+		res, err := suite.handler.GenerateRecoveryCodes(context.Background(), &proto.GenerateRecoveryCodesRequest{})
+		require.Error(err)
+		require.Equal(codes.Unauthenticated, status.Code(err))
+		require.Nil(res)
+	})
+
+	suite.Run("generate code error", func() {
+		suite.hasherMock.onGenerateSalt(recoveryCodeLength, emptyString, testError)
+
+		res, err := suite.handler.GenerateRecoveryCodes(suite.testIncomingContext, &proto.GenerateRecoveryCodesRequest{})
+		require.ErrorContains(err, "generate recovery code")
+		require.Equal(codes.Internal, status.Code(err))
+		require.Nil(res)
+	})
+
+	suite.Run("database error", func() {
+		suite.hasherMock.onGenerateSalt(recoveryCodeLength, "testCode", nil)
+		suite.hasherMock.onGenerateHash("testCode", "testCodeHash")
+		suite.storageMock.onReplaceRecoveryCodes(suite.testUserID, expectedHashes, testError)
+
+		res, err := suite.handler.GenerateRecoveryCodes(suite.testIncomingContext, &proto.GenerateRecoveryCodesRequest{})
+		require.ErrorContains(err, testError.Error())
+		require.Equal(codes.Internal, status.Code(err))
+		require.Nil(res)
+	})
+
+	suite.Run("positive test", func() {
+		suite.hasherMock.onGenerateSalt(recoveryCodeLength, "testCode", nil)
+		suite.hasherMock.onGenerateHash("testCode", "testCodeHash")
+		suite.storageMock.onReplaceRecoveryCodes(suite.testUserID, expectedHashes, nil)
+
+		res, err := suite.handler.GenerateRecoveryCodes(suite.testIncomingContext, &proto.GenerateRecoveryCodesRequest{})
+		require.NoError(err)
+		require.Len(res.GetCodes(), recoveryCodeCount)
+
+		for _, code := range res.GetCodes() {
+			require.Equal("testCode", code)
+		}
+	})
+}
+
+func (suite *HandlersTestSuite) TestRecoverWithCode() {
+	require := suite.Require()
+
+	positiveReq := &proto.RecoverWithCodeRequest{
+		Login: suite.testLogin,
+		Code:  "testCode",
+	}
+
+	storedCodes := []storage.RecoveryCode{
+		{
+			ID:       "testRecoveryCodeID",
+			UserID:   suite.testUserID,
+			CodeHash: "testCodeHash",
+		},
+	}
+
+	suite.Run("unknown user", func() {
+		suite.storageMock.onGetUserByLogin(suite.testLogin, nil, storage.ErrUserNotFound)
+
+		res, err := suite.handler.RecoverWithCode(context.Background(), positiveReq)
+		require.ErrorContains(err, fmt.Sprintf("unknown user %s", suite.testLogin))
+		require.Equal(codes.Unknown, status.Code(err))
+		require.Nil(res)
+	})
+
+	suite.Run("database error", func() {
+		suite.storageMock.onGetUserByLogin(suite.testLogin, nil, testError)
+
+		res, err := suite.handler.RecoverWithCode(context.Background(), positiveReq)
+		require.ErrorContains(err, testError.Error())
+		require.Equal(codes.Internal, status.Code(err))
+		require.Nil(res)
+	})
+
+	suite.Run("get recovery codes error", func() {
+		suite.storageMock.onGetUserByLogin(suite.testLogin, &storage.User{ID: suite.testUserID}, nil)
+		suite.storageMock.onGetRecoveryCodes(suite.testUserID, nil, testError)
+
+		res, err := suite.handler.RecoverWithCode(context.Background(), positiveReq)
+		require.ErrorContains(err, testError.Error())
+		require.Equal(codes.Internal, status.Code(err))
+		require.Nil(res)
+	})
+
+	suite.Run("invalid code", func() {
+		suite.storageMock.onGetUserByLogin(suite.testLogin, &storage.User{ID: suite.testUserID}, nil)
+		suite.storageMock.onGetRecoveryCodes(suite.testUserID, storedCodes, nil)
+		suite.hasherMock.onGenerateHash("testCode", "wrongHash")
+
+		res, err := suite.handler.RecoverWithCode(context.Background(), positiveReq)
+		require.ErrorContains(err, "invalid recovery code")
+		require.Equal(codes.PermissionDenied, status.Code(err))
+		require.Nil(res)
+	})
+
+	suite.Run("code already used", func() {
+		suite.storageMock.onGetUserByLogin(suite.testLogin, &storage.User{ID: suite.testUserID}, nil)
+		suite.storageMock.onGetRecoveryCodes(suite.testUserID, storedCodes, nil)
+		suite.hasherMock.onGenerateHash("testCode", "testCodeHash")
+		suite.storageMock.onDeleteRecoveryCode("testRecoveryCodeID", storage.ErrRecoveryCodeNotFound)
+
+		res, err := suite.handler.RecoverWithCode(context.Background(), positiveReq)
+		require.ErrorContains(err, "invalid recovery code")
+		require.Equal(codes.PermissionDenied, status.Code(err))
+		require.Nil(res)
+	})
+
+	suite.Run("get token error", func() {
+		suite.storageMock.onGetUserByLogin(suite.testLogin, &storage.User{ID: suite.testUserID}, nil)
+		suite.storageMock.onGetRecoveryCodes(suite.testUserID, storedCodes, nil)
+		suite.hasherMock.onGenerateHash("testCode", "testCodeHash")
+		suite.storageMock.onDeleteRecoveryCode("testRecoveryCodeID", nil)
+		suite.tokenerMock.onGetToken(suite.testUserID, emptyString, testError)
+
+		res, err := suite.handler.RecoverWithCode(context.Background(), positiveReq)
+		require.ErrorContains(err, testError.Error())
+		require.Equal(codes.Internal, status.Code(err))
+		require.Nil(res)
+	})
+
+	suite.Run("positive test", func() {
+		suite.storageMock.onGetUserByLogin(suite.testLogin, &storage.User{ID: suite.testUserID}, nil)
+		suite.storageMock.onGetRecoveryCodes(suite.testUserID, storedCodes, nil)
+		suite.hasherMock.onGenerateHash("testCode", "testCodeHash")
+		suite.storageMock.onDeleteRecoveryCode("testRecoveryCodeID", nil)
+		suite.tokenerMock.onGetToken(suite.testUserID, suite.testToken, nil)
+
+		res, err := suite.handler.RecoverWithCode(context.Background(), positiveReq)
+		require.NoError(err)
+		require.Equal(suite.testToken, res.GetToken())
+		suite.storageMock.AssertCalled(suite.T(), "DeleteRecoveryCode", "testRecoveryCodeID")
+	})
+}
+
+func (suite *HandlersTestSuite) TestUploadPublicKey() {
+	require := suite.Require()
+
+	publicKeyPEM := testPublicKeyPEM(suite.T())
+
+	suite.Run("unauthenticated", func() {
+		res, err := suite.handler.UploadPublicKey(context.Background(), &proto.UploadPublicKeyRequest{PublicKey: publicKeyPEM})
+		require.Error(err)
+		require.Equal(codes.Unauthenticated, status.Code(err))
+		require.Nil(res)
+	})
+
+	suite.Run("invalid key format", func() {
+		res, err := suite.handler.UploadPublicKey(suite.testIncomingContext, &proto.UploadPublicKeyRequest{PublicKey: "not a PEM key"})
+		require.ErrorContains(err, "invalid public key")
+		require.Equal(codes.InvalidArgument, status.Code(err))
+		require.Nil(res)
+	})
+
+	suite.Run("unknown UserID error", func() {
+		suite.storageMock.onUpsertPublicKey(suite.testUserID, publicKeyPEM, storage.ErrUserNotFound)
+
+		res, err := suite.handler.UploadPublicKey(suite.testIncomingContext, &proto.UploadPublicKeyRequest{PublicKey: publicKeyPEM})
+		require.ErrorContains(err, fmt.Sprintf("unknown UserID %s", suite.testUserID))
+		require.Equal(codes.Unknown, status.Code(err))
+		require.Nil(res)
+	})
+
+	suite.Run("positive test", func() {
+		suite.storageMock.onUpsertPublicKey(suite.testUserID, publicKeyPEM, nil)
+
+		res, err := suite.handler.UploadPublicKey(suite.testIncomingContext, &proto.UploadPublicKeyRequest{PublicKey: publicKeyPEM})
+		require.NoError(err)
+		require.NotNil(res)
+	})
+}
+
+func (suite *HandlersTestSuite) TestGetPublicKey() {
+	require := suite.Require()
+
+	publicKeyPEM := testPublicKeyPEM(suite.T())
+
+	positiveReq := &proto.GetPublicKeyRequest{
+		Login: suite.testLogin,
+	}
+
+	testUser := &storage.User{
+		ID:    suite.testUserID,
+		Login: suite.testLogin,
+	}
+
+	suite.Run("unauthenticated", func() {
+		res, err := suite.handler.GetPublicKey(context.Background(), positiveReq)
+		require.Error(err)
+		require.Equal(codes.Unauthenticated, status.Code(err))
+		require.Nil(res)
+	})
+
+	suite.Run("unknown user", func() {
+		suite.storageMock.onGetUserByLogin(suite.testLogin, nil, storage.ErrUserNotFound)
+
+		res, err := suite.handler.GetPublicKey(suite.testIncomingContext, positiveReq)
+		require.ErrorContains(err, fmt.Sprintf("unknown user %s", suite.testLogin))
+		require.Equal(codes.Unknown, status.Code(err))
+		require.Nil(res)
+	})
+
+	suite.Run("no uploaded key", func() {
+		suite.storageMock.onGetUserByLogin(suite.testLogin, testUser, nil)
+		suite.storageMock.onGetPublicKey(suite.testUserID, emptyString, storage.ErrPublicKeyNotFound)
+
+		res, err := suite.handler.GetPublicKey(suite.testIncomingContext, positiveReq)
+		require.ErrorContains(err, fmt.Sprintf("no public key for user %s", suite.testLogin))
+		require.Equal(codes.NotFound, status.Code(err))
+		require.Nil(res)
+	})
+
+	suite.Run("positive test", func() {
+		suite.storageMock.onGetUserByLogin(suite.testLogin, testUser, nil)
+		suite.storageMock.onGetPublicKey(suite.testUserID, publicKeyPEM, nil)
+
+		res, err := suite.handler.GetPublicKey(suite.testIncomingContext, positiveReq)
+		require.NoError(err)
+		require.Equal(publicKeyPEM, res.GetPublicKey())
+	})
+}
+
+func (suite *HandlersTestSuite) TestMalformedUserID() {
+	require := suite.Require()
+
+	malformedCtx := metadata.NewIncomingContext(
+		context.Background(),
+		metadata.Pairs(userIDHeader, "notAUUID"),
+	)
+
+	res, err := suite.handler.CreatePassword(malformedCtx, &proto.CreatePasswordRequest{
+		Name:     suite.testName,
+		Login:    suite.testLogin,
+		Password: suite.testPassword,
+		Meta:     suite.testMeta,
+	})
+	require.ErrorContains(err, "invalid user id")
+	require.Equal(status.Code(err), codes.Unauthenticated)
+	require.Nil(res)
+}
+
+func (suite *HandlersTestSuite) TestImportUser() {
+	require := suite.Require()
+
+	positiveReq := &proto.ImportUserRequest{
+		Login:        suite.testLogin,
+		PasswordHash: suite.testHashedPassword,
+		Salt:         suite.testSalt,
+		Algo:         "bcrypt",
+	}
+
+	adminCtx := metadata.NewIncomingContext(
+		context.Background(),
+		metadata.Pairs(adminTokenHeader, suite.testAdminToken),
+	)
+
+	suite.Run("admin methods disabled", func() {
+		handler := NewGophKeeperHandler(
+			suite.storageMock,
+			suite.hasherMock,
+			suite.tokenerMock,
+			suite.fileStoreMock,
+			*storage.NewRetryPolicy(3, 5, 3),
+			suite.testSaltLength,
+			emptyString,
+			false,
+			defaultListLimit,
+		)
+
+		res, err := handler.ImportUser(adminCtx, positiveReq)
+		require.ErrorContains(err, "admin methods are disabled")
+		require.Equal(status.Code(err), codes.PermissionDenied)
+		require.Nil(res)
+	})
+
+	suite.Run("missing metadata", func() {
+		res, err := suite.handler.ImportUser(context.Background(), positiveReq)
+		require.ErrorContains(err, "missing metadata")
+		require.Equal(status.Code(err), codes.PermissionDenied)
+		require.Nil(res)
+	})
+
+	suite.Run("invalid admin token", func() {
+		invalidCtx := metadata.NewIncomingContext(
+			context.Background(),
+			metadata.Pairs(adminTokenHeader, "invalidToken"),
+		)
+
+		res, err := suite.handler.ImportUser(invalidCtx, positiveReq)
+		require.ErrorContains(err, "invalid admin token")
+		require.Equal(status.Code(err), codes.PermissionDenied)
+		require.Nil(res)
+	})
+
+	suite.Run("unsupported algo", func() {
+		req := &proto.ImportUserRequest{
+			Login:        suite.testLogin,
+			PasswordHash: suite.testHashedPassword,
+			Salt:         suite.testSalt,
+			Algo:         "md5",
+		}
+
+		res, err := suite.handler.ImportUser(adminCtx, req)
+		require.ErrorContains(err, "unsupported hash algorithm md5")
+		require.Equal(status.Code(err), codes.InvalidArgument)
+		require.Nil(res)
+	})
+
+	suite.Run("user already exists error", func() {
+		suite.hasherMock.onGenerateHash(suite.testLogin, suite.testHashedLogin)
+		suite.storageMock.onCreateUserPreHashed(suite.testLogin, suite.testHashedLogin, suite.testSalt, suite.testHashedPassword, "bcrypt", nil, storage.ErrUserAlreadyExists)
+
+		res, err := suite.handler.ImportUser(adminCtx, positiveReq)
+		require.ErrorContains(err, fmt.Sprintf("user %s already exists", suite.testLogin))
+		require.Equal(status.Code(err), codes.AlreadyExists)
+		require.Nil(res)
+	})
+
+	suite.Run("database error", func() {
+		suite.hasherMock.onGenerateHash(suite.testLogin, suite.testHashedLogin)
+		suite.storageMock.onCreateUserPreHashed(suite.testLogin, suite.testHashedLogin, suite.testSalt, suite.testHashedPassword, "bcrypt", nil, testError)
+
+		res, err := suite.handler.ImportUser(adminCtx, positiveReq)
+		require.ErrorContains(err, testError.Error())
+		require.Equal(status.Code(err), codes.Internal)
+		require.Nil(res)
+	})
+
+	suite.Run("positive test", func() {
+		suite.hasherMock.onGenerateHash(suite.testLogin, suite.testHashedLogin)
+		suite.storageMock.onCreateUserPreHashed(suite.testLogin, suite.testHashedLogin, suite.testSalt, suite.testHashedPassword, "bcrypt", &storage.User{}, nil)
+
+		res, err := suite.handler.ImportUser(adminCtx, positiveReq)
+		suite.Require().NoError(err)
+		suite.Require().NotNil(res)
+	})
+}
+
+func (suite *HandlersTestSuite) TestValidateToken() {
+	require := suite.Require()
+
+	suite.Run("unauthenticated", func() {
+		res, err := suite.handler.ValidateToken(context.Background(), &proto.ValidateTokenRequest{})
+		require.Error(err)
+		require.Equal(status.Code(err), codes.Unauthenticated)
+		require.Nil(res)
+	})
+
+	suite.Run("positive test", func() {
+		res, err := suite.handler.ValidateToken(suite.testIncomingContext, &proto.ValidateTokenRequest{})
+		require.NoError(err)
+		require.Equal(suite.testUserID, res.GetUserId())
+		require.Nil(res.GetExpiresAt())
+	})
+
+	suite.Run("with expiry", func() {
+		exp := time.Now().Add(time.Hour).Truncate(time.Second)
+
+		ctx := metadata.NewIncomingContext(
+			context.Background(),
+			metadata.Pairs(
+				userIDHeader, suite.testUserID,
+				tokenExpHeader, strconv.FormatInt(exp.Unix(), 10),
+			),
+		)
+
+		res, err := suite.handler.ValidateToken(ctx, &proto.ValidateTokenRequest{})
+		require.NoError(err)
+		require.Equal(suite.testUserID, res.GetUserId())
+		require.True(res.GetExpiresAt().AsTime().Equal(exp))
+	})
+
+	suite.Run("unparseable expiry", func() {
+		ctx := metadata.NewIncomingContext(
+			context.Background(),
+			metadata.Pairs(
+				userIDHeader, suite.testUserID,
+				tokenExpHeader, "not a number",
+			),
+		)
+
+		res, err := suite.handler.ValidateToken(ctx, &proto.ValidateTokenRequest{})
+		require.NoError(err)
+		require.Equal(suite.testUserID, res.GetUserId())
+		require.Nil(res.GetExpiresAt())
+	})
+}
+
+func (suite *HandlersTestSuite) TestCreatePassword() {
+	require := suite.Require()
+
+	positiveReq := &proto.CreatePasswordRequest{
+		Name:     suite.testName,
+		Login:    suite.testLogin,
+		Password: suite.testPassword,
+		Meta:     suite.testMeta,
+	}
+
+	suite.Run("unauthenticated", func() {
+		req := &proto.CreatePasswordRequest{
+			Name:     "",
+			Login:    "",
+			Password: "",
+			Meta:     "",
+		}
+
+		res, err := suite.handler.CreatePassword(context.Background(), req)
+		require.Error(err)
+		require.Equal(status.Code(err), codes.Unauthenticated)
+		require.Nil(res)
+	})
+
+	suite.Run("database error", func() {
+		suite.storageMock.onCreatePassword(suite.testUserID, suite.testName, suite.testLogin, suite.testPassword, suite.testMeta, "", "", false, false, nil, testError)
+
+		res, err := suite.handler.CreatePassword(suite.testIncomingContext, positiveReq)
+		require.ErrorContains(err, testError.Error())
+		require.Equal(status.Code(err), codes.Internal)
+		require.Nil(res)
+	})
+
+	suite.Run("unknown UserID error", func() {
+		suite.storageMock.onCreatePassword(suite.testUserID, suite.testName, suite.testLogin, suite.testPassword, suite.testMeta, "", "", false, false, nil, storage.ErrUserNotFound)
+
+		res, err := suite.handler.CreatePassword(suite.testIncomingContext, positiveReq)
+		require.ErrorContains(err, fmt.Sprintf("unknown UserID %s", suite.testUserID))
+		require.Equal(status.Code(err), codes.Unknown)
+		require.Nil(res)
+	})
+
+	suite.Run("positive test", func() {
+		suite.storageMock.onCreatePassword(suite.testUserID, suite.testName, suite.testLogin, suite.testPassword, suite.testMeta, "", "", false, false, &storage.Password{ID: suite.testPasswordID}, nil)
+
+		res, err := suite.handler.CreatePassword(suite.testIncomingContext, positiveReq)
+		suite.Require().NoError(err)
+		suite.Require().Equal(suite.testPasswordID, res.GetId())
+	})
+}
+
+func (suite *HandlersTestSuite) TestUpdatePassword() {
+	require := suite.Require()
+
+	positiveReq := &proto.UpdatePasswordRequest{
+		Id:       suite.testPasswordID,
+		Name:     suite.testName,
+		Login:    suite.testLogin,
+		Password: suite.testPassword,
+		Meta:     suite.testMeta,
+	}
+
+	suite.Run("unauthenticated", func() {
+		req := &proto.UpdatePasswordRequest{
+			Id:       "",
+			Name:     "",
+			Login:    "",
+			Password: "",
+			Meta:     "",
+		}
+
+		res, err := suite.handler.UpdatePassword(context.Background(), req)
+		require.Error(err)
+		require.Equal(status.Code(err), codes.Unauthenticated)
+		require.Nil(res)
+	})
+
+	suite.Run("database error", func() {
+		suite.storageMock.onUpdatePassword(suite.testPasswordID, suite.testUserID, suite.testName, suite.testLogin, suite.testPassword, suite.testMeta, "", "", false, false, nil, testError)
+
+		res, err := suite.handler.UpdatePassword(suite.testIncomingContext, positiveReq)
+		require.ErrorContains(err, testError.Error())
+		require.Equal(status.Code(err), codes.Internal)
+		require.Nil(res)
+	})
+
+	suite.Run("unknown UserID error", func() {
+		suite.storageMock.onUpdatePassword(suite.testPasswordID, suite.testUserID, suite.testName, suite.testLogin, suite.testPassword, suite.testMeta, "", "", false, false, nil, storage.ErrUserNotFound)
+
+		res, err := suite.handler.UpdatePassword(suite.testIncomingContext, positiveReq)
+		require.ErrorContains(err, fmt.Sprintf("unknown UserID %s", suite.testUserID))
+		require.Equal(status.Code(err), codes.Unknown)
+		require.Nil(res)
+	})
+
+	suite.Run("unknown PasswordID error", func() {
+		suite.storageMock.onUpdatePassword(suite.testPasswordID, suite.testUserID, suite.testName, suite.testLogin, suite.testPassword, suite.testMeta, "", "", false, false, nil, storage.ErrPasswordNotFound)
+
+		res, err := suite.handler.UpdatePassword(suite.testIncomingContext, positiveReq)
+		require.ErrorContains(err, fmt.Sprintf("unknown PasswordID %s", suite.testPasswordID))
+		require.Equal(status.Code(err), codes.Unknown)
+		require.Nil(res)
+	})
+
+	suite.Run("positive test", func() {
+		suite.storageMock.onUpdatePassword(suite.testPasswordID, suite.testUserID, suite.testName, suite.testLogin, suite.testPassword, suite.testMeta, "", "", false, false, &storage.Password{ID: suite.testPasswordID}, nil)
+
+		res, err := suite.handler.UpdatePassword(suite.testIncomingContext, positiveReq)
+		suite.Require().NoError(err)
+		suite.Require().Equal(suite.testPasswordID, res.GetId())
+	})
+}
+
+func (suite *HandlersTestSuite) TestGetPassword() {
+	require := suite.Require()
+
+	positiveReq := &proto.GetPasswordRequest{
+		Id: suite.testPasswordID,
+	}
+
+	suite.Run("unauthenticated", func() {
+		res, err := suite.handler.GetPassword(context.Background(), positiveReq)
+		require.Error(err)
+		require.Equal(status.Code(err), codes.Unauthenticated)
+		require.Nil(res)
+	})
+
+	suite.Run("empty PasswordID", func() {
+		req := &proto.GetPasswordRequest{
+			Id: "",
+		}
+		res, err := suite.handler.GetPassword(suite.testIncomingContext, req)
+		require.ErrorContains(err, "empty PasswordID")
+		require.Equal(status.Code(err), codes.InvalidArgument)
+		require.Nil(res)
+	})
+
+	suite.Run("database error", func() {
+		suite.storageMock.onGetPassword(suite.testPasswordID, suite.testUserID, nil, testError)
+
+		res, err := suite.handler.GetPassword(suite.testIncomingContext, positiveReq)
+		require.ErrorContains(err, testError.Error())
+		require.Equal(status.Code(err), codes.Internal)
+		require.Nil(res)
+	})
+
+	suite.Run("unknown PasswordID error", func() {
+		suite.storageMock.onGetPassword(suite.testPasswordID, suite.testUserID, nil, storage.ErrPasswordNotFound)
+
+		res, err := suite.handler.GetPassword(suite.testIncomingContext, positiveReq)
+		require.ErrorContains(err, fmt.Sprintf("unknown PasswordID %s", suite.testPasswordID))
+		require.Equal(status.Code(err), codes.Unknown)
+		require.Nil(res)
+	})
+
+	suite.Run("positive test", func() {
+		suite.storageMock.onGetPassword(suite.testPasswordID, suite.testUserID, &storage.Password{
+			ID:       suite.testPasswordID,
+			Name:     suite.testName,
+			Login:    suite.testLogin,
+			Password: suite.testPassword,
+			Meta:     suite.testMeta,
+			UpdateAt: suite.testUpdateAt,
+		}, nil)
+
+		res, err := suite.handler.GetPassword(suite.testIncomingContext, positiveReq)
+		suite.Require().NoError(err)
+		suite.Require().Equal(&proto.GetPasswordResponse{
+			Password: &proto.Password{
+				Id:       suite.testPasswordID,
+				Name:     suite.testName,
+				Login:    suite.testLogin,
+				Password: suite.testPassword,
+				Meta:     suite.testMeta,
+				UpdateAt: timestamppb.New(suite.testUpdateAt),
+			},
+		}, res)
+	})
+
+	suite.Run("not modified", func() {
+		suite.storageMock.onGetPassword(suite.testPasswordID, suite.testUserID, &storage.Password{
+			ID:       suite.testPasswordID,
+			Name:     suite.testName,
+			Login:    suite.testLogin,
+			Password: suite.testPassword,
+			Meta:     suite.testMeta,
+			UpdateAt: suite.testUpdateAt,
+		}, nil)
+
+		req := &proto.GetPasswordRequest{
+			Id:              suite.testPasswordID,
+			IfModifiedSince: timestamppb.New(suite.testUpdateAt),
+		}
+
+		res, err := suite.handler.GetPassword(suite.testIncomingContext, req)
+		require.NoError(err)
+		require.Equal(&proto.GetPasswordResponse{
+			NotModified: true,
+		}, res)
+	})
+
+	suite.Run("modified since", func() {
+		suite.storageMock.onGetPassword(suite.testPasswordID, suite.testUserID, &storage.Password{
+			ID:       suite.testPasswordID,
+			Name:     suite.testName,
+			Login:    suite.testLogin,
+			Password: suite.testPassword,
+			Meta:     suite.testMeta,
+			UpdateAt: suite.testUpdateAt,
+		}, nil)
+
+		req := &proto.GetPasswordRequest{
+			Id:              suite.testPasswordID,
+			IfModifiedSince: timestamppb.New(suite.testUpdateAt.Add(-time.Hour)),
+		}
+
+		res, err := suite.handler.GetPassword(suite.testIncomingContext, req)
+		require.NoError(err)
+		require.False(res.GetNotModified())
+		require.Equal(suite.testPasswordID, res.GetPassword().GetId())
+	})
+}
+
+func (suite *HandlersTestSuite) TestRevealRequiresReauth() {
+	require := suite.Require()
+
+	positiveReq := &proto.GetPasswordRequest{
+		Id: suite.testPasswordID,
+	}
+
+	gatedPWD := &storage.Password{
+		ID:             suite.testPasswordID,
+		Name:           suite.testName,
+		Login:          suite.testLogin,
+		Password:       suite.testPassword,
+		Meta:           suite.testMeta,
+		RequiresReauth: true,
+		UpdateAt:       suite.testUpdateAt,
+	}
+
+	authedContext := func(authAt time.Time) context.Context {
+		md := metadata.Pairs(userIDHeader, suite.testUserID)
+		md.Set(authAtHeader, strconv.FormatInt(authAt.Unix(), 10))
+
+		return metadata.NewIncomingContext(context.Background(), md)
+	}
+
+	suite.Run("gated entry without auth time", func() {
+		suite.storageMock.onGetPassword(suite.testPasswordID, suite.testUserID, gatedPWD, nil)
+
+		res, err := suite.handler.GetPassword(suite.testIncomingContext, positiveReq)
+		require.ErrorContains(err, "reauth required")
+		require.Equal(status.Code(err), codes.PermissionDenied)
+		require.Nil(res)
+	})
+
+	suite.Run("gated entry with stale auth", func() {
+		suite.storageMock.onGetPassword(suite.testPasswordID, suite.testUserID, gatedPWD, nil)
+
+		res, err := suite.handler.GetPassword(authedContext(time.Now().Add(-time.Hour)), positiveReq)
+		require.ErrorContains(err, "reauth required")
+		require.Equal(status.Code(err), codes.PermissionDenied)
+		require.Nil(res)
+	})
+
+	suite.Run("gated entry with recent auth", func() {
+		suite.storageMock.onGetPassword(suite.testPasswordID, suite.testUserID, gatedPWD, nil)
+
+		res, err := suite.handler.GetPassword(authedContext(time.Now()), positiveReq)
+		require.NoError(err)
+		require.Equal(suite.testPasswordID, res.GetPassword().GetId())
+		require.True(res.GetPassword().GetRequiresReauth())
+	})
+
+	suite.Run("ungated entry needs no auth time", func() {
+		suite.storageMock.onGetPassword(suite.testPasswordID, suite.testUserID, &storage.Password{
+			ID:       suite.testPasswordID,
+			Name:     suite.testName,
+			Login:    suite.testLogin,
+			Password: suite.testPassword,
+			Meta:     suite.testMeta,
+			UpdateAt: suite.testUpdateAt,
+		}, nil)
+
+		res, err := suite.handler.GetPassword(suite.testIncomingContext, positiveReq)
+		require.NoError(err)
+		require.Equal(suite.testPasswordID, res.GetPassword().GetId())
+		require.False(res.GetPassword().GetRequiresReauth())
+	})
+
+	suite.Run("gated text entry", func() {
+		suite.storageMock.onGetText(suite.testTextID, suite.testUserID, &storage.Text{
+			ID:             suite.testTextID,
+			Name:           suite.testName,
+			Text:           suite.testText,
+			Meta:           suite.testMeta,
+			RequiresReauth: true,
+			UpdateAt:       suite.testUpdateAt,
+		}, nil)
+
+		res, err := suite.handler.GetText(suite.testIncomingContext, &proto.GetTextRequest{Id: suite.testTextID})
+		require.ErrorContains(err, "reauth required")
+		require.Equal(status.Code(err), codes.PermissionDenied)
+		require.Nil(res)
+	})
+
+	suite.Run("gated bank entry", func() {
+		suite.storageMock.onGetBank(suite.testBankID, suite.testUserID, &storage.Bank{
+			ID:             suite.testBankID,
+			Name:           suite.testName,
+			CardNumber:     suite.testCardNumber,
+			RequiresReauth: true,
+			UpdateAt:       suite.testUpdateAt,
+		}, nil)
+
+		res, err := suite.handler.GetBank(suite.testIncomingContext, &proto.GetBankRequest{Id: suite.testBankID})
+		require.ErrorContains(err, "reauth required")
+		require.Equal(status.Code(err), codes.PermissionDenied)
+		require.Nil(res)
+	})
+
+	suite.Run("gated entry gates the password list", func() {
+		suite.storageMock.onGetAllPassword(suite.testUserID, []storage.Password{*gatedPWD}, nil)
+
+		res, err := suite.handler.GetPasswords(suite.testIncomingContext, &proto.GetPasswordsRequest{})
+		require.ErrorContains(err, "reauth required")
+		require.Equal(status.Code(err), codes.PermissionDenied)
+		require.Nil(res)
+	})
+
+	suite.Run("gated password list with recent auth", func() {
+		suite.storageMock.onGetAllPassword(suite.testUserID, []storage.Password{*gatedPWD}, nil)
+
+		res, err := suite.handler.GetPasswords(authedContext(time.Now()), &proto.GetPasswordsRequest{})
+		require.NoError(err)
+		require.Len(res.GetPasswords(), 1)
+		require.True(res.GetPasswords()[0].GetRequiresReauth())
+	})
+
+	suite.Run("ungated password list needs no auth time", func() {
+		suite.storageMock.onGetAllPassword(suite.testUserID, []storage.Password{{
+			ID:       suite.testPasswordID,
+			Name:     suite.testName,
+			Login:    suite.testLogin,
+			Password: suite.testPassword,
+			Meta:     suite.testMeta,
+			UpdateAt: suite.testUpdateAt,
+		}}, nil)
+
+		res, err := suite.handler.GetPasswords(suite.testIncomingContext, &proto.GetPasswordsRequest{})
+		require.NoError(err)
+		require.Len(res.GetPasswords(), 1)
+	})
+
+	suite.Run("gated entry gates the category list", func() {
+		suite.storageMock.onGetPasswordsByCategory(suite.testUserID, "testCategory", []storage.Password{*gatedPWD}, nil)
+
+		res, err := suite.handler.GetPasswordsByCategory(suite.testIncomingContext, &proto.GetPasswordsByCategoryRequest{Category: "testCategory"})
+		require.ErrorContains(err, "reauth required")
+		require.Equal(status.Code(err), codes.PermissionDenied)
+		require.Nil(res)
+	})
+
+	suite.Run("gated entry gates the bank list", func() {
+		suite.storageMock.onGetAllBanks(suite.testUserID, []storage.Bank{{
+			ID:             suite.testBankID,
+			Name:           suite.testName,
+			CardNumber:     suite.testCardNumber,
+			RequiresReauth: true,
+			UpdateAt:       suite.testUpdateAt,
+		}}, nil)
+
+		res, err := suite.handler.GetBanks(suite.testIncomingContext, &proto.GetBanksRequest{})
+		require.ErrorContains(err, "reauth required")
+		require.Equal(status.Code(err), codes.PermissionDenied)
+		require.Nil(res)
+	})
+
+	suite.Run("gated entry gates the text list", func() {
+		suite.storageMock.onGetAllTexts(suite.testUserID, "", "", 0, 0, []storage.Text{{
+			ID:             suite.testTextID,
+			Name:           suite.testName,
+			Text:           suite.testText,
+			RequiresReauth: true,
+			UpdateAt:       suite.testUpdateAt,
+		}}, nil)
+
+		res, err := suite.handler.GetTexts(suite.testIncomingContext, &proto.GetTextsRequest{})
+		require.ErrorContains(err, "reauth required")
+		require.Equal(status.Code(err), codes.PermissionDenied)
+		require.Nil(res)
+	})
+
+	suite.Run("gated entry gates the favorites", func() {
+		suite.storageMock.onGetFavorites(suite.testUserID, &storage.Favorites{
+			Texts: []storage.Text{{
+				ID:             suite.testTextID,
+				Name:           suite.testName,
+				Text:           suite.testText,
+				Pinned:         true,
+				RequiresReauth: true,
+				UpdateAt:       suite.testUpdateAt,
+			}},
+		}, nil)
+
+		res, err := suite.handler.GetFavorites(suite.testIncomingContext, &proto.GetFavoritesRequest{})
+		require.ErrorContains(err, "reauth required")
+		require.Equal(status.Code(err), codes.PermissionDenied)
+		require.Nil(res)
+	})
+}
+
+func (suite *HandlersTestSuite) TestGetPasswords() {
+	require := suite.Require()
+
+	suite.Run("unauthenticated", func() {
+		res, err := suite.handler.GetPasswords(context.Background(), &proto.GetPasswordsRequest{})
+		require.Error(err)
+		require.Equal(status.Code(err), codes.Unauthenticated)
+		require.Nil(res)
+	})
+
+	suite.Run("database error", func() {
+		suite.storageMock.onGetAllPassword(suite.testUserID, nil, testError)
+
+		res, err := suite.handler.GetPasswords(suite.testIncomingContext, &proto.GetPasswordsRequest{})
+		require.ErrorContains(err, testError.Error())
+		require.Equal(status.Code(err), codes.Internal)
+		require.Nil(res)
+	})
+
+	suite.Run("unknown UserID error", func() {
+		suite.storageMock.onGetAllPassword(suite.testUserID, nil, storage.ErrUserNotFound)
+
+		res, err := suite.handler.GetPasswords(suite.testIncomingContext, &proto.GetPasswordsRequest{})
+		require.ErrorContains(err, fmt.Sprintf("unknown UserID %s", suite.testUserID))
+		require.Equal(status.Code(err), codes.Unknown)
+		require.Nil(res)
+	})
+
+	suite.Run("positive test", func() {
+		passwords := []storage.Password{
+			{
+				ID:       suite.testPasswordID,
+				Name:     suite.testName,
+				Login:    suite.testLogin,
+				Password: suite.testPassword,
+				Meta:     suite.testMeta,
+				UpdateAt: suite.testUpdateAt,
+			},
+			{
+				ID:       "anotherPasswordID",
+				Name:     "anotherName",
+				Login:    "anotherLogin",
+				Password: "anotherPassword",
+				Meta:     "anotherMeta",
+				UpdateAt: suite.testUpdateAt,
+			},
+		}
+		suite.storageMock.onGetAllPassword(suite.testUserID, passwords, nil)
+
+		res, err := suite.handler.GetPasswords(suite.testIncomingContext, &proto.GetPasswordsRequest{})
+		suite.Require().NoError(err)
+		suite.Require().Equal(&proto.GetPasswordsResponse{
+			Passwords: []*proto.Password{
+				{
+					Id:       suite.testPasswordID,
+					Name:     suite.testName,
+					Login:    suite.testLogin,
+					Password: suite.testPassword,
+					Meta:     suite.testMeta,
+					UpdateAt: timestamppb.New(suite.testUpdateAt),
+				},
+				{
+					Id:       "anotherPasswordID",
+					Name:     "anotherName",
+					Login:    "anotherLogin",
+					Password: "anotherPassword",
+					Meta:     "anotherMeta",
+					UpdateAt: timestamppb.New(suite.testUpdateAt),
+				},
+			},
+		}, res)
+	})
+
+	suite.Run("truncated at list limit", func() {
+		handler := NewGophKeeperHandler(
+			suite.storageMock,
+			suite.hasherMock,
+			suite.tokenerMock,
+			suite.fileStoreMock,
+			*storage.NewRetryPolicy(3, 5, 3),
+			75,
+			"testAdminToken",
+			false,
+			2,
+		)
+
+		passwords := make([]storage.Password, 3)
+
+		for i := range passwords {
+			passwords[i] = storage.Password{
+				ID:       fmt.Sprintf("PasswordID%d", i),
+				Name:     suite.testName,
+				Login:    suite.testLogin,
+				Password: suite.testPassword,
+				Meta:     suite.testMeta,
+				UpdateAt: suite.testUpdateAt,
+			}
+		}
+
+		suite.storageMock.onGetAllPassword(suite.testUserID, passwords, nil)
+
+		res, err := handler.GetPasswords(suite.testIncomingContext, &proto.GetPasswordsRequest{})
+		require.NoError(err)
+		require.True(res.GetTruncated())
+		require.Len(res.GetPasswords(), 2)
+		require.Equal("PasswordID0", res.GetPasswords()[0].GetId())
+		require.Equal("PasswordID1", res.GetPasswords()[1].GetId())
+	})
+}
+
+func (suite *HandlersTestSuite) TestGetPasswordsByCategory() {
+	require := suite.Require()
+
+	testCategory := "testCategory"
+
+	suite.Run("unauthenticated", func() {
+		res, err := suite.handler.GetPasswordsByCategory(context.Background(), &proto.GetPasswordsByCategoryRequest{Category: testCategory})
+		require.Error(err)
+		require.Equal(status.Code(err), codes.Unauthenticated)
+		require.Nil(res)
+	})
+
+	suite.Run("database error", func() {
+		suite.storageMock.onGetPasswordsByCategory(suite.testUserID, testCategory, nil, testError)
+
+		res, err := suite.handler.GetPasswordsByCategory(suite.testIncomingContext, &proto.GetPasswordsByCategoryRequest{Category: testCategory})
+		require.ErrorContains(err, testError.Error())
+		require.Equal(status.Code(err), codes.Internal)
+		require.Nil(res)
+	})
+
+	suite.Run("unknown UserID error", func() {
+		suite.storageMock.onGetPasswordsByCategory(suite.testUserID, testCategory, nil, storage.ErrUserNotFound)
+
+		res, err := suite.handler.GetPasswordsByCategory(suite.testIncomingContext, &proto.GetPasswordsByCategoryRequest{Category: testCategory})
+		require.ErrorContains(err, fmt.Sprintf("unknown UserID %s", suite.testUserID))
+		require.Equal(status.Code(err), codes.Unknown)
+		require.Nil(res)
+	})
+
+	suite.Run("positive test", func() {
+		passwords := []storage.Password{
+			{
+				ID:       suite.testPasswordID,
+				Name:     suite.testName,
+				Login:    suite.testLogin,
+				Password: suite.testPassword,
+				Meta:     suite.testMeta,
+				UpdateAt: suite.testUpdateAt,
+			},
+		}
+		suite.storageMock.onGetPasswordsByCategory(suite.testUserID, testCategory, passwords, nil)
+
+		res, err := suite.handler.GetPasswordsByCategory(suite.testIncomingContext, &proto.GetPasswordsByCategoryRequest{Category: testCategory})
+		require.NoError(err)
+		require.Equal(&proto.GetPasswordsByCategoryResponse{
+			Passwords: []*proto.Password{
+				{
+					Id:       suite.testPasswordID,
+					Name:     suite.testName,
+					Login:    suite.testLogin,
+					Password: suite.testPassword,
+					Meta:     suite.testMeta,
+					UpdateAt: timestamppb.New(suite.testUpdateAt),
+				},
+			},
+		}, res)
+	})
+}
+
+func (suite *HandlersTestSuite) TestDeletePassword() {
+	require := suite.Require()
+
+	positiveReq := &proto.DeletePasswordRequest{
+		Id: suite.testPasswordID,
+	}
+
+	suite.Run("unauthenticated", func() {
+		req := &proto.DeletePasswordRequest{
+			Id: "",
+		}
+
+		res, err := suite.handler.DeletePassword(context.Background(), req)
+		require.Error(err)
+		require.Equal(status.Code(err), codes.Unauthenticated)
+		require.Nil(res)
+	})
+
+	suite.Run("database error", func() {
+		suite.storageMock.onDeletePassword(suite.testPasswordID, suite.testUserID, testError)
+
+		res, err := suite.handler.DeletePassword(suite.testIncomingContext, positiveReq)
+		require.ErrorContains(err, testError.Error())
+		require.Equal(status.Code(err), codes.Internal)
+		require.Nil(res)
+	})
+
+	suite.Run("unknown UserID error", func() {
+		suite.storageMock.onDeletePassword(suite.testPasswordID, suite.testUserID, storage.ErrUserNotFound)
+
+		res, err := suite.handler.DeletePassword(suite.testIncomingContext, positiveReq)
+		require.ErrorContains(err, fmt.Sprintf("unknown UserID %s", suite.testUserID))
+		require.Equal(status.Code(err), codes.Unknown)
+		require.Nil(res)
+	})
+
+	suite.Run("unknown PasswordID error", func() {
+		suite.storageMock.onDeletePassword(suite.testPasswordID, suite.testUserID, storage.ErrPasswordNotFound)
+
+		res, err := suite.handler.DeletePassword(suite.testIncomingContext, positiveReq)
+		require.ErrorContains(err, fmt.Sprintf("unknown PasswordID %s", suite.testPasswordID))
+		require.Equal(status.Code(err), codes.Unknown)
+		require.Nil(res)
+	})
+
+	suite.Run("positive test", func() {
+		suite.storageMock.onDeletePassword(suite.testPasswordID, suite.testUserID, nil)
+
+		res, err := suite.handler.DeletePassword(suite.testIncomingContext, positiveReq)
+		suite.Require().NoError(err)
+		suite.Require().Nil(res)
+	})
+}
+
+func (suite *HandlersTestSuite) TestTouchPassword() {
+	require := suite.Require()
+
+	positiveReq := &proto.TouchPasswordRequest{
+		Id: suite.testPasswordID,
+	}
+
+	suite.Run("unauthenticated", func() {
+		req := &proto.TouchPasswordRequest{
+			Id: "",
+		}
+
+		res, err := suite.handler.TouchPassword(context.Background(), req)
+		require.Error(err)
+		require.Equal(status.Code(err), codes.Unauthenticated)
+		require.Nil(res)
+	})
+
+	suite.Run("database error", func() {
+		suite.storageMock.onTouchPassword(suite.testPasswordID, suite.testUserID, nil, testError)
+
+		res, err := suite.handler.TouchPassword(suite.testIncomingContext, positiveReq)
+		require.ErrorContains(err, testError.Error())
+		require.Equal(status.Code(err), codes.Internal)
+		require.Nil(res)
+	})
+
+	suite.Run("unknown PasswordID error", func() {
+		suite.storageMock.onTouchPassword(suite.testPasswordID, suite.testUserID, nil, storage.ErrPasswordNotFound)
+
+		res, err := suite.handler.TouchPassword(suite.testIncomingContext, positiveReq)
+		require.ErrorContains(err, fmt.Sprintf("unknown PasswordID %s", suite.testPasswordID))
+		require.Equal(status.Code(err), codes.Unknown)
+		require.Nil(res)
+	})
+
+	suite.Run("positive test", func() {
+		suite.storageMock.onTouchPassword(suite.testPasswordID, suite.testUserID, &storage.Password{
+			ID:       suite.testPasswordID,
+			UpdateAt: suite.testUpdateAt,
+		}, nil)
+
+		res, err := suite.handler.TouchPassword(suite.testIncomingContext, positiveReq)
+		require.NoError(err)
+		require.Equal(suite.testPasswordID, res.GetId())
+		require.Equal(suite.testUpdateAt.UTC(), res.GetUpdateAt().AsTime())
+	})
+}
+
+func (suite *HandlersTestSuite) TestGetReusedPasswords() {
+	require := suite.Require()
+
+	suite.Run("unauthenticated", func() {
+		res, err := suite.handler.GetReusedPasswords(context.Background(), &proto.GetReusedPasswordsRequest{})
+		require.Error(err)
+		require.Equal(status.Code(err), codes.Unauthenticated)
+		require.Nil(res)
+	})
+
+	suite.Run("database error", func() {
+		suite.storageMock.onGetReusedPasswords(suite.testUserID, nil, testError)
+
+		res, err := suite.handler.GetReusedPasswords(suite.testIncomingContext, &proto.GetReusedPasswordsRequest{})
+		require.ErrorContains(err, testError.Error())
+		require.Equal(status.Code(err), codes.Internal)
+		require.Nil(res)
+	})
+
+	suite.Run("positive test", func() {
+		suite.storageMock.onGetReusedPasswords(suite.testUserID, []storage.ReusedPasswords{
+			{
+				Fingerprint: "testFingerprint",
+				IDs:         []string{suite.testPasswordID, "testPasswordID2"},
+			},
+		}, nil)
+
+		res, err := suite.handler.GetReusedPasswords(suite.testIncomingContext, &proto.GetReusedPasswordsRequest{})
+		require.NoError(err)
+		require.Len(res.GetReused(), 1)
+		require.Equal("testFingerprint", res.GetReused()[0].GetFingerprint())
+		require.Equal([]string{suite.testPasswordID, "testPasswordID2"}, res.GetReused()[0].GetIds())
+	})
+}
+
+func (suite *HandlersTestSuite) TestCreateBank() {
+	require := suite.Require()
+
+	positiveReq := &proto.CreateBankRequest{
+		Name:       suite.testName,
+		CardNumber: suite.testCardNumber,
+		Cvc:        suite.testCvc,
+		Owner:      suite.testOwner,
+		Exp:        suite.testExp,
+		Meta:       suite.testMeta,
+	}
+
+	suite.Run("unauthenticated", func() {
+		req := &proto.CreateBankRequest{
+			Name:       "",
+			CardNumber: "",
+			Cvc:        "",
+			Owner:      "",
+			Exp:        "",
+			Meta:       "",
+		}
+
+		res, err := suite.handler.CreateBank(context.Background(), req)
+		require.Error(err)
+		require.Equal(status.Code(err), codes.Unauthenticated)
+		require.Nil(res)
+	})
+
+	suite.Run("database error", func() {
+		suite.storageMock.onCreateBank(suite.testUserID, suite.testName, suite.testCardNumber, suite.testCvc, suite.testOwner, suite.testExp, suite.testMeta, false, false, nil, testError)
+
+		res, err := suite.handler.CreateBank(suite.testIncomingContext, positiveReq)
+		require.ErrorContains(err, testError.Error())
+		require.Equal(status.Code(err), codes.Internal)
+		require.Nil(res)
+	})
+
+	suite.Run("unknown UserID error", func() {
+		suite.storageMock.onCreateBank(suite.testUserID, suite.testName, suite.testCardNumber, suite.testCvc, suite.testOwner, suite.testExp, suite.testMeta, false, false, nil, storage.ErrUserNotFound)
+
+		res, err := suite.handler.CreateBank(suite.testIncomingContext, positiveReq)
+		require.ErrorContains(err, fmt.Sprintf("unknown UserID %s", suite.testUserID))
+		require.Equal(status.Code(err), codes.Unknown)
+		require.Nil(res)
+	})
+
+	suite.Run("positive test", func() {
+		suite.storageMock.onCreateBank(suite.testUserID, suite.testName, suite.testCardNumber, suite.testCvc, suite.testOwner, suite.testExp, suite.testMeta, false, false, &storage.Bank{ID: suite.testBankID}, nil)
+
+		res, err := suite.handler.CreateBank(suite.testIncomingContext, positiveReq)
+		suite.Require().NoError(err)
+		suite.Require().Equal(suite.testBankID, res.GetId())
+	})
+}
+
+func (suite *HandlersTestSuite) TestUpdateBank() {
+	require := suite.Require()
+
+	positiveReq := &proto.UpdateBankRequest{
+		Id:         suite.testBankID,
+		Name:       suite.testName,
+		CardNumber: suite.testCardNumber,
+		Cvc:        suite.testCvc,
+		Owner:      suite.testOwner,
+		Exp:        suite.testExp,
+		Meta:       suite.testMeta,
+	}
+
+	suite.Run("unauthenticated", func() {
+		req := &proto.UpdateBankRequest{
+			Id:         "",
+			Name:       "",
+			CardNumber: "",
+			Cvc:        "",
+			Owner:      "",
+			Exp:        "",
+			Meta:       "",
+		}
+
+		res, err := suite.handler.UpdateBank(context.Background(), req)
+		require.Error(err)
+		require.Equal(status.Code(err), codes.Unauthenticated)
+		require.Nil(res)
+	})
+
+	suite.Run("database error", func() {
+		suite.storageMock.onUpdateBank(suite.testBankID, suite.testUserID, suite.testName, suite.testCardNumber, suite.testCvc, suite.testOwner, suite.testExp, suite.testMeta, false, false, nil, testError)
+
+		res, err := suite.handler.UpdateBank(suite.testIncomingContext, positiveReq)
+		require.ErrorContains(err, testError.Error())
+		require.Equal(status.Code(err), codes.Internal)
+		require.Nil(res)
+	})
+
+	suite.Run("unknown UserID error", func() {
+		suite.storageMock.onUpdateBank(suite.testBankID, suite.testUserID, suite.testName, suite.testCardNumber, suite.testCvc, suite.testOwner, suite.testExp, suite.testMeta, false, false, nil, storage.ErrUserNotFound)
+
+		res, err := suite.handler.UpdateBank(suite.testIncomingContext, positiveReq)
+		require.ErrorContains(err, fmt.Sprintf("unknown UserID %s", suite.testUserID))
+		require.Equal(status.Code(err), codes.Unknown)
+		require.Nil(res)
+	})
+
+	suite.Run("unknown BankID error", func() {
+		suite.storageMock.onUpdateBank(suite.testBankID, suite.testUserID, suite.testName, suite.testCardNumber, suite.testCvc, suite.testOwner, suite.testExp, suite.testMeta, false, false, nil, storage.ErrBankNotFound)
+
+		res, err := suite.handler.UpdateBank(suite.testIncomingContext, positiveReq)
+		require.ErrorContains(err, fmt.Sprintf("unknown BankID %s", suite.testBankID))
+		require.Equal(status.Code(err), codes.Unknown)
+		require.Nil(res)
+	})
+
+	suite.Run("positive test", func() {
+		suite.storageMock.onUpdateBank(suite.testBankID, suite.testUserID, suite.testName, suite.testCardNumber, suite.testCvc, suite.testOwner, suite.testExp, suite.testMeta, false, false, &storage.Bank{ID: suite.testBankID}, nil)
+
+		res, err := suite.handler.UpdateBank(suite.testIncomingContext, positiveReq)
+		suite.Require().NoError(err)
+		suite.Require().Equal(suite.testBankID, res.GetId())
+	})
+}
+
+func (suite *HandlersTestSuite) TestGetBank() {
+	require := suite.Require()
+
+	positiveReq := &proto.GetBankRequest{
+		Id: suite.testBankID,
+	}
+
+	suite.Run("unauthenticated", func() {
+		res, err := suite.handler.GetBank(context.Background(), positiveReq)
+		require.Error(err)
+		require.Equal(status.Code(err), codes.Unauthenticated)
+		require.Nil(res)
+	})
+
+	suite.Run("empty BankID", func() {
+		req := &proto.GetBankRequest{
+			Id: "",
+		}
+
+		res, err := suite.handler.GetBank(suite.testIncomingContext, req)
+		require.ErrorContains(err, "empty BankID")
+		require.Equal(status.Code(err), codes.InvalidArgument)
+		require.Nil(res)
+	})
+
+	suite.Run("database error", func() {
+		suite.storageMock.onGetBank(suite.testBankID, suite.testUserID, nil, testError)
+
+		res, err := suite.handler.GetBank(suite.testIncomingContext, positiveReq)
+		require.ErrorContains(err, testError.Error())
+		require.Equal(status.Code(err), codes.Internal)
+		require.Nil(res)
+	})
+
+	suite.Run("unknown BankID error", func() {
+		suite.storageMock.onGetBank(suite.testBankID, suite.testUserID, nil, storage.ErrBankNotFound)
+
+		res, err := suite.handler.GetBank(suite.testIncomingContext, positiveReq)
+		require.ErrorContains(err, fmt.Sprintf("unknown BankID %s", suite.testBankID))
+		require.Equal(status.Code(err), codes.Unknown)
+		require.Nil(res)
+	})
+
+	suite.Run("positive test", func() {
+		suite.storageMock.onGetBank(suite.testBankID, suite.testUserID, &storage.Bank{
+			ID:         suite.testBankID,
+			Name:       suite.testName,
+			CardNumber: suite.testCardNumber,
+			CVC:        suite.testCvc,
+			Owner:      suite.testOwner,
+			Exp:        suite.testExp,
+			Meta:       suite.testMeta,
+			UpdateAt:   suite.testUpdateAt,
+		}, nil)
+
+		res, err := suite.handler.GetBank(suite.testIncomingContext, positiveReq)
+		suite.Require().NoError(err)
+		suite.Require().Equal(&proto.GetBankResponse{
+			Bank: &proto.Bank{
+				Id:         suite.testBankID,
+				Name:       suite.testName,
+				CardNumber: suite.testCardNumber,
+				Cvc:        suite.testCvc,
+				Owner:      suite.testOwner,
+				Exp:        suite.testExp,
+				Meta:       suite.testMeta,
+				UpdateAt:   timestamppb.New(suite.testUpdateAt),
+			},
+		}, res)
+	})
+}
+
+func (suite *HandlersTestSuite) TestGetBanks() {
+	require := suite.Require()
+
+	suite.Run("unauthenticated", func() {
+		res, err := suite.handler.GetBanks(context.Background(), &proto.GetBanksRequest{})
+		require.Error(err)
+		require.Equal(status.Code(err), codes.Unauthenticated)
+		require.Nil(res)
+	})
+
+	suite.Run("database error", func() {
+		suite.storageMock.onGetAllBanks(suite.testUserID, nil, testError)
+
+		res, err := suite.handler.GetBanks(suite.testIncomingContext, &proto.GetBanksRequest{})
+		require.ErrorContains(err, testError.Error())
+		require.Equal(status.Code(err), codes.Internal)
+		require.Nil(res)
+	})
+
+	suite.Run("unknown UserID error", func() {
+		suite.storageMock.onGetAllBanks(suite.testUserID, nil, storage.ErrUserNotFound)
+
+		res, err := suite.handler.GetBanks(suite.testIncomingContext, &proto.GetBanksRequest{})
+		require.ErrorContains(err, fmt.Sprintf("unknown UserID %s", suite.testUserID))
+		require.Equal(status.Code(err), codes.Unknown)
+		require.Nil(res)
+	})
+
+	suite.Run("positive test", func() {
+		banks := []storage.Bank{
+			{
+				ID:         suite.testBankID,
+				Name:       suite.testName,
+				CardNumber: suite.testCardNumber,
+				CVC:        suite.testCvc,
+				Owner:      suite.testOwner,
+				Exp:        suite.testExp,
+				Meta:       suite.testMeta,
+				UpdateAt:   suite.testUpdateAt,
+			},
+			{
+				ID:         "anotherBankID",
+				Name:       "anotherName",
+				CardNumber: "anotherCardNumber",
+				CVC:        "anotherCvc",
+				Owner:      "anotherOwner",
+				Exp:        "anotherExp",
+				Meta:       "anotherMeta",
+				UpdateAt:   suite.testUpdateAt,
+			},
+		}
+		suite.storageMock.onGetAllBanks(suite.testUserID, banks, nil)
+
+		res, err := suite.handler.GetBanks(suite.testIncomingContext, &proto.GetBanksRequest{})
+		suite.Require().NoError(err)
+		suite.Require().Equal(&proto.GetBanksResponse{
+			Banks: []*proto.Bank{
+				{
+					Id:         suite.testBankID,
+					Name:       suite.testName,
+					CardNumber: suite.testCardNumber,
+					Cvc:        suite.testCvc,
+					Owner:      suite.testOwner,
+					Exp:        suite.testExp,
+					Meta:       suite.testMeta,
+					UpdateAt:   timestamppb.New(suite.testUpdateAt),
+				},
+				{
+					Id:         "anotherBankID",
+					Name:       "anotherName",
+					CardNumber: "anotherCardNumber",
+					Cvc:        "anotherCvc",
+					Owner:      "anotherOwner",
+					Exp:        "anotherExp",
+					Meta:       "anotherMeta",
+					UpdateAt:   timestamppb.New(suite.testUpdateAt),
+				},
+			},
+		}, res)
+	})
+}
+
+func (suite *HandlersTestSuite) TestDeleteBank() {
+	require := suite.Require()
+
+	positiveReq := &proto.DeleteBankRequest{
+		Id: suite.testBankID,
+	}
+
+	suite.Run("unauthenticated", func() {
+		req := &proto.DeleteBankRequest{
+			Id: "",
+		}
+
+		res, err := suite.handler.DeleteBank(context.Background(), req)
+		require.Error(err)
+		require.Equal(status.Code(err), codes.Unauthenticated)
+		require.Nil(res)
+	})
+
+	suite.Run("database error", func() {
+		suite.storageMock.onDeleteBank(suite.testBankID, suite.testUserID, testError)
+
+		res, err := suite.handler.DeleteBank(suite.testIncomingContext, positiveReq)
+		require.ErrorContains(err, testError.Error())
+		require.Equal(status.Code(err), codes.Internal)
+		require.Nil(res)
+	})
+
+	suite.Run("unknown UserID error", func() {
+		suite.storageMock.onDeleteBank(suite.testBankID, suite.testUserID, storage.ErrUserNotFound)
+
+		res, err := suite.handler.DeleteBank(suite.testIncomingContext, positiveReq)
+		require.ErrorContains(err, fmt.Sprintf("unknown UserID %s", suite.testUserID))
+		require.Equal(status.Code(err), codes.Unknown)
+		require.Nil(res)
+	})
+
+	suite.Run("unknown BankID error", func() {
+		suite.storageMock.onDeleteBank(suite.testBankID, suite.testUserID, storage.ErrBankNotFound)
+
+		res, err := suite.handler.DeleteBank(suite.testIncomingContext, positiveReq)
+		require.ErrorContains(err, fmt.Sprintf("unknown BankID %s", suite.testBankID))
+		require.Equal(status.Code(err), codes.Unknown)
+		require.Nil(res)
+	})
+
+	suite.Run("positive test", func() {
+		suite.storageMock.onDeleteBank(suite.testBankID, suite.testUserID, nil)
+
+		res, err := suite.handler.DeleteBank(suite.testIncomingContext, positiveReq)
+		suite.Require().NoError(err)
+		suite.Require().Nil(res)
+	})
+}
+
+func (suite *HandlersTestSuite) TestCreateText() {
+	require := suite.Require()
+
+	positiveReq := &proto.CreateTextRequest{
+		Name: suite.testName,
+		Text: suite.testText,
+		Meta: suite.testMeta,
+	}
+
+	suite.Run("unauthenticated", func() {
+		req := &proto.CreateTextRequest{
+			Name: "",
+			Text: "",
+			Meta: "",
+		}
+
+		res, err := suite.handler.CreateText(context.Background(), req)
+		require.Error(err)
+		require.Equal(status.Code(err), codes.Unauthenticated)
+		require.Nil(res)
+	})
+
+	suite.Run("database error", func() {
+		suite.storageMock.onCreateText(suite.testUserID, suite.testName, suite.testText, suite.testMeta, false, false, nil, testError)
+
+		res, err := suite.handler.CreateText(suite.testIncomingContext, positiveReq)
+		require.ErrorContains(err, testError.Error())
+		require.Equal(status.Code(err), codes.Internal)
+		require.Nil(res)
+	})
+
+	suite.Run("unknown UserID error", func() {
+		suite.storageMock.onCreateText(suite.testUserID, suite.testName, suite.testText, suite.testMeta, false, false, nil, storage.ErrUserNotFound)
+
+		res, err := suite.handler.CreateText(suite.testIncomingContext, positiveReq)
+		require.ErrorContains(err, fmt.Sprintf("unknown UserID %s", suite.testUserID))
+		require.Equal(status.Code(err), codes.Unknown)
+		require.Nil(res)
+	})
+
+	suite.Run("positive test", func() {
+		suite.storageMock.onCreateText(suite.testUserID, suite.testName, suite.testText, suite.testMeta, false, false, &storage.Text{ID: suite.testTextID}, nil)
+
+		res, err := suite.handler.CreateText(suite.testIncomingContext, positiveReq)
+		suite.Require().NoError(err)
+		suite.Require().Equal(suite.testTextID, res.GetId())
+	})
+}
+
+func (suite *HandlersTestSuite) TestUpdateText() {
+	require := suite.Require()
+
+	positiveReq := &proto.UpdateTextRequest{
+		Id:   suite.testTextID,
+		Name: suite.testName,
+		Text: suite.testText,
+		Meta: suite.testMeta,
+	}
+
+	suite.Run("unauthenticated", func() {
+		req := &proto.UpdateTextRequest{
+			Id:   "",
+			Name: "",
+			Text: "",
+			Meta: "",
+		}
+
+		res, err := suite.handler.UpdateText(context.Background(), req)
+		require.Error(err)
+		require.Equal(status.Code(err), codes.Unauthenticated)
+		require.Nil(res)
+	})
+
+	suite.Run("database error", func() {
+		suite.storageMock.onUpdateText(suite.testTextID, suite.testUserID, suite.testName, suite.testText, suite.testMeta, false, false, nil, testError)
+
+		res, err := suite.handler.UpdateText(suite.testIncomingContext, positiveReq)
+		require.ErrorContains(err, testError.Error())
+		require.Equal(status.Code(err), codes.Internal)
+		require.Nil(res)
+	})
+
+	suite.Run("unknown UserID error", func() {
+		suite.storageMock.onUpdateText(suite.testTextID, suite.testUserID, suite.testName, suite.testText, suite.testMeta, false, false, nil, storage.ErrUserNotFound)
+
+		res, err := suite.handler.UpdateText(suite.testIncomingContext, positiveReq)
+		require.ErrorContains(err, fmt.Sprintf("unknown UserID %s", suite.testUserID))
+		require.Equal(status.Code(err), codes.Unknown)
+		require.Nil(res)
+	})
+
+	suite.Run("unknown TextID error", func() {
+		suite.storageMock.onUpdateText(suite.testTextID, suite.testUserID, suite.testName, suite.testText, suite.testMeta, false, false, nil, storage.ErrTextNotFound)
+
+		res, err := suite.handler.UpdateText(suite.testIncomingContext, positiveReq)
+		require.ErrorContains(err, fmt.Sprintf("unknown TextID %s", suite.testTextID))
+		require.Equal(status.Code(err), codes.Unknown)
+		require.Nil(res)
+	})
+
+	suite.Run("positive test", func() {
+		suite.storageMock.onUpdateText(suite.testTextID, suite.testUserID, suite.testName, suite.testText, suite.testMeta, false, false, &storage.Text{ID: suite.testTextID}, nil)
+
+		res, err := suite.handler.UpdateText(suite.testIncomingContext, positiveReq)
+		suite.Require().NoError(err)
+		suite.Require().Equal(suite.testTextID, res.GetId())
+	})
+}
+
+func (suite *HandlersTestSuite) TestGetText() {
+	require := suite.Require()
+
+	positiveReq := &proto.GetTextRequest{
+		Id: suite.testTextID,
+	}
+
+	suite.Run("unauthenticated", func() {
+		res, err := suite.handler.GetText(context.Background(), positiveReq)
+		require.Error(err)
+		require.Equal(status.Code(err), codes.Unauthenticated)
+		require.Nil(res)
+	})
+
+	suite.Run("empty TextID", func() {
+		req := &proto.GetTextRequest{
+			Id: "",
+		}
+
+		res, err := suite.handler.GetText(suite.testIncomingContext, req)
+		require.ErrorContains(err, "empty TextID")
+		require.Equal(status.Code(err), codes.InvalidArgument)
+		require.Nil(res)
+	})
+
+	suite.Run("database error", func() {
+		suite.storageMock.onGetText(suite.testTextID, suite.testUserID, nil, testError)
+
+		res, err := suite.handler.GetText(suite.testIncomingContext, positiveReq)
+		require.ErrorContains(err, testError.Error())
+		require.Equal(status.Code(err), codes.Internal)
+		require.Nil(res)
+	})
+
+	suite.Run("unknown TextID error", func() {
+		suite.storageMock.onGetText(suite.testTextID, suite.testUserID, nil, storage.ErrTextNotFound)
+
+		res, err := suite.handler.GetText(suite.testIncomingContext, positiveReq)
+		require.ErrorContains(err, fmt.Sprintf("unknown TextID %s", suite.testTextID))
+		require.Equal(status.Code(err), codes.Unknown)
+		require.Nil(res)
+	})
+
+	suite.Run("positive test", func() {
+		suite.storageMock.onGetText(suite.testTextID, suite.testUserID, &storage.Text{
+			ID:       suite.testTextID,
+			Name:     suite.testName,
+			Text:     suite.testText,
+			Meta:     suite.testMeta,
+			UpdateAt: suite.testUpdateAt,
+		}, nil)
+
+		res, err := suite.handler.GetText(suite.testIncomingContext, positiveReq)
+		suite.Require().NoError(err)
+		suite.Require().Equal(&proto.GetTextResponse{
+			Text: &proto.Text{
+				Id:       suite.testTextID,
+				Name:     suite.testName,
+				Text:     suite.testText,
+				Meta:     suite.testMeta,
+				UpdateAt: timestamppb.New(suite.testUpdateAt),
+			},
+		}, res)
+	})
+}
+
+func (suite *HandlersTestSuite) TestGetTexts() {
+	require := suite.Require()
+
+	suite.Run("unauthenticated", func() {
+		res, err := suite.handler.GetTexts(context.Background(), &proto.GetTextsRequest{})
+		require.Error(err)
+		require.Equal(status.Code(err), codes.Unauthenticated)
+		require.Nil(res)
+	})
+
+	suite.Run("database error", func() {
+		suite.storageMock.onGetAllTexts(suite.testUserID, "", "", 0, 0, nil, testError)
+
+		res, err := suite.handler.GetTexts(suite.testIncomingContext, &proto.GetTextsRequest{})
+		require.ErrorContains(err, testError.Error())
+		require.Equal(status.Code(err), codes.Internal)
+		require.Nil(res)
+	})
+
+	suite.Run("unknown UserID error", func() {
+		suite.storageMock.onGetAllTexts(suite.testUserID, "", "", 0, 0, nil, storage.ErrUserNotFound)
+
+		res, err := suite.handler.GetTexts(suite.testIncomingContext, &proto.GetTextsRequest{})
+		require.ErrorContains(err, fmt.Sprintf("unknown UserID %s", suite.testUserID))
+		require.Equal(status.Code(err), codes.Unknown)
+		require.Nil(res)
+	})
+
+	suite.Run("positive test", func() {
+		texts := []storage.Text{
+			{
+				ID:       suite.testTextID,
+				Name:     suite.testName,
+				Text:     suite.testText,
+				Meta:     suite.testMeta,
+				UpdateAt: suite.testUpdateAt,
+			},
+			{
+				ID:       "anotherTextID",
+				Name:     "anotherName",
+				Text:     "anotherText",
+				Meta:     "anotherMeta",
+				UpdateAt: suite.testUpdateAt,
+			},
+		}
+		suite.storageMock.onGetAllTexts(suite.testUserID, "", "", 0, 0, texts, nil)
+
+		res, err := suite.handler.GetTexts(suite.testIncomingContext, &proto.GetTextsRequest{})
+		suite.Require().NoError(err)
+		suite.Require().Equal(&proto.GetTextsResponse{
+			Texts: []*proto.Text{
+				{
+					Id:       suite.testTextID,
+					Name:     suite.testName,
+					Text:     suite.testText,
+					Meta:     suite.testMeta,
+					UpdateAt: timestamppb.New(suite.testUpdateAt),
+				},
+				{
+					Id:       "anotherTextID",
+					Name:     "anotherName",
+					Text:     "anotherText",
+					Meta:     "anotherMeta",
+					UpdateAt: timestamppb.New(suite.testUpdateAt),
+				},
+			},
+		}, res)
+	})
+}
+
+func (suite *HandlersTestSuite) TestGetRecentActivity() {
+	require := suite.Require()
+
+	suite.Run("unauthenticated", func() {
+		res, err := suite.handler.GetRecentActivity(context.Background(), &proto.GetRecentActivityRequest{})
+		require.Error(err)
+		require.Equal(status.Code(err), codes.Unauthenticated)
+		require.Nil(res)
+	})
+
+	suite.Run("database error", func() {
+		suite.storageMock.onGetRecentActivity(suite.testUserID, defaultActivityLimit, nil, testError)
+
+		res, err := suite.handler.GetRecentActivity(suite.testIncomingContext, &proto.GetRecentActivityRequest{})
+		require.ErrorContains(err, testError.Error())
+		require.Equal(status.Code(err), codes.Internal)
+		require.Nil(res)
+	})
+
+	suite.Run("unknown UserID error", func() {
+		suite.storageMock.onGetRecentActivity(suite.testUserID, defaultActivityLimit, nil, storage.ErrUserNotFound)
+
+		res, err := suite.handler.GetRecentActivity(suite.testIncomingContext, &proto.GetRecentActivityRequest{})
+		require.ErrorContains(err, fmt.Sprintf("unknown UserID %s", suite.testUserID))
+		require.Equal(status.Code(err), codes.Unknown)
+		require.Nil(res)
+	})
+
+	suite.Run("positive test", func() {
+		activities := []storage.Activity{
+			{
+				ID:       suite.testTextID,
+				Kind:     "text",
+				Name:     suite.testName,
+				UpdateAt: suite.testUpdateAt,
+			},
+			{
+				ID:       suite.testPasswordID,
+				Kind:     "password",
+				Name:     suite.testName,
+				UpdateAt: suite.testUpdateAt.Add(-time.Hour),
+			},
+		}
+
+		suite.storageMock.onGetRecentActivity(suite.testUserID, 2, activities, nil)
+
+		res, err := suite.handler.GetRecentActivity(suite.testIncomingContext, &proto.GetRecentActivityRequest{Limit: 2})
+		require.NoError(err)
+		require.Len(res.GetActivities(), 2)
+		require.Equal(suite.testTextID, res.GetActivities()[0].GetId())
+		require.Equal("text", res.GetActivities()[0].GetKind())
+		require.Equal(suite.testName, res.GetActivities()[0].GetName())
+		require.Equal(suite.testPasswordID, res.GetActivities()[1].GetId())
+		require.Equal("password", res.GetActivities()[1].GetKind())
+	})
+}
+
+func (suite *HandlersTestSuite) TestGetFavorites() {
+	require := suite.Require()
+
+	suite.Run("unauthenticated", func() {
+		res, err := suite.handler.GetFavorites(context.Background(), &proto.GetFavoritesRequest{})
+		require.Error(err)
+		require.Equal(status.Code(err), codes.Unauthenticated)
+		require.Nil(res)
+	})
+
+	suite.Run("database error", func() {
+		suite.storageMock.onGetFavorites(suite.testUserID, nil, testError)
+
+		res, err := suite.handler.GetFavorites(suite.testIncomingContext, &proto.GetFavoritesRequest{})
+		require.ErrorContains(err, testError.Error())
+		require.Equal(status.Code(err), codes.Internal)
+		require.Nil(res)
+	})
+
+	suite.Run("positive test", func() {
+		favorites := &storage.Favorites{
+			Passwords: []storage.Password{
+				{
+					ID:       suite.testPasswordID,
+					Name:     suite.testName,
+					Login:    suite.testLogin,
+					Password: suite.testPassword,
+					Meta:     suite.testMeta,
+					Pinned:   true,
+					UpdateAt: suite.testUpdateAt,
+				},
+			},
+			Texts: []storage.Text{
+				{
+					ID:       suite.testTextID,
+					Name:     suite.testName,
+					Text:     suite.testText,
+					Meta:     suite.testMeta,
+					Pinned:   true,
+					UpdateAt: suite.testUpdateAt,
+				},
+			},
+		}
+
+		suite.storageMock.onGetFavorites(suite.testUserID, favorites, nil)
+
+		res, err := suite.handler.GetFavorites(suite.testIncomingContext, &proto.GetFavoritesRequest{})
+		require.NoError(err)
+		require.Len(res.GetPasswords(), 1)
+		require.Equal(suite.testPasswordID, res.GetPasswords()[0].GetId())
+		require.Equal(suite.testName, res.GetPasswords()[0].GetName())
+		require.Equal(suite.testLogin, res.GetPasswords()[0].GetLogin())
+		require.True(res.GetPasswords()[0].GetPinned())
+		require.Empty(res.GetFileInfo())
+		require.Empty(res.GetBanks())
+		require.Len(res.GetTexts(), 1)
+		require.Equal(suite.testTextID, res.GetTexts()[0].GetId())
+		require.Equal(suite.testText, res.GetTexts()[0].GetText())
+		require.True(res.GetTexts()[0].GetPinned())
+	})
+}
+
+func (suite *HandlersTestSuite) TestListEntryIDs() {
+	require := suite.Require()
+
+	req := &proto.ListEntryIDsRequest{Kind: "text"}
+
+	suite.Run("unauthenticated", func() {
+		res, err := suite.handler.ListEntryIDs(context.Background(), req)
+		require.Error(err)
+		require.Equal(status.Code(err), codes.Unauthenticated)
+		require.Nil(res)
+	})
+
+	suite.Run("unknown entry kind", func() {
+		suite.storageMock.onGetEntryIDs(suite.testUserID, "wrongKind", nil, storage.ErrUnknownEntryKind)
+
+		res, err := suite.handler.ListEntryIDs(suite.testIncomingContext, &proto.ListEntryIDsRequest{Kind: "wrongKind"})
+		require.ErrorContains(err, "unknown entry kind wrongKind")
+		require.Equal(status.Code(err), codes.InvalidArgument)
+		require.Nil(res)
+	})
+
+	suite.Run("database error", func() {
+		suite.storageMock.onGetEntryIDs(suite.testUserID, "text", nil, testError)
+
+		res, err := suite.handler.ListEntryIDs(suite.testIncomingContext, req)
+		require.ErrorContains(err, testError.Error())
+		require.Equal(status.Code(err), codes.Internal)
+		require.Nil(res)
+	})
+
+	suite.Run("unknown UserID error", func() {
+		suite.storageMock.onGetEntryIDs(suite.testUserID, "text", nil, storage.ErrUserNotFound)
+
+		res, err := suite.handler.ListEntryIDs(suite.testIncomingContext, req)
+		require.ErrorContains(err, fmt.Sprintf("unknown UserID %s", suite.testUserID))
+		require.Equal(status.Code(err), codes.Unknown)
+		require.Nil(res)
+	})
+
+	suite.Run("positive test", func() {
+		entries := []storage.EntryID{
+			{
+				ID:       suite.testTextID,
+				UpdateAt: suite.testUpdateAt,
+			},
+		}
+
+		suite.storageMock.onGetEntryIDs(suite.testUserID, "text", entries, nil)
+
+		res, err := suite.handler.ListEntryIDs(suite.testIncomingContext, req)
+		require.NoError(err)
+		require.Len(res.GetEntries(), 1)
+		require.Equal(suite.testTextID, res.GetEntries()[0].GetId())
+		require.Equal(suite.testUpdateAt.UTC(), res.GetEntries()[0].GetUpdateAt().AsTime())
+	})
+}
+
+func (suite *HandlersTestSuite) TestGetVaultManifest() {
+	require := suite.Require()
+
+	req := &proto.GetVaultManifestRequest{}
+
+	suite.Run("unauthenticated", func() {
+		res, err := suite.handler.GetVaultManifest(context.Background(), req)
+		require.Error(err)
+		require.Equal(status.Code(err), codes.Unauthenticated)
+		require.Nil(res)
+	})
+
+	suite.Run("database error", func() {
+		suite.storageMock.onGetVaultManifest(suite.testUserID, 0, 0, nil, testError)
+
+		res, err := suite.handler.GetVaultManifest(suite.testIncomingContext, req)
+		require.ErrorContains(err, testError.Error())
+		require.Equal(status.Code(err), codes.Internal)
+		require.Nil(res)
+	})
+
+	suite.Run("unknown UserID error", func() {
+		suite.storageMock.onGetVaultManifest(suite.testUserID, 0, 0, nil, storage.ErrUserNotFound)
+
+		res, err := suite.handler.GetVaultManifest(suite.testIncomingContext, req)
+		require.ErrorContains(err, fmt.Sprintf("unknown UserID %s", suite.testUserID))
+		require.Equal(status.Code(err), codes.Unknown)
+		require.Nil(res)
+	})
+
+	suite.Run("positive test", func() {
+		entries := []storage.ManifestEntry{
+			{
+				ID:       suite.testPasswordID,
+				Kind:     "password",
+				UpdateAt: suite.testUpdateAt,
+			},
+			{
+				ID:       suite.testTextID,
+				Kind:     "text",
+				UpdateAt: suite.testUpdateAt,
+			},
+		}
+
+		suite.storageMock.onGetVaultManifest(suite.testUserID, 10, 5, entries, nil)
+
+		res, err := suite.handler.GetVaultManifest(suite.testIncomingContext, &proto.GetVaultManifestRequest{Limit: 10, Offset: 5})
+		require.NoError(err)
+		require.Len(res.GetEntries(), 2)
+		require.Equal(suite.testPasswordID, res.GetEntries()[0].GetId())
+		require.Equal("password", res.GetEntries()[0].GetKind())
+		require.Equal(suite.testTextID, res.GetEntries()[1].GetId())
+		require.Equal("text", res.GetEntries()[1].GetKind())
+		require.Equal(suite.testUpdateAt.UTC(), res.GetEntries()[0].GetUpdateAt().AsTime())
+	})
+}
+
+func (suite *HandlersTestSuite) TestListSessions() {
+	require := suite.Require()
+
+	req := &proto.ListSessionsRequest{}
+
+	suite.Run("unauthenticated", func() {
+		res, err := suite.handler.ListSessions(context.Background(), req)
+		require.Error(err)
+		require.Equal(status.Code(err), codes.Unauthenticated)
+		require.Nil(res)
+	})
+
+	suite.Run("database error", func() {
+		suite.storageMock.onListSessions(suite.testUserID, nil, testError)
+
+		res, err := suite.handler.ListSessions(suite.testIncomingContext, req)
+		require.ErrorContains(err, testError.Error())
+		require.Equal(status.Code(err), codes.Internal)
+		require.Nil(res)
+	})
+
+	suite.Run("positive test", func() {
+		sessions := []storage.Session{
+			{
+				DeviceID:    "testDevice1",
+				LastSeen:    suite.testUpdateAt,
+				IP:          "192.0.2.1",
+				TokenMarker: suite.testUpdateAt,
+			},
+			{
+				DeviceID:    "testDevice2",
+				LastSeen:    suite.testUpdateAt,
+				IP:          "192.0.2.2",
+				TokenMarker: time.Unix(0, 0),
+			},
+		}
+
+		suite.storageMock.onListSessions(suite.testUserID, sessions, nil)
+
+		res, err := suite.handler.ListSessions(suite.testIncomingContext, req)
+		require.NoError(err)
+		require.Len(res.GetSessions(), 2)
+		require.Equal("testDevice1", res.GetSessions()[0].GetDeviceId())
+		require.Equal("192.0.2.1", res.GetSessions()[0].GetIp())
+		require.Equal(suite.testUpdateAt.UTC(), res.GetSessions()[0].GetLastSeen().AsTime())
+		require.True(res.GetSessions()[0].GetRevoked())
+		require.Equal("testDevice2", res.GetSessions()[1].GetDeviceId())
+		require.False(res.GetSessions()[1].GetRevoked())
+	})
+}
+
+func (suite *HandlersTestSuite) TestRevokeSession() {
+	require := suite.Require()
+
+	positiveReq := &proto.RevokeSessionRequest{
+		DeviceId: "testDevice",
+	}
+
+	suite.Run("unauthenticated", func() {
+		res, err := suite.handler.RevokeSession(context.Background(), positiveReq)
+		require.Error(err)
+		require.Equal(status.Code(err), codes.Unauthenticated)
+		require.Nil(res)
+	})
+
+	suite.Run("database error", func() {
+		suite.storageMock.onRevokeSession(suite.testUserID, "testDevice", testError)
+
+		res, err := suite.handler.RevokeSession(suite.testIncomingContext, positiveReq)
+		require.ErrorContains(err, testError.Error())
+		require.Equal(status.Code(err), codes.Internal)
+		require.Nil(res)
+	})
+
+	suite.Run("unknown DeviceID error", func() {
+		suite.storageMock.onRevokeSession(suite.testUserID, "testDevice", storage.ErrSessionNotFound)
+
+		res, err := suite.handler.RevokeSession(suite.testIncomingContext, positiveReq)
+		require.ErrorContains(err, "unknown DeviceID testDevice")
+		require.Equal(status.Code(err), codes.Unknown)
+		require.Nil(res)
+	})
+
+	suite.Run("positive test", func() {
+		suite.storageMock.onRevokeSession(suite.testUserID, "testDevice", nil)
+
+		res, err := suite.handler.RevokeSession(suite.testIncomingContext, positiveReq)
+		require.NoError(err)
+		require.Nil(res)
+	})
+}
+
+func (suite *HandlersTestSuite) TestDeleteText() {
+	require := suite.Require()
+
+	positiveReq := &proto.DeleteTextRequest{
+		Id: suite.testTextID,
+	}
+
+	suite.Run("unauthenticated", func() {
+		req := &proto.DeleteTextRequest{
+			Id: "",
+		}
+
+		res, err := suite.handler.DeleteText(context.Background(), req)
+		require.Error(err)
+		require.Equal(status.Code(err), codes.Unauthenticated)
+		require.Nil(res)
+	})
+
+	suite.Run("database error", func() {
+		suite.storageMock.onDeleteText(suite.testTextID, suite.testUserID, testError)
+
+		res, err := suite.handler.DeleteText(suite.testIncomingContext, positiveReq)
+		require.ErrorContains(err, testError.Error())
+		require.Equal(status.Code(err), codes.Internal)
+		require.Nil(res)
+	})
+
+	suite.Run("unknown UserID error", func() {
+		suite.storageMock.onDeleteText(suite.testTextID, suite.testUserID, storage.ErrUserNotFound)
+
+		res, err := suite.handler.DeleteText(suite.testIncomingContext, positiveReq)
+		require.ErrorContains(err, fmt.Sprintf("unknown UserID %s", suite.testUserID))
+		require.Equal(status.Code(err), codes.Unknown)
+		require.Nil(res)
+	})
+
+	suite.Run("unknown TextID error", func() {
+		suite.storageMock.onDeleteText(suite.testTextID, suite.testUserID, storage.ErrTextNotFound)
+
+		res, err := suite.handler.DeleteText(suite.testIncomingContext, positiveReq)
+		require.ErrorContains(err, fmt.Sprintf("unknown TextID %s", suite.testTextID))
+		require.Equal(status.Code(err), codes.Unknown)
+		require.Nil(res)
+	})
+
+	suite.Run("positive test", func() {
+		suite.storageMock.onDeleteText(suite.testTextID, suite.testUserID, nil)
+
+		res, err := suite.handler.DeleteText(suite.testIncomingContext, positiveReq)
+		suite.Require().NoError(err)
+		suite.Require().Nil(res)
+	})
+}
+
+func (suite *HandlersTestSuite) TestCreateFile() {
+	require := suite.Require()
+
+	fileInfo := &proto.File{
+		Name: suite.testName,
+		Meta: suite.testMeta,
+	}
+	positiveReq := []*proto.CreateFileRequest{
+		{
+			Data: &proto.CreateFileRequest_FileInfo{
+				FileInfo: fileInfo,
+			},
+		},
+		{
+			Data: &proto.CreateFileRequest_Content{
+				Content: suite.testBatch1,
+			},
+		},
+		{
+			Data: &proto.CreateFileRequest_Content{
+				Content: suite.testBatch2,
+			},
+		},
+	}
+
+	suite.Run("unauthenticated", func() {
+		suite.streamCreateFileMock.onContext(context.Background())
+
+		err := suite.handler.CreateFile(suite.streamCreateFileMock)
+		require.Error(err)
+		require.Equal(status.Code(err), codes.Unauthenticated)
+	})
+
+	suite.Run("cannot receive file info", func() {
+		suite.streamCreateFileMock.onContext(suite.testIncomingContext)
+		suite.streamCreateFileMock.onRecvWithOnce(nil, testError)
+
+		err := suite.handler.CreateFile(suite.streamCreateFileMock)
+		require.ErrorContains(err, "cannot receive file info")
+		require.Equal(status.Code(err), codes.Unknown)
+	})
+
+	suite.Run("create DB file error", func() {
+		suite.streamCreateFileMock.onContext(suite.testIncomingContext)
+		suite.streamCreateFileMock.onRecvWithOnce(positiveReq[0], nil)
+		suite.fileStoreMock.onCreateDBFile(mock.Anything, nil, testError)
+
+		err := suite.handler.CreateFile(suite.streamCreateFileMock)
+		require.Error(err)
+		require.Equal(status.Code(err), codes.Internal)
+	})
+
+	suite.Run("cannot receive content", func() {
+		suite.streamCreateFileMock.onContext(suite.testIncomingContext)
+		suite.streamCreateFileMock.onRecvWithOnce(positiveReq[0], nil)
+		suite.streamCreateFileMock.onRecvWithOnce(nil, testError)
+
+		dbfmo := new(DBFilerMockedObject)
+		dbfmo.onClose(nil)
+		defer dbfmo.AssertExpectations(suite.T())
+
+		suite.fileStoreMock.onCreateDBFile(mock.Anything, dbfmo, nil)
+
+		err := suite.handler.CreateFile(suite.streamCreateFileMock)
+		require.ErrorContains(err, "cannot receive content")
+		require.Equal(status.Code(err), codes.Unknown)
+	})
+
+	suite.Run("write chunk error", func() {
+		suite.streamCreateFileMock.onContext(suite.testIncomingContext)
+		suite.streamCreateFileMock.onRecvWithOnce(positiveReq[0], nil)
+		suite.streamCreateFileMock.onRecvWithOnce(positiveReq[1], nil)
+
+		dbfmo := new(DBFilerMockedObject)
+		dbfmo.onClose(nil)
+		dbfmo.onWriteOnce(suite.testBatch1, 0, testError)
+		defer dbfmo.AssertExpectations(suite.T())
+
+		suite.fileStoreMock.onCreateDBFile(mock.Anything, dbfmo, nil)
+
+		err := suite.handler.CreateFile(suite.streamCreateFileMock)
+		require.Error(err)
+		require.Equal(status.Code(err), codes.Internal)
+	})
+
+	suite.Run("empty file", func() {
+		suite.streamCreateFileMock.onContext(suite.testIncomingContext)
+		suite.streamCreateFileMock.onRecvWithOnce(positiveReq[0], nil)
+		suite.streamCreateFileMock.onRecvWithOnce(nil, io.EOF)
+
+		dbfmo := new(DBFilerMockedObject)
+		dbfmo.onClose(nil)
+		defer dbfmo.AssertExpectations(suite.T())
+
+		suite.fileStoreMock.onCreateDBFile(mock.Anything, dbfmo, nil)
+		suite.fileStoreMock.onDeleteDBFile(mock.Anything, nil)
+
+		err := suite.handler.CreateFile(suite.streamCreateFileMock)
+		require.ErrorContains(err, "empty file")
+		require.Equal(status.Code(err), codes.InvalidArgument)
+	})
+
+	suite.Run("empty file allowed", func() {
+		suite.handler.allowEmptyFiles = true
+		defer func() {
+			suite.handler.allowEmptyFiles = false
+		}()
+
+		suite.streamCreateFileMock.onContext(suite.testIncomingContext)
+		suite.streamCreateFileMock.onRecvWithOnce(positiveReq[0], nil)
+		suite.streamCreateFileMock.onRecvWithOnce(nil, io.EOF)
+		suite.streamCreateFileMock.onSendAndClose(&proto.CreateFileResponse{Id: suite.testFileID}, nil)
+
+		dbfmo := new(DBFilerMockedObject)
+		dbfmo.onClose(nil)
+		defer dbfmo.AssertExpectations(suite.T())
+
+		suite.fileStoreMock.onCreateDBFile(mock.Anything, dbfmo, nil)
+		suite.storageMock.onCreateFile(suite.testUserID, suite.testName, mock.Anything, suite.testMeta, false, false, &storage.File{ID: suite.testFileID}, nil)
+
+		err := suite.handler.CreateFile(suite.streamCreateFileMock)
+		require.NoError(err)
+	})
+
+	suite.Run("database error", func() {
+		suite.streamCreateFileMock.onContext(suite.testIncomingContext)
+		suite.streamCreateFileMock.onRecvWithOnce(positiveReq[0], nil)
+		suite.streamCreateFileMock.onRecvWithOnce(positiveReq[1], nil)
+		suite.streamCreateFileMock.onRecvWithOnce(positiveReq[2], nil)
+		suite.streamCreateFileMock.onRecvWithOnce(nil, io.EOF)
+
+		dbfmo := new(DBFilerMockedObject)
+		dbfmo.onClose(nil)
+		dbfmo.onWriteOnce(suite.testBatch1, len(suite.testBatch1), nil)
+		dbfmo.onWriteOnce(suite.testBatch2, len(suite.testBatch2), nil)
+		defer dbfmo.AssertExpectations(suite.T())
+
+		suite.storageMock.onCreateFile(suite.testUserID, suite.testName, mock.Anything, suite.testMeta, false, false, nil, testError)
+
+		suite.fileStoreMock.onCreateDBFile(mock.Anything, dbfmo, nil)
+
+		err := suite.handler.CreateFile(suite.streamCreateFileMock)
+		require.ErrorContains(err, testError.Error())
+		require.Equal(status.Code(err), codes.Internal)
+	})
+
+	suite.Run("unknown UserID error", func() {
+		suite.streamCreateFileMock.onContext(suite.testIncomingContext)
+		suite.streamCreateFileMock.onRecvWithOnce(positiveReq[0], nil)
+		suite.streamCreateFileMock.onRecvWithOnce(positiveReq[1], nil)
+		suite.streamCreateFileMock.onRecvWithOnce(positiveReq[2], nil)
+		suite.streamCreateFileMock.onRecvWithOnce(nil, io.EOF)
+
+		dbfmo := new(DBFilerMockedObject)
+		dbfmo.onClose(nil)
+		dbfmo.onWriteOnce(suite.testBatch1, len(suite.testBatch1), nil)
+		dbfmo.onWriteOnce(suite.testBatch2, len(suite.testBatch2), nil)
+		defer dbfmo.AssertExpectations(suite.T())
+
+		suite.storageMock.onCreateFile(suite.testUserID, suite.testName, mock.Anything, suite.testMeta, false, false, nil, storage.ErrUserNotFound)
+
+		suite.fileStoreMock.onCreateDBFile(mock.Anything, dbfmo, nil)
+
+		err := suite.handler.CreateFile(suite.streamCreateFileMock)
+		require.ErrorContains(err, fmt.Sprintf("unknown UserID %s", suite.testUserID))
+		require.Equal(status.Code(err), codes.Unknown)
+	})
+
+	suite.Run("path collision retry", func() {
+		suite.streamCreateFileMock.onContext(suite.testIncomingContext)
+		suite.streamCreateFileMock.onRecvWithOnce(positiveReq[0], nil)
+		suite.streamCreateFileMock.onRecvWithOnce(positiveReq[1], nil)
+		suite.streamCreateFileMock.onRecvWithOnce(positiveReq[2], nil)
+		suite.streamCreateFileMock.onRecvWithOnce(nil, io.EOF)
+		suite.streamCreateFileMock.onSendAndClose(&proto.CreateFileResponse{Id: suite.testFileID}, nil)
+
+		dbfmo := new(DBFilerMockedObject)
+		dbfmo.onClose(nil)
+		dbfmo.onWriteOnce(suite.testBatch1, len(suite.testBatch1), nil)
+		dbfmo.onWriteOnce(suite.testBatch2, len(suite.testBatch2), nil)
+		defer dbfmo.AssertExpectations(suite.T())
+
+		srcDbfmo := new(DBFilerMockedObject)
+		srcDbfmo.onGetChunkOnce(suite.testBatch1, nil)
+		srcDbfmo.onGetChunkOnce(nil, io.EOF)
+		srcDbfmo.onClose(nil)
+		defer srcDbfmo.AssertExpectations(suite.T())
+
+		dstDbfmo := new(DBFilerMockedObject)
+		dstDbfmo.onWriteOnce(suite.testBatch1, len(suite.testBatch1), nil)
+		dstDbfmo.onClose(nil)
+		defer dstDbfmo.AssertExpectations(suite.T())
+
+		suite.storageMock.onCreateFileOnce(suite.testUserID, suite.testName, mock.Anything, suite.testMeta, false, false, nil, storage.ErrPathAlreadyExists)
+		suite.storageMock.onCreateFileOnce(suite.testUserID, suite.testName, mock.Anything, suite.testMeta, false, false, &storage.File{ID: suite.testFileID}, nil)
+
+		suite.fileStoreMock.onCreateDBFileOnce(mock.Anything, dbfmo, nil)
+		suite.fileStoreMock.onGetDBFile(mock.Anything, srcDbfmo, nil)
+		suite.fileStoreMock.onCreateDBFileOnce(mock.Anything, dstDbfmo, nil)
+		suite.fileStoreMock.onDeleteDBFile(mock.Anything, nil)
+
+		err := suite.handler.CreateFile(suite.streamCreateFileMock)
+		require.NoError(err)
+	})
+
+	suite.Run("positive test", func() {
+		suite.streamCreateFileMock.onContext(suite.testIncomingContext)
+		suite.streamCreateFileMock.onRecvWithOnce(positiveReq[0], nil)
+		suite.streamCreateFileMock.onRecvWithOnce(positiveReq[1], nil)
+		suite.streamCreateFileMock.onRecvWithOnce(positiveReq[2], nil)
+		suite.streamCreateFileMock.onRecvWithOnce(nil, io.EOF)
+		suite.streamCreateFileMock.onSendAndClose(&proto.CreateFileResponse{Id: suite.testFileID}, nil)
+
+		dbfmo := new(DBFilerMockedObject)
+		dbfmo.onClose(nil)
+		dbfmo.onWriteOnce(suite.testBatch1, len(suite.testBatch1), nil)
+		dbfmo.onWriteOnce(suite.testBatch2, len(suite.testBatch2), nil)
+		defer dbfmo.AssertExpectations(suite.T())
+
+		suite.storageMock.onCreateFile(suite.testUserID, suite.testName, mock.Anything, suite.testMeta, false, false, &storage.File{ID: suite.testFileID}, nil)
+
+		suite.fileStoreMock.onCreateDBFile(mock.Anything, dbfmo, nil)
+
+		err := suite.handler.CreateFile(suite.streamCreateFileMock)
+		require.NoError(err)
+	})
+}
+
+func (suite *HandlersTestSuite) TestCreateFileStream() {
+	require := suite.Require()
+
+	fileInfo := &proto.File{
+		Name: suite.testName,
+		Meta: suite.testMeta,
+	}
+	positiveReq := []*proto.CreateFileStreamRequest{
+		{
+			Data: &proto.CreateFileStreamRequest_FileInfo{
+				FileInfo: fileInfo,
+			},
+		},
+		{
+			Data: &proto.CreateFileStreamRequest_Content{
+				Content: suite.testBatch1,
+			},
+		},
+		{
+			Data: &proto.CreateFileStreamRequest_Content{
+				Content: suite.testBatch2,
+			},
+		},
+	}
+
+	suite.Run("unauthenticated", func() {
+		suite.streamCreateFileStreamMock.onContext(context.Background())
+
+		err := suite.handler.CreateFileStream(suite.streamCreateFileStreamMock)
+		require.Error(err)
+		require.Equal(status.Code(err), codes.Unauthenticated)
+	})
+
+	suite.Run("send ack error", func() {
+		suite.streamCreateFileStreamMock.onContext(suite.testIncomingContext)
+		suite.streamCreateFileStreamMock.onRecvWithOnce(positiveReq[0], nil)
+		suite.streamCreateFileStreamMock.onRecvWithOnce(positiveReq[1], nil)
+		suite.streamCreateFileStreamMock.onSend(testError)
+
+		dbfmo := new(DBFilerMockedObject)
+		dbfmo.onClose(nil)
+		dbfmo.onWriteOnce(suite.testBatch1, len(suite.testBatch1), nil)
+		defer dbfmo.AssertExpectations(suite.T())
+
+		suite.fileStoreMock.onCreateDBFile(mock.Anything, dbfmo, nil)
+
+		err := suite.handler.CreateFileStream(suite.streamCreateFileStreamMock)
+		require.ErrorContains(err, "cannot send ack")
+		require.Equal(status.Code(err), codes.Unknown)
+	})
+
+	suite.Run("acks are emitted as chunks arrive", func() {
+		suite.streamCreateFileStreamMock = new(GophKeeper_CreateFileStreamServerMockedObject)
+		suite.streamCreateFileStreamMock.onContext(suite.testIncomingContext)
+		suite.streamCreateFileStreamMock.onRecvWithOnce(positiveReq[0], nil)
+		suite.streamCreateFileStreamMock.onRecvWithOnce(positiveReq[1], nil)
+		suite.streamCreateFileStreamMock.onRecvWithOnce(positiveReq[2], nil)
+		suite.streamCreateFileStreamMock.onRecvWithOnce(nil, io.EOF)
+		suite.streamCreateFileStreamMock.onSend(nil)
+
+		dbfmo := new(DBFilerMockedObject)
+		dbfmo.onClose(nil)
+		dbfmo.onWriteOnce(suite.testBatch1, len(suite.testBatch1), nil)
+		dbfmo.onWriteOnce(suite.testBatch2, len(suite.testBatch2), nil)
+		defer dbfmo.AssertExpectations(suite.T())
+
+		suite.storageMock.onCreateFile(suite.testUserID, suite.testName, mock.Anything, suite.testMeta, false, false, &storage.File{ID: suite.testFileID}, nil)
+
+		suite.fileStoreMock.onCreateDBFile(mock.Anything, dbfmo, nil)
+
+		err := suite.handler.CreateFileStream(suite.streamCreateFileStreamMock)
+		require.NoError(err)
+
+		sent := suite.streamCreateFileStreamMock.sent
+		require.Len(sent, 3)
+		require.Equal(uint64(len(suite.testBatch1)), sent[0].GetBytesReceived())
+		require.Equal(uint64(len(suite.testBatch1)+len(suite.testBatch2)), sent[1].GetBytesReceived())
+		require.Equal(suite.testFileID, sent[2].GetId())
+	})
+}
+
+func (suite *HandlersTestSuite) TestUpdateFile() {
+	require := suite.Require()
+
+	fileInfo := &proto.File{
+		Id:   suite.testFileID,
+		Name: suite.testName,
+		Meta: suite.testMeta,
+	}
+	positiveReq := []*proto.UpdateFileRequest{
+		{
+			Data: &proto.UpdateFileRequest_FileInfo{
+				FileInfo: fileInfo,
+			},
+		},
+		{
+			Data: &proto.UpdateFileRequest_Content{
+				Content: suite.testBatch1,
+			},
+		},
+		{
+			Data: &proto.UpdateFileRequest_Content{
+				Content: suite.testBatch2,
+			},
+		},
+	}
+
+	suite.Run("unauthenticated", func() {
+		suite.streamUpdateFileMock.onContext(context.Background())
+
+		err := suite.handler.UpdateFile(suite.streamUpdateFileMock)
+		require.Error(err)
+		require.Equal(status.Code(err), codes.Unauthenticated)
+	})
+
+	suite.Run("cannot receive file info", func() {
+		suite.streamUpdateFileMock.onContext(suite.testIncomingContext)
+		suite.streamUpdateFileMock.onRecvWithOnce(nil, testError)
+
+		err := suite.handler.UpdateFile(suite.streamUpdateFileMock)
+		require.ErrorContains(err, "cannot receive file info")
+		require.Equal(status.Code(err), codes.Unknown)
+	})
+
+	suite.Run("create DB file error", func() {
+		suite.streamUpdateFileMock.onContext(suite.testIncomingContext)
+		suite.streamUpdateFileMock.onRecvWithOnce(positiveReq[0], nil)
+		suite.fileStoreMock.onCreateDBFile(mock.Anything, nil, testError)
+
+		err := suite.handler.UpdateFile(suite.streamUpdateFileMock)
+		require.Error(err)
+		require.Equal(status.Code(err), codes.Internal)
+	})
+
+	suite.Run("cannot receive content", func() {
+		suite.streamUpdateFileMock.onContext(suite.testIncomingContext)
+		suite.streamUpdateFileMock.onRecvWithOnce(positiveReq[0], nil)
+		suite.streamUpdateFileMock.onRecvWithOnce(nil, testError)
+
+		dbfmo := new(DBFilerMockedObject)
+		dbfmo.onClose(nil)
+		defer dbfmo.AssertExpectations(suite.T())
+
+		suite.fileStoreMock.onCreateDBFile(mock.Anything, dbfmo, nil)
+
+		err := suite.handler.UpdateFile(suite.streamUpdateFileMock)
+		require.ErrorContains(err, "cannot receive content")
+		require.Equal(status.Code(err), codes.Unknown)
+	})
+
+	suite.Run("write chunk error", func() {
+		suite.streamUpdateFileMock.onContext(suite.testIncomingContext)
+		suite.streamUpdateFileMock.onRecvWithOnce(positiveReq[0], nil)
+		suite.streamUpdateFileMock.onRecvWithOnce(positiveReq[1], nil)
+
+		dbfmo := new(DBFilerMockedObject)
+		dbfmo.onClose(nil)
+		dbfmo.onWriteOnce(suite.testBatch1, 0, testError)
+		defer dbfmo.AssertExpectations(suite.T())
+
+		suite.fileStoreMock.onCreateDBFile(mock.Anything, dbfmo, nil)
+
+		err := suite.handler.UpdateFile(suite.streamUpdateFileMock)
+		require.Error(err)
+		require.Equal(status.Code(err), codes.Internal)
+	})
+
+	suite.Run("database error", func() {
+		suite.streamUpdateFileMock.onContext(suite.testIncomingContext)
+		suite.streamUpdateFileMock.onRecvWithOnce(positiveReq[0], nil)
+		suite.streamUpdateFileMock.onRecvWithOnce(positiveReq[1], nil)
+		suite.streamUpdateFileMock.onRecvWithOnce(positiveReq[2], nil)
+		suite.streamUpdateFileMock.onRecvWithOnce(nil, io.EOF)
+
+		dbfmo := new(DBFilerMockedObject)
+		dbfmo.onClose(nil)
+		dbfmo.onWriteOnce(suite.testBatch1, len(suite.testBatch1), nil)
+		dbfmo.onWriteOnce(suite.testBatch2, len(suite.testBatch2), nil)
+		defer dbfmo.AssertExpectations(suite.T())
+
+		suite.storageMock.onUpdateFile(suite.testFileID, suite.testUserID, suite.testName, mock.Anything, suite.testMeta, false, false, nil, testError)
+
+		suite.fileStoreMock.onCreateDBFile(mock.Anything, dbfmo, nil)
+
+		err := suite.handler.UpdateFile(suite.streamUpdateFileMock)
+		require.ErrorContains(err, testError.Error())
+		require.Equal(status.Code(err), codes.Internal)
+	})
+
+	suite.Run("unknown UserID error", func() {
+		suite.streamUpdateFileMock.onContext(suite.testIncomingContext)
+		suite.streamUpdateFileMock.onRecvWithOnce(positiveReq[0], nil)
+		suite.streamUpdateFileMock.onRecvWithOnce(positiveReq[1], nil)
+		suite.streamUpdateFileMock.onRecvWithOnce(positiveReq[2], nil)
+		suite.streamUpdateFileMock.onRecvWithOnce(nil, io.EOF)
+
+		dbfmo := new(DBFilerMockedObject)
+		dbfmo.onClose(nil)
+		dbfmo.onWriteOnce(suite.testBatch1, len(suite.testBatch1), nil)
+		dbfmo.onWriteOnce(suite.testBatch2, len(suite.testBatch2), nil)
+		defer dbfmo.AssertExpectations(suite.T())
+
+		suite.storageMock.onUpdateFile(suite.testFileID, suite.testUserID, suite.testName, mock.Anything, suite.testMeta, false, false, nil, storage.ErrUserNotFound)
+
+		suite.fileStoreMock.onCreateDBFile(mock.Anything, dbfmo, nil)
+
+		err := suite.handler.UpdateFile(suite.streamUpdateFileMock)
+		require.ErrorContains(err, fmt.Sprintf("unknown UserID %s", suite.testUserID))
+		require.Equal(status.Code(err), codes.Unknown)
+	})
+
+	suite.Run("delete file error", func() {
+		suite.streamUpdateFileMock.onContext(suite.testIncomingContext)
+		suite.streamUpdateFileMock.onRecvWithOnce(positiveReq[0], nil)
+		suite.streamUpdateFileMock.onRecvWithOnce(positiveReq[1], nil)
+		suite.streamUpdateFileMock.onRecvWithOnce(positiveReq[2], nil)
+		suite.streamUpdateFileMock.onRecvWithOnce(nil, io.EOF)
+
+		dbfmo := new(DBFilerMockedObject)
+		dbfmo.onClose(nil)
+		dbfmo.onWriteOnce(suite.testBatch1, len(suite.testBatch1), nil)
+		dbfmo.onWriteOnce(suite.testBatch2, len(suite.testBatch2), nil)
+		defer dbfmo.AssertExpectations(suite.T())
+
+		suite.storageMock.onUpdateFile(suite.testFileID, suite.testUserID, suite.testName, mock.Anything, suite.testMeta, false, false, &storage.File{
+			ID:         suite.testFileID,
+			PathToFile: mock.Anything,
+		}, nil)
+
+		suite.fileStoreMock.onCreateDBFile(mock.Anything, dbfmo, nil)
+		suite.fileStoreMock.onDeleteDBFile(mock.Anything, testError)
+
+		err := suite.handler.UpdateFile(suite.streamUpdateFileMock)
+		require.Error(err)
+		require.Equal(status.Code(err), codes.Internal)
+	})
+
+	suite.Run("positive test", func() {
+		suite.streamUpdateFileMock.onContext(suite.testIncomingContext)
+		suite.streamUpdateFileMock.onRecvWithOnce(positiveReq[0], nil)
+		suite.streamUpdateFileMock.onRecvWithOnce(positiveReq[1], nil)
+		suite.streamUpdateFileMock.onRecvWithOnce(positiveReq[2], nil)
+		suite.streamUpdateFileMock.onRecvWithOnce(nil, io.EOF)
+		suite.streamUpdateFileMock.onSendAndClose(&proto.UpdateFileResponse{Id: suite.testFileID}, nil)
+
+		dbfmo := new(DBFilerMockedObject)
+		dbfmo.onClose(nil)
+		dbfmo.onWriteOnce(suite.testBatch1, len(suite.testBatch1), nil)
+		dbfmo.onWriteOnce(suite.testBatch2, len(suite.testBatch2), nil)
+		defer dbfmo.AssertExpectations(suite.T())
+
+		suite.storageMock.onUpdateFile(suite.testFileID, suite.testUserID, suite.testName, mock.Anything, suite.testMeta, false, false, &storage.File{
+			ID:         suite.testFileID,
+			PathToFile: mock.Anything,
+		}, nil)
+
+		suite.fileStoreMock.onCreateDBFile(mock.Anything, dbfmo, nil)
+		suite.fileStoreMock.onDeleteDBFile(mock.Anything, nil)
+
+		err := suite.handler.UpdateFile(suite.streamUpdateFileMock)
+		require.NoError(err)
+	})
+}
+
+func (suite *HandlersTestSuite) TestUpdateFileInfo() {
+	require := suite.Require()
+
+	positiveReq := &proto.UpdateFileInfoRequest{
+		Id:   suite.testFileID,
+		Name: suite.testName,
+		Meta: suite.testMeta,
+	}
+
+	suite.Run("unauthenticated", func() {
+		res, err := suite.handler.UpdateFileInfo(context.Background(), positiveReq)
+		require.Error(err)
+		require.Equal(status.Code(err), codes.Unauthenticated)
+		require.Nil(res)
+	})
+
+	suite.Run("database error", func() {
+		suite.storageMock.onUpdateFileInfo(suite.testFileID, suite.testUserID, suite.testName, suite.testMeta, false, false, nil, testError)
+
+		res, err := suite.handler.UpdateFileInfo(suite.testIncomingContext, positiveReq)
+		require.ErrorContains(err, testError.Error())
+		require.Equal(status.Code(err), codes.Internal)
+		require.Nil(res)
+	})
+
+	suite.Run("unknown FileID error", func() {
+		suite.storageMock.onUpdateFileInfo(suite.testFileID, suite.testUserID, suite.testName, suite.testMeta, false, false, nil, storage.ErrFileNotFound)
+
+		res, err := suite.handler.UpdateFileInfo(suite.testIncomingContext, positiveReq)
+		require.ErrorContains(err, fmt.Sprintf("unknown FileID %s", suite.testFileID))
+		require.Equal(status.Code(err), codes.Unknown)
+		require.Nil(res)
+	})
+
+	suite.Run("positive test", func() {
+		suite.storageMock.onUpdateFileInfo(suite.testFileID, suite.testUserID, suite.testName, suite.testMeta, false, false, &storage.File{
+			ID: suite.testFileID,
+		}, nil)
+
+		res, err := suite.handler.UpdateFileInfo(suite.testIncomingContext, positiveReq)
+		require.NoError(err)
+		require.Equal(&proto.UpdateFileInfoResponse{Id: suite.testFileID}, res)
+	})
+}
+
+func (suite *HandlersTestSuite) TestGetFile() {
+	require := suite.Require()
+
+	positiveReq := &proto.GetFileRequest{
+		Id: suite.testFileID,
+	}
+
+	fileInfo := &proto.GetFileResponse{
+		Data: &proto.GetFileResponse_FileInfo{
+			FileInfo: &proto.File{
+				Id:       suite.testFileID,
+				Name:     suite.testName,
+				Meta:     suite.testMeta,
+				UpdateAt: timestamppb.New(suite.testUpdateAt),
+			},
+		},
+	}
+
+	content1 := &proto.GetFileResponse{
+		Data: &proto.GetFileResponse_Content{
+			Content: suite.testBatch1,
+		},
+	}
+
+	content2 := &proto.GetFileResponse{
+		Data: &proto.GetFileResponse_Content{
+			Content: suite.testBatch2,
+		},
+	}
+
+	fileReq := &storage.File{
+		ID:         suite.testFileID,
+		UserID:     suite.testUserID,
+		Name:       suite.testName,
+		PathToFile: mock.Anything,
+		Meta:       suite.testMeta,
+		UpdateAt:   suite.testUpdateAt,
+	}
+
+	suite.Run("unauthenticated", func() {
+		suite.streamGetFileMock.onContext(context.Background())
+
+		err := suite.handler.GetFile(positiveReq, suite.streamGetFileMock)
+		require.Error(err)
+		require.Equal(status.Code(err), codes.Unauthenticated)
+	})
+
+	suite.Run("empty FileID", func() {
+		req := &proto.GetFileRequest{
+			Id: "",
+		}
+		suite.streamGetFileMock.onContext(suite.testIncomingContext)
+
+		err := suite.handler.GetFile(req, suite.streamGetFileMock)
+		require.ErrorContains(err, "empty FileID")
+		require.Equal(status.Code(err), codes.InvalidArgument)
+	})
+
+	suite.Run("database error", func() {
+		suite.streamGetFileMock.onContext(suite.testIncomingContext)
+		suite.storageMock.onGetFile(suite.testFileID, suite.testUserID, nil, testError)
+
+		err := suite.handler.GetFile(positiveReq, suite.streamGetFileMock)
+		require.ErrorContains(err, testError.Error())
+		require.Equal(status.Code(err), codes.Internal)
+	})
+
+	suite.Run("unknown FileID error", func() {
+		suite.streamGetFileMock.onContext(suite.testIncomingContext)
+		suite.storageMock.onGetFile(suite.testFileID, suite.testUserID, nil, storage.ErrFileNotFound)
+
+		err := suite.handler.GetFile(positiveReq, suite.streamGetFileMock)
+		require.ErrorContains(err, fmt.Sprintf("unknown FileID %s", suite.testFileID))
+		require.Equal(status.Code(err), codes.Unknown)
+	})
+
+	suite.Run("send file info error", func() {
+		suite.streamGetFileMock.onContext(suite.testIncomingContext)
+		suite.streamGetFileMock.onSendOnce(fileInfo, testError)
+
+		suite.storageMock.onGetFile(suite.testFileID, suite.testUserID, fileReq, nil)
+
+		err := suite.handler.GetFile(positiveReq, suite.streamGetFileMock)
+		require.Error(err)
+		require.Equal(status.Code(err), codes.Internal)
+	})
+
+	suite.Run("get DB file error", func() {
+		suite.streamGetFileMock.onContext(suite.testIncomingContext)
+		suite.streamGetFileMock.onSendOnce(fileInfo, nil)
+
+		suite.storageMock.onGetFile(suite.testFileID, suite.testUserID, fileReq, nil)
+
+		suite.fileStoreMock.onGetDBFile(mock.Anything, nil, testError)
+
+		err := suite.handler.GetFile(positiveReq, suite.streamGetFileMock)
+		require.Error(err)
+		require.Equal(status.Code(err), codes.Internal)
+	})
+
+	suite.Run("get chunk error", func() {
+		suite.streamGetFileMock.onContext(suite.testIncomingContext)
+		suite.streamGetFileMock.onSendOnce(fileInfo, nil)
+
+		suite.storageMock.onGetFile(suite.testFileID, suite.testUserID, fileReq, nil)
+
+		dbfmo := new(DBFilerMockedObject)
+		dbfmo.onClose(nil)
+		dbfmo.onGetChunkOnce(nil, testError)
+		defer dbfmo.AssertExpectations(suite.T())
+
+		suite.fileStoreMock.onGetDBFile(mock.Anything, dbfmo, nil)
+
+		err := suite.handler.GetFile(positiveReq, suite.streamGetFileMock)
+		require.Error(err)
+		require.Equal(status.Code(err), codes.Internal)
+	})
+
+	suite.Run("send error", func() {
+		suite.streamGetFileMock.onContext(suite.testIncomingContext)
+		suite.streamGetFileMock.onSendOnce(fileInfo, nil)
+		suite.streamGetFileMock.onSendOnce(content1, testError)
+
+		suite.storageMock.onGetFile(suite.testFileID, suite.testUserID, fileReq, nil)
+
+		dbfmo := new(DBFilerMockedObject)
+		dbfmo.onClose(nil)
+		dbfmo.onGetChunkOnce(suite.testBatch1, nil)
+		defer dbfmo.AssertExpectations(suite.T())
+
+		suite.fileStoreMock.onGetDBFile(mock.Anything, dbfmo, nil)
+
+		err := suite.handler.GetFile(positiveReq, suite.streamGetFileMock)
+		require.Error(err)
+		require.Equal(status.Code(err), codes.Internal)
+	})
+
+	suite.Run("positive test", func() {
+		suite.streamGetFileMock.onContext(suite.testIncomingContext)
+		suite.streamGetFileMock.onSendOnce(fileInfo, nil)
+		suite.streamGetFileMock.onSendOnce(content1, nil)
+		suite.streamGetFileMock.onSendOnce(content2, nil)
+
+		suite.storageMock.onGetFile(suite.testFileID, suite.testUserID, fileReq, nil)
+
+		dbfmo := new(DBFilerMockedObject)
+		dbfmo.onClose(nil)
+		dbfmo.onGetChunkOnce(suite.testBatch1, nil)
+		dbfmo.onGetChunkOnce(suite.testBatch2, nil)
+		dbfmo.onGetChunkOnce(nil, io.EOF)
+		defer dbfmo.AssertExpectations(suite.T())
+
+		suite.fileStoreMock.onGetDBFile(mock.Anything, dbfmo, nil)
+
+		err := suite.handler.GetFile(positiveReq, suite.streamGetFileMock)
+		require.NoError(err)
+	})
+}
+
+func (suite *HandlersTestSuite) TestGetFiles() {
+	require := suite.Require()
+
+	suite.Run("unauthenticated", func() {
+		res, err := suite.handler.GetFiles(context.Background(), &proto.GetFilesRequest{})
+		require.Error(err)
+		require.Equal(status.Code(err), codes.Unauthenticated)
+		require.Nil(res)
+	})
+
+	suite.Run("database error", func() {
+		suite.storageMock.onGetAllFiles(suite.testUserID, nil, testError)
+
+		res, err := suite.handler.GetFiles(suite.testIncomingContext, &proto.GetFilesRequest{})
+		require.ErrorContains(err, testError.Error())
+		require.Equal(status.Code(err), codes.Internal)
+		require.Nil(res)
+	})
+
+	suite.Run("unknown UserID error", func() {
+		suite.storageMock.onGetAllFiles(suite.testUserID, nil, storage.ErrUserNotFound)
+
+		res, err := suite.handler.GetFiles(suite.testIncomingContext, &proto.GetFilesRequest{})
+		require.ErrorContains(err, fmt.Sprintf("unknown UserID %s", suite.testUserID))
+		require.Equal(status.Code(err), codes.Unknown)
+		require.Nil(res)
+	})
+
+	suite.Run("positive test", func() {
+		files := []storage.File{
+			{
+				ID:       suite.testFileID,
+				Name:     suite.testName,
+				Meta:     suite.testMeta,
+				UpdateAt: suite.testUpdateAt,
+			},
+			{
+				ID:       "anotherFileID",
+				Name:     "anotherName",
+				Meta:     "anotherMeta",
+				UpdateAt: suite.testUpdateAt,
+			},
+		}
+		suite.storageMock.onGetAllFiles(suite.testUserID, files, nil)
+
+		res, err := suite.handler.GetFiles(suite.testIncomingContext, &proto.GetFilesRequest{})
+		suite.Require().NoError(err)
+		suite.Require().Equal(&proto.GetFilesResponse{
+			FileInfo: []*proto.File{
+				{
+					Id:       suite.testFileID,
+					Name:     suite.testName,
+					Meta:     suite.testMeta,
+					UpdateAt: timestamppb.New(suite.testUpdateAt),
+				},
+				{
+					Id:       "anotherFileID",
+					Name:     "anotherName",
+					Meta:     "anotherMeta",
+					UpdateAt: timestamppb.New(suite.testUpdateAt),
+				},
+			},
+		}, res)
+	})
+}
+
+func (suite *HandlersTestSuite) TestDeleteFile() {
+	require := suite.Require()
+
+	positiveReq := &proto.DeleteFileRequest{
+		Id: suite.testFileID,
+	}
+
+	file := &storage.File{
+		ID:         suite.testFileID,
+		UserID:     suite.testUserID,
+		Name:       suite.testName,
+		PathToFile: mock.Anything,
+		Meta:       suite.testMeta,
+		UpdateAt:   suite.testUpdateAt,
+	}
+
+	suite.Run("unauthenticated", func() {
+		req := &proto.DeleteFileRequest{
+			Id: "",
+		}
+
+		res, err := suite.handler.DeleteFile(context.Background(), req)
+		require.Error(err)
+		require.Equal(status.Code(err), codes.Unauthenticated)
+		require.Nil(res)
+	})
+
+	suite.Run("database error", func() {
+		suite.storageMock.onGetFile(suite.testFileID, suite.testUserID, nil, testError)
+
+		res, err := suite.handler.DeleteFile(suite.testIncomingContext, positiveReq)
+		require.ErrorContains(err, testError.Error())
+		require.Equal(status.Code(err), codes.Internal)
+		require.Nil(res)
+	})
+
+	suite.Run("unknown FileID error", func() {
+		suite.storageMock.onGetFile(suite.testFileID, suite.testUserID, nil, storage.ErrFileNotFound)
+
+		res, err := suite.handler.DeleteFile(suite.testIncomingContext, positiveReq)
+		require.ErrorContains(err, fmt.Sprintf("unknown FileID %s", suite.testFileID))
+		require.Equal(status.Code(err), codes.Unknown)
+		require.Nil(res)
+	})
+
+	suite.Run("delete file error keeps row", func() {
+		suite.storageMock.onGetFile(suite.testFileID, suite.testUserID, file, nil)
+
+		suite.fileStoreMock.onDeleteDBFile(mock.Anything, testError)
+
+		res, err := suite.handler.DeleteFile(suite.testIncomingContext, positiveReq)
+		require.Error(err)
+		require.Nil(res)
+
+		suite.storageMock.AssertNotCalled(suite.T(), "DeleteFile", suite.testFileID, suite.testUserID)
+	})
+
+	suite.Run("transient blob delete error", func() {
+		suite.storageMock.onGetFile(suite.testFileID, suite.testUserID, file, nil)
+		suite.storageMock.onDeleteFile(suite.testFileID, suite.testUserID, file, nil)
+
+		suite.fileStoreMock.onDeleteDBFileOnce(mock.Anything, testError)
+		suite.fileStoreMock.onDeleteDBFile(mock.Anything, nil)
+
+		res, err := suite.handler.DeleteFile(suite.testIncomingContext, positiveReq)
+		require.NoError(err)
+		require.Nil(res)
+	})
+
+	suite.Run("blob delete fails twice then succeeds", func() {
+		suite.storageMock.onGetFile(suite.testFileID, suite.testUserID, file, nil)
+		suite.storageMock.onDeleteFile(suite.testFileID, suite.testUserID, file, nil)
+
+		suite.fileStoreMock.onDeleteDBFileOnce(mock.Anything, testError)
+		suite.fileStoreMock.onDeleteDBFileOnce(mock.Anything, testError)
+		suite.fileStoreMock.onDeleteDBFile(mock.Anything, nil)
+
+		res, err := suite.handler.DeleteFile(suite.testIncomingContext, positiveReq)
+		require.NoError(err)
+		require.Nil(res)
+	})
+
+	suite.Run("already absent blob counts as deleted", func() {
+		suite.storageMock.onGetFile(suite.testFileID, suite.testUserID, file, nil)
+		suite.storageMock.onDeleteFile(suite.testFileID, suite.testUserID, file, nil)
+
+		suite.fileStoreMock.onDeleteDBFile(mock.Anything, os.ErrNotExist)
+
+		res, err := suite.handler.DeleteFile(suite.testIncomingContext, positiveReq)
+		require.NoError(err)
+		require.Nil(res)
+
+		suite.storageMock.AssertCalled(suite.T(), "DeleteFile", suite.testFileID, suite.testUserID)
+	})
+
+	suite.Run("delete row error", func() {
+		suite.storageMock.onGetFile(suite.testFileID, suite.testUserID, file, nil)
+		suite.storageMock.onDeleteFile(suite.testFileID, suite.testUserID, nil, testError)
+
+		suite.fileStoreMock.onDeleteDBFile(mock.Anything, nil)
+
+		res, err := suite.handler.DeleteFile(suite.testIncomingContext, positiveReq)
+		require.ErrorContains(err, testError.Error())
+		require.Equal(status.Code(err), codes.Internal)
+		require.Nil(res)
+	})
+
+	suite.Run("positive test", func() {
+		suite.storageMock.onGetFile(suite.testFileID, suite.testUserID, file, nil)
+		suite.storageMock.onDeleteFile(suite.testFileID, suite.testUserID, file, nil)
+
+		suite.fileStoreMock.onDeleteDBFile(mock.Anything, nil)
+
+		res, err := suite.handler.DeleteFile(suite.testIncomingContext, positiveReq)
+		require.NoError(err)
+		require.Nil(res)
+	})
+}
+
+func (suite *HandlersTestSuite) TestFileCleanupOrder() {
+	require := suite.Require()
+
+	fileInfo := &proto.File{
+		Id:   suite.testFileID,
+		Name: suite.testName,
+		Meta: suite.testMeta,
+	}
+	updateReq := []*proto.UpdateFileRequest{
+		{
+			Data: &proto.UpdateFileRequest_FileInfo{
+				FileInfo: fileInfo,
+			},
+		},
+		{
+			Data: &proto.UpdateFileRequest_Content{
+				Content: suite.testBatch1,
+			},
+		},
+		{
+			Data: &proto.UpdateFileRequest_Content{
+				Content: suite.testBatch2,
+			},
+		},
+	}
+
+	newHandler := func(rfs *recordingFileStore) *GophKeeperHandler {
+		return NewGophKeeperHandler(
+			suite.storageMock,
+			suite.hasherMock,
+			suite.tokenerMock,
+			rfs,
+			*storage.NewRetryPolicy(3, 5, 3),
+			75,
+			suite.testAdminToken,
+			false,
+			defaultListLimit,
+		)
+	}
+
+	suite.Run("create failure leaves the old blob", func() {
+		suite.streamUpdateFileMock.onContext(suite.testIncomingContext)
+		suite.streamUpdateFileMock.onRecvWithOnce(updateReq[0], nil)
+		suite.fileStoreMock.onCreateDBFile(mock.Anything, nil, testError)
+
+		rfs := newRecordingFileStore(suite.fileStoreMock)
+
+		err := newHandler(rfs).UpdateFile(suite.streamUpdateFileMock)
+		require.Error(err)
+
+		require.Equal([]string{"CreateDBFile"}, rfs.calls)
+	})
+
+	suite.Run("update success deletes the old blob last", func() {
+		suite.streamUpdateFileMock.onContext(suite.testIncomingContext)
+		suite.streamUpdateFileMock.onRecvWithOnce(updateReq[0], nil)
+		suite.streamUpdateFileMock.onRecvWithOnce(updateReq[1], nil)
+		suite.streamUpdateFileMock.onRecvWithOnce(updateReq[2], nil)
+		suite.streamUpdateFileMock.onRecvWithOnce(nil, io.EOF)
+		suite.streamUpdateFileMock.onSendAndClose(&proto.UpdateFileResponse{Id: suite.testFileID}, nil)
+
+		dbfmo := new(DBFilerMockedObject)
+		dbfmo.onClose(nil)
+		dbfmo.onWriteOnce(suite.testBatch1, len(suite.testBatch1), nil)
+		dbfmo.onWriteOnce(suite.testBatch2, len(suite.testBatch2), nil)
+		defer dbfmo.AssertExpectations(suite.T())
+
+		suite.storageMock.onUpdateFile(suite.testFileID, suite.testUserID, suite.testName, mock.Anything, suite.testMeta, false, false, &storage.File{
+			ID:         suite.testFileID,
+			PathToFile: "testOldPath",
+		}, nil)
+
+		suite.fileStoreMock.onCreateDBFile(mock.Anything, dbfmo, nil)
+		suite.fileStoreMock.onDeleteDBFile("testOldPath", nil)
+
+		rfs := newRecordingFileStore(suite.fileStoreMock)
+
+		err := newHandler(rfs).UpdateFile(suite.streamUpdateFileMock)
+		require.NoError(err)
+
+		require.Equal([]string{"CreateDBFile", "DeleteDBFile"}, rfs.calls)
+	})
+
+	suite.Run("delete failure never touches the row", func() {
+		file := &storage.File{
+			ID:         suite.testFileID,
+			UserID:     suite.testUserID,
+			Name:       suite.testName,
+			PathToFile: "testOldPath",
+			Meta:       suite.testMeta,
+			UpdateAt:   suite.testUpdateAt,
+		}
+
+		suite.storageMock.onGetFile(suite.testFileID, suite.testUserID, file, nil)
+		suite.fileStoreMock.onDeleteDBFile("testOldPath", testError)
+
+		rfs := newRecordingFileStore(suite.fileStoreMock)
+
+		res, err := newHandler(rfs).DeleteFile(suite.testIncomingContext, &proto.DeleteFileRequest{
+			Id: suite.testFileID,
+		})
+		require.Error(err)
+		require.Nil(res)
+
+		// The blob delete is retried, but every recorded call must be a
+		// DeleteDBFile and the row must stay untouched.
+		require.NotEmpty(rfs.calls)
+
+		for _, call := range rfs.calls {
+			require.Equal("DeleteDBFile", call)
+		}
+
+		suite.storageMock.AssertNotCalled(suite.T(), "DeleteFile", suite.testFileID, suite.testUserID)
+	})
+}
+
+func (suite *HandlersTestSuite) TestGetChunkSize() {
+	suite.Run("non-positive chunk size", func() {
+		suite.fileStoreMock.onGetChunkSize(0)
+
+		res, err := suite.handler.GetChunkSize(nil, nil)
+		suite.Require().ErrorContains(err, "non-positive chunk size")
+		suite.Require().Equal(codes.Internal, status.Code(err))
+		suite.Require().Nil(res)
+	})
+
+	suite.Run("positive test", func() {
+		suite.fileStoreMock.onGetChunkSize(1024)
+
+		res, err := suite.handler.GetChunkSize(nil, nil)
+		suite.Require().NoError(err)
+		suite.Equal(&proto.GetChunkSizeResponse{
+			Size: 1024,
+		}, res)
+	})
+}
+
+func (suite *HandlersTestSuite) TestServerInfo() {
+	suite.Run("database error", func() {
+		suite.storageMock.onServerTime(time.Time{}, testError)
+
+		res, err := suite.handler.ServerInfo(context.Background(), &proto.ServerInfoRequest{})
+		suite.Require().Error(err)
+		suite.Require().Equal(codes.Internal, status.Code(err))
+		suite.Require().Nil(res)
+	})
+
+	suite.Run("positive test", func() {
+		now := time.Now()
+		suite.storageMock.onServerTime(now, nil)
+
+		res, err := suite.handler.ServerInfo(context.Background(), &proto.ServerInfoRequest{})
+		suite.Require().NoError(err)
+		suite.Require().WithinDuration(now, res.GetServerTime().AsTime(), time.Second)
+	})
+}
+
+func TestHandlersTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlersTestSuite))
+}
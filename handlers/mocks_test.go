@@ -0,0 +1,902 @@
+//go:build unit
+
+package handlers
+
+import (
+	"context"
+	"time"
+
+	proto "github.com/Tomap-Tomap/GophKeeper/proto/gophkeeper/v1"
+	"github.com/Tomap-Tomap/GophKeeper/storage"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+)
+
+type HasherMockedObject struct {
+	mock.Mock
+}
+
+func (h *HasherMockedObject) GenerateSalt(length int) (string, error) {
+	args := h.Called(length)
+
+	return args.String(0), args.Error(1)
+}
+
+func (h *HasherMockedObject) onGenerateSalt(length int, retSalt string, retErr error) {
+	h.On("GenerateSalt", length).Return(retSalt, retErr)
+}
+
+func (h *HasherMockedObject) GenerateHash(str string) string {
+	args := h.Called(str)
+
+	return args.String(0)
+}
+
+func (h *HasherMockedObject) onGenerateHash(str, retHash string) {
+	h.On("GenerateHash", str).Return(retHash)
+}
+
+func (h *HasherMockedObject) GenerateHashWithSalt(str, salt string) (string, error) {
+	args := h.Called(str, salt)
+
+	return args.String(0), args.Error(1)
+}
+
+func (h *HasherMockedObject) onGenerateHashWithSalt(str, salt, retHash string, retErr error) {
+	h.On("GenerateHashWithSalt", str, salt).Return(retHash, retErr)
+}
+
+func (h *HasherMockedObject) VerifyHashWithSalt(str, salt, hash, algo string) (bool, error) {
+	args := h.Called(str, salt, hash, algo)
+
+	return args.Bool(0), args.Error(1)
+}
+
+func (h *HasherMockedObject) onVerifyHashWithSalt(str, salt, hash, algo string, retOK bool, retErr error) {
+	h.On("VerifyHashWithSalt", str, salt, hash, algo).Return(retOK, retErr)
+}
+
+type StorageMockedObject struct {
+	mock.Mock
+}
+
+func (sm *StorageMockedObject) CreateUser(_ context.Context, login, loginHashed, salt, password string) (*storage.User, error) {
+	args := sm.Called(login, loginHashed, salt, password)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*storage.User), args.Error(1)
+}
+
+func (sm *StorageMockedObject) onCreateUser(login, loginHashed, salt, password string, retUser *storage.User, retErr error) {
+	sm.On("CreateUser", login, loginHashed, salt, password).Return(retUser, retErr)
+}
+
+func (sm *StorageMockedObject) CreateUserPreHashed(_ context.Context, login, loginHashed, salt, passwordHash, algo string) (*storage.User, error) {
+	args := sm.Called(login, loginHashed, salt, passwordHash, algo)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*storage.User), args.Error(1)
+}
+
+func (sm *StorageMockedObject) onCreateUserPreHashed(login, loginHashed, salt, passwordHash, algo string, retUser *storage.User, retErr error) {
+	sm.On("CreateUserPreHashed", login, loginHashed, salt, passwordHash, algo).Return(retUser, retErr)
+}
+
+func (sm *StorageMockedObject) GetUser(_ context.Context, login, loginHashed string) (*storage.User, error) {
+	args := sm.Called(login, loginHashed)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*storage.User), args.Error(1)
+}
+
+func (sm *StorageMockedObject) onGetUser(login, loginHashed string, retUser *storage.User, retErr error) {
+	sm.On("GetUser", login, loginHashed).Return(retUser, retErr)
+}
+
+func (sm *StorageMockedObject) GetUserByLogin(_ context.Context, login string) (*storage.User, error) {
+	args := sm.Called(login)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*storage.User), args.Error(1)
+}
+
+func (sm *StorageMockedObject) onGetUserByLogin(login string, retUser *storage.User, retErr error) {
+	sm.On("GetUserByLogin", login).Return(retUser, retErr)
+}
+
+func (sm *StorageMockedObject) Maintenance(_ context.Context) (time.Duration, error) {
+	args := sm.Called()
+
+	return args.Get(0).(time.Duration), args.Error(1)
+}
+
+func (sm *StorageMockedObject) onMaintenance(retDuration time.Duration, retErr error) {
+	sm.On("Maintenance").Return(retDuration, retErr)
+}
+
+func (sm *StorageMockedObject) ServerTime(_ context.Context) (time.Time, error) {
+	args := sm.Called()
+
+	return args.Get(0).(time.Time), args.Error(1)
+}
+
+func (sm *StorageMockedObject) onServerTime(retTime time.Time, retErr error) {
+	sm.On("ServerTime").Return(retTime, retErr)
+}
+
+func (sm *StorageMockedObject) UpsertPublicKey(_ context.Context, userID, publicKey string) error {
+	args := sm.Called(userID, publicKey)
+
+	return args.Error(0)
+}
+
+func (sm *StorageMockedObject) onUpsertPublicKey(userID, publicKey string, retErr error) {
+	sm.On("UpsertPublicKey", userID, publicKey).Return(retErr)
+}
+
+func (sm *StorageMockedObject) GetPublicKey(_ context.Context, userID string) (string, error) {
+	args := sm.Called(userID)
+
+	return args.String(0), args.Error(1)
+}
+
+func (sm *StorageMockedObject) onGetPublicKey(userID string, retPublicKey string, retErr error) {
+	sm.On("GetPublicKey", userID).Return(retPublicKey, retErr)
+}
+
+func (sm *StorageMockedObject) ReplaceRecoveryCodes(_ context.Context, userID string, codeHashes []string) error {
+	args := sm.Called(userID, codeHashes)
+
+	return args.Error(0)
+}
+
+func (sm *StorageMockedObject) onReplaceRecoveryCodes(userID string, codeHashes any, retErr error) {
+	sm.On("ReplaceRecoveryCodes", userID, codeHashes).Return(retErr)
+}
+
+func (sm *StorageMockedObject) GetRecoveryCodes(_ context.Context, userID string) ([]storage.RecoveryCode, error) {
+	args := sm.Called(userID)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]storage.RecoveryCode), args.Error(1)
+}
+
+func (sm *StorageMockedObject) onGetRecoveryCodes(userID string, retCodes []storage.RecoveryCode, retErr error) {
+	sm.On("GetRecoveryCodes", userID).Return(retCodes, retErr)
+}
+
+func (sm *StorageMockedObject) DeleteRecoveryCode(_ context.Context, id string) error {
+	args := sm.Called(id)
+
+	return args.Error(0)
+}
+
+func (sm *StorageMockedObject) onDeleteRecoveryCode(id string, retErr error) {
+	sm.On("DeleteRecoveryCode", id).Return(retErr)
+}
+
+func (sm *StorageMockedObject) CreatePassword(_ context.Context, userID, name, login, password, meta, wrappedKey, fingerprint string, pinned, requiresReauth bool) (*storage.Password, error) {
+	args := sm.Called(userID, name, login, password, meta, wrappedKey, fingerprint, pinned, requiresReauth)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*storage.Password), args.Error(1)
+}
+
+func (sm *StorageMockedObject) onCreatePassword(userID, name, login, password, meta, wrappedKey, fingerprint string, pinned, requiresReauth bool, retPassword *storage.Password, retErr error) {
+	sm.On("CreatePassword", userID, name, login, password, meta, wrappedKey, fingerprint, pinned, requiresReauth).Return(retPassword, retErr)
+}
+
+func (sm *StorageMockedObject) UpdatePassword(_ context.Context, id, userID, name, login, password, meta, wrappedKey, fingerprint string, pinned, requiresReauth bool) (*storage.Password, error) {
+	args := sm.Called(id, userID, name, login, password, meta, wrappedKey, fingerprint, pinned, requiresReauth)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*storage.Password), args.Error(1)
+}
+
+func (sm *StorageMockedObject) onUpdatePassword(id, userID, name, login, password, meta, wrappedKey, fingerprint string, pinned, requiresReauth bool, retPassword *storage.Password, retErr error) {
+	sm.On("UpdatePassword", id, userID, name, login, password, meta, wrappedKey, fingerprint, pinned, requiresReauth).Return(retPassword, retErr)
+}
+
+func (sm *StorageMockedObject) GetPassword(_ context.Context, passwordID, userID string) (*storage.Password, error) {
+	args := sm.Called(passwordID, userID)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*storage.Password), args.Error(1)
+}
+
+func (sm *StorageMockedObject) onGetPassword(passwordID, userID string, retPassword *storage.Password, retErr error) {
+	sm.On("GetPassword", passwordID, userID).Return(retPassword, retErr)
+}
+
+func (sm *StorageMockedObject) GetAllPassword(_ context.Context, userID string) ([]storage.Password, error) {
+	args := sm.Called(userID)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]storage.Password), args.Error(1)
+}
+
+func (sm *StorageMockedObject) onGetAllPassword(userID string, retPasswords []storage.Password, retErr error) {
+	sm.On("GetAllPassword", userID).Return(retPasswords, retErr)
+}
+
+func (sm *StorageMockedObject) GetPasswordsByCategory(_ context.Context, userID, category string) ([]storage.Password, error) {
+	args := sm.Called(userID, category)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]storage.Password), args.Error(1)
+}
+
+func (sm *StorageMockedObject) onGetPasswordsByCategory(userID, category string, retPasswords []storage.Password, retErr error) {
+	sm.On("GetPasswordsByCategory", userID, category).Return(retPasswords, retErr)
+}
+
+func (sm *StorageMockedObject) DeletePassword(_ context.Context, id, userID string) error {
+	args := sm.Called(id, userID)
+
+	return args.Error(0)
+}
+
+func (sm *StorageMockedObject) onDeletePassword(id, userID string, retErr error) {
+	sm.On("DeletePassword", id, userID).Return(retErr)
+}
+
+func (sm *StorageMockedObject) TouchPassword(_ context.Context, id, userID string) (*storage.Password, error) {
+	args := sm.Called(id, userID)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*storage.Password), args.Error(1)
+}
+
+func (sm *StorageMockedObject) onTouchPassword(id, userID string, retPassword *storage.Password, retErr error) {
+	sm.On("TouchPassword", id, userID).Return(retPassword, retErr)
+}
+
+func (sm *StorageMockedObject) GetReusedPasswords(_ context.Context, userID string) ([]storage.ReusedPasswords, error) {
+	args := sm.Called(userID)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]storage.ReusedPasswords), args.Error(1)
+}
+
+func (sm *StorageMockedObject) onGetReusedPasswords(userID string, retReused []storage.ReusedPasswords, retErr error) {
+	sm.On("GetReusedPasswords", userID).Return(retReused, retErr)
+}
+
+func (sm *StorageMockedObject) CreateFile(_ context.Context, userID, name, pathToFile, meta string, pinned, requiresReauth bool) (*storage.File, error) {
+	args := sm.Called(userID, name, pathToFile, meta, pinned, requiresReauth)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*storage.File), args.Error(1)
+}
+
+func (sm *StorageMockedObject) UpdateFile(_ context.Context, id, userID, name, pathToFile, meta string, pinned, requiresReauth bool) (*storage.File, error) {
+	args := sm.Called(id, userID, name, pathToFile, meta, pinned, requiresReauth)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*storage.File), args.Error(1)
+}
+
+func (sm *StorageMockedObject) UpdateFileInfo(_ context.Context, fileID, userID, name, meta string, pinned, requiresReauth bool) (*storage.File, error) {
+	args := sm.Called(fileID, userID, name, meta, pinned, requiresReauth)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*storage.File), args.Error(1)
+}
+
+func (sm *StorageMockedObject) GetFile(_ context.Context, fileID, userID string) (*storage.File, error) {
+	args := sm.Called(fileID, userID)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*storage.File), args.Error(1)
+}
+
+func (sm *StorageMockedObject) GetAllFiles(_ context.Context, userID string) ([]storage.File, error) {
+	args := sm.Called(userID)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]storage.File), args.Error(1)
+}
+
+func (sm *StorageMockedObject) ListAllFiles(_ context.Context) ([]storage.File, error) {
+	args := sm.Called()
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]storage.File), args.Error(1)
+}
+
+func (sm *StorageMockedObject) DeleteFile(_ context.Context, id, userID string) (*storage.File, error) {
+	args := sm.Called(id, userID)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*storage.File), args.Error(1)
+}
+
+func (sm *StorageMockedObject) onCreateFile(userID, name, pathToFile, meta string, pinned, requiresReauth bool, retFile *storage.File, retErr error) {
+	sm.On("CreateFile", userID, name, pathToFile, meta, pinned, requiresReauth).Return(retFile, retErr)
+}
+
+func (sm *StorageMockedObject) onCreateFileOnce(userID, name, pathToFile, meta string, pinned, requiresReauth bool, retFile *storage.File, retErr error) {
+	sm.On("CreateFile", userID, name, pathToFile, meta, pinned, requiresReauth).Return(retFile, retErr).Once()
+}
+
+func (sm *StorageMockedObject) onUpdateFile(fileID, userID, name, pathToFile, meta string, pinned, requiresReauth bool, retFile *storage.File, retErr error) {
+	sm.On("UpdateFile", fileID, userID, name, pathToFile, meta, pinned, requiresReauth).Return(retFile, retErr)
+}
+
+func (sm *StorageMockedObject) onUpdateFileInfo(fileID, userID, name, meta string, pinned, requiresReauth bool, retFile *storage.File, retErr error) {
+	sm.On("UpdateFileInfo", fileID, userID, name, meta, pinned, requiresReauth).Return(retFile, retErr)
+}
+
+func (sm *StorageMockedObject) onGetFile(fileID, userID string, retFile *storage.File, retErr error) {
+	sm.On("GetFile", fileID, userID).Return(retFile, retErr)
+}
+
+func (sm *StorageMockedObject) onGetAllFiles(userID string, retFiles []storage.File, retErr error) {
+	sm.On("GetAllFiles", userID).Return(retFiles, retErr)
+}
+
+func (sm *StorageMockedObject) onListAllFilesOnce(retFiles []storage.File, retErr error) {
+	sm.On("ListAllFiles").Return(retFiles, retErr).Once()
+}
+
+func (sm *StorageMockedObject) onDeleteFile(fileID, userID string, retFile *storage.File, retErr error) {
+	sm.On("DeleteFile", fileID, userID).Return(retFile, retErr)
+}
+
+func (sm *StorageMockedObject) CreateBank(_ context.Context, userID, name, number, cvc, owner, exp, meta string, pinned, requiresReauth bool) (*storage.Bank, error) {
+	args := sm.Called(userID, name, number, cvc, owner, exp, meta, pinned, requiresReauth)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*storage.Bank), args.Error(1)
+}
+
+func (sm *StorageMockedObject) UpdateBank(_ context.Context, id, userID, name, number, cvc, owner, exp, meta string, pinned, requiresReauth bool) (*storage.Bank, error) {
+	args := sm.Called(id, userID, name, number, cvc, owner, exp, meta, pinned, requiresReauth)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*storage.Bank), args.Error(1)
+}
+
+func (sm *StorageMockedObject) GetBank(_ context.Context, bankID, userID string) (*storage.Bank, error) {
+	args := sm.Called(bankID, userID)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*storage.Bank), args.Error(1)
+}
+
+func (sm *StorageMockedObject) GetAllBanks(_ context.Context, userID string) ([]storage.Bank, error) {
+	args := sm.Called(userID)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]storage.Bank), args.Error(1)
+}
+
+func (sm *StorageMockedObject) DeleteBank(_ context.Context, id, userID string) error {
+	args := sm.Called(id, userID)
+
+	return args.Error(0)
+}
+
+func (sm *StorageMockedObject) onCreateBank(userID, name, number, cvc, owner, exp, meta string, pinned, requiresReauth bool, retBank *storage.Bank, retErr error) {
+	sm.On("CreateBank", userID, name, number, cvc, owner, exp, meta, pinned, requiresReauth).Return(retBank, retErr)
+}
+
+func (sm *StorageMockedObject) onUpdateBank(bankID, userID, name, number, cvc, owner, exp, meta string, pinned, requiresReauth bool, retBank *storage.Bank, retErr error) {
+	sm.On("UpdateBank", bankID, userID, name, number, cvc, owner, exp, meta, pinned, requiresReauth).Return(retBank, retErr)
+}
+
+func (sm *StorageMockedObject) onGetBank(bankID, userID string, retBank *storage.Bank, retErr error) {
+	sm.On("GetBank", bankID, userID).Return(retBank, retErr)
+}
+
+func (sm *StorageMockedObject) onGetAllBanks(userID string, retBanks []storage.Bank, retErr error) {
+	sm.On("GetAllBanks", userID).Return(retBanks, retErr)
+}
+
+func (sm *StorageMockedObject) onDeleteBank(bankID, userID string, retErr error) {
+	sm.On("DeleteBank", bankID, userID).Return(retErr)
+}
+
+func (sm *StorageMockedObject) CreateText(_ context.Context, userID, name, text, meta string, pinned, requiresReauth bool) (*storage.Text, error) {
+	args := sm.Called(userID, name, text, meta, pinned, requiresReauth)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*storage.Text), args.Error(1)
+}
+
+func (sm *StorageMockedObject) UpdateText(_ context.Context, id, userID, name, text, meta string, pinned, requiresReauth bool) (*storage.Text, error) {
+	args := sm.Called(id, userID, name, text, meta, pinned, requiresReauth)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*storage.Text), args.Error(1)
+}
+
+func (sm *StorageMockedObject) GetText(_ context.Context, textID, userID string) (*storage.Text, error) {
+	args := sm.Called(textID, userID)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*storage.Text), args.Error(1)
+}
+
+func (sm *StorageMockedObject) GetAllTexts(_ context.Context, userID, orderBy, orderDir string, limit, offset int) ([]storage.Text, error) {
+	args := sm.Called(userID, orderBy, orderDir, limit, offset)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]storage.Text), args.Error(1)
+}
+
+func (sm *StorageMockedObject) DeleteText(_ context.Context, id, userID string) error {
+	args := sm.Called(id, userID)
+
+	return args.Error(0)
+}
+
+func (sm *StorageMockedObject) GetRecentActivity(_ context.Context, userID string, limit int) ([]storage.Activity, error) {
+	args := sm.Called(userID, limit)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]storage.Activity), args.Error(1)
+}
+
+func (sm *StorageMockedObject) onGetRecentActivity(userID string, limit int, retActivities []storage.Activity, retErr error) {
+	sm.On("GetRecentActivity", userID, limit).Return(retActivities, retErr)
+}
+
+func (sm *StorageMockedObject) GetFavorites(_ context.Context, userID string) (*storage.Favorites, error) {
+	args := sm.Called(userID)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*storage.Favorites), args.Error(1)
+}
+
+func (sm *StorageMockedObject) onGetFavorites(userID string, retFavorites *storage.Favorites, retErr error) {
+	sm.On("GetFavorites", userID).Return(retFavorites, retErr)
+}
+
+func (sm *StorageMockedObject) GetEntryIDs(_ context.Context, userID, kind string) ([]storage.EntryID, error) {
+	args := sm.Called(userID, kind)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]storage.EntryID), args.Error(1)
+}
+
+func (sm *StorageMockedObject) onGetEntryIDs(userID, kind string, retEntries []storage.EntryID, retErr error) {
+	sm.On("GetEntryIDs", userID, kind).Return(retEntries, retErr)
+}
+
+func (sm *StorageMockedObject) GetVaultManifest(_ context.Context, userID string, limit, offset int) ([]storage.ManifestEntry, error) {
+	args := sm.Called(userID, limit, offset)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]storage.ManifestEntry), args.Error(1)
+}
+
+func (sm *StorageMockedObject) onGetVaultManifest(userID string, limit, offset int, retEntries []storage.ManifestEntry, retErr error) {
+	sm.On("GetVaultManifest", userID, limit, offset).Return(retEntries, retErr)
+}
+
+func (sm *StorageMockedObject) ListSessions(_ context.Context, userID string) ([]storage.Session, error) {
+	args := sm.Called(userID)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]storage.Session), args.Error(1)
+}
+
+func (sm *StorageMockedObject) onListSessions(userID string, retSessions []storage.Session, retErr error) {
+	sm.On("ListSessions", userID).Return(retSessions, retErr)
+}
+
+func (sm *StorageMockedObject) RevokeSession(_ context.Context, userID, deviceID string) error {
+	args := sm.Called(userID, deviceID)
+
+	return args.Error(0)
+}
+
+func (sm *StorageMockedObject) onRevokeSession(userID, deviceID string, retErr error) {
+	sm.On("RevokeSession", userID, deviceID).Return(retErr)
+}
+
+func (sm *StorageMockedObject) onCreateText(userID, name, text, meta string, pinned, requiresReauth bool, retText *storage.Text, retErr error) {
+	sm.On("CreateText", userID, name, text, meta, pinned, requiresReauth).Return(retText, retErr)
+}
+
+func (sm *StorageMockedObject) onUpdateText(textID, userID, name, text, meta string, pinned, requiresReauth bool, retText *storage.Text, retErr error) {
+	sm.On("UpdateText", textID, userID, name, text, meta, pinned, requiresReauth).Return(retText, retErr)
+}
+
+func (sm *StorageMockedObject) onGetText(textID, userID string, retText *storage.Text, retErr error) {
+	sm.On("GetText", textID, userID).Return(retText, retErr)
+}
+
+func (sm *StorageMockedObject) onGetAllTexts(userID, orderBy, orderDir string, limit, offset int, retTexts []storage.Text, retErr error) {
+	sm.On("GetAllTexts", userID, orderBy, orderDir, limit, offset).Return(retTexts, retErr)
+}
+
+func (sm *StorageMockedObject) onDeleteText(textID, userID string, retErr error) {
+	sm.On("DeleteText", textID, userID).Return(retErr)
+}
+
+type TokenerMockedObject struct {
+	mock.Mock
+}
+
+func (t *TokenerMockedObject) GetToken(sub string) (string, error) {
+	args := t.Called(sub)
+
+	return args.String(0), args.Error(1)
+}
+
+func (t *TokenerMockedObject) onGetToken(sub, retToken string, retErr error) {
+	t.On("GetToken", sub).Return(retToken, retErr)
+}
+
+func (t *TokenerMockedObject) SignChallenge(nonce []byte) ([]byte, error) {
+	args := t.Called(nonce)
+
+	if signature, ok := args.Get(0).([]byte); ok {
+		return signature, args.Error(1)
+	}
+
+	return nil, args.Error(1)
+}
+
+func (t *TokenerMockedObject) onSignChallenge(nonce, retSignature []byte, retErr error) {
+	t.On("SignChallenge", nonce).Return(retSignature, retErr)
+}
+
+type FileStoreMockedObject struct {
+	mock.Mock
+}
+
+func (fs *FileStoreMockedObject) CreateDBFile(fileName string) (storage.DBFiler, error) {
+	args := fs.Called(fileName)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(storage.DBFiler), args.Error(1)
+}
+
+func (fs *FileStoreMockedObject) GetDBFile(fileName string) (storage.DBFiler, error) {
+	args := fs.Called(fileName)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(storage.DBFiler), args.Error(1)
+}
+
+func (fs *FileStoreMockedObject) DeleteDBFile(fileName string) error {
+	args := fs.Called(fileName)
+
+	return args.Error(0)
+}
+
+func (fs *FileStoreMockedObject) onCreateDBFile(fileName string, retDBFiler storage.DBFiler, retErr error) {
+	fs.On("CreateDBFile", fileName).Return(retDBFiler, retErr)
+}
+
+func (fs *FileStoreMockedObject) onCreateDBFileOnce(fileName string, retDBFiler storage.DBFiler, retErr error) {
+	fs.On("CreateDBFile", fileName).Return(retDBFiler, retErr).Once()
+}
+
+func (fs *FileStoreMockedObject) onGetDBFile(fileName string, retDBFiler storage.DBFiler, retErr error) {
+	fs.On("GetDBFile", fileName).Return(retDBFiler, retErr)
+}
+
+func (fs *FileStoreMockedObject) onGetDBFileOnce(fileName string, retDBFiler storage.DBFiler, retErr error) {
+	fs.On("GetDBFile", fileName).Return(retDBFiler, retErr).Once()
+}
+
+func (fs *FileStoreMockedObject) onDeleteDBFile(fileName string, retErr error) {
+	fs.On("DeleteDBFile", fileName).Return(retErr)
+}
+
+func (fs *FileStoreMockedObject) onDeleteDBFileOnce(fileName string, retErr error) {
+	fs.On("DeleteDBFile", fileName).Return(retErr).Once()
+}
+
+func (fs *FileStoreMockedObject) GetChunkSize() int {
+	args := fs.Called()
+
+	return args.Int(0)
+}
+
+func (fs *FileStoreMockedObject) onGetChunkSize(retSize int) {
+	fs.On("GetChunkSize").Return(retSize)
+}
+
+// recordingFileStore wraps a FileStore and records the blob calls in the
+// order the handler makes them, so tests can pin the exact cleanup
+// sequence of CreateDBFile/DeleteDBFile instead of only asserting that
+// each call happened.
+type recordingFileStore struct {
+	inner FileStore
+
+	calls []string
+}
+
+func newRecordingFileStore(inner FileStore) *recordingFileStore {
+	return &recordingFileStore{inner: inner}
+}
+
+func (rfs *recordingFileStore) CreateDBFile(fileName string) (storage.DBFiler, error) {
+	rfs.calls = append(rfs.calls, "CreateDBFile")
+
+	return rfs.inner.CreateDBFile(fileName)
+}
+
+func (rfs *recordingFileStore) GetDBFile(fileName string) (storage.DBFiler, error) {
+	rfs.calls = append(rfs.calls, "GetDBFile")
+
+	return rfs.inner.GetDBFile(fileName)
+}
+
+func (rfs *recordingFileStore) DeleteDBFile(fileName string) error {
+	rfs.calls = append(rfs.calls, "DeleteDBFile")
+
+	return rfs.inner.DeleteDBFile(fileName)
+}
+
+func (rfs *recordingFileStore) GetChunkSize() int {
+	return rfs.inner.GetChunkSize()
+}
+
+type DBFilerMockedObject struct {
+	mock.Mock
+}
+
+func (dbf *DBFilerMockedObject) Write(chunk []byte) (int, error) {
+	args := dbf.Called(chunk)
+	return args.Int(0), args.Error(1)
+}
+
+func (dbf *DBFilerMockedObject) GetChunk() ([]byte, error) {
+	args := dbf.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (dbf *DBFilerMockedObject) Close() error {
+	args := dbf.Called()
+	return args.Error(0)
+}
+
+func (dbf *DBFilerMockedObject) onWriteOnce(chunk []byte, retInt int, retErr error) {
+	dbf.On("Write", chunk).Return(retInt, retErr).Once()
+}
+
+func (dbf *DBFilerMockedObject) onGetChunkOnce(retChunk []byte, retErr error) {
+	dbf.On("GetChunk").Return(retChunk, retErr).Once()
+}
+
+func (dbf *DBFilerMockedObject) onClose(retErr error) {
+	dbf.On("Close").Return(retErr)
+}
+
+type GophKeeper_CreateFileServerMockedObject struct {
+	mock.Mock
+	grpc.ServerStream
+}
+
+func (m *GophKeeper_CreateFileServerMockedObject) SendAndClose(res *proto.CreateFileResponse) error {
+	args := m.Called(res)
+	return args.Error(0)
+}
+
+func (m *GophKeeper_CreateFileServerMockedObject) Recv() (*proto.CreateFileRequest, error) {
+	args := m.Called()
+	return args.Get(0).(*proto.CreateFileRequest), args.Error(1)
+}
+
+func (m *GophKeeper_CreateFileServerMockedObject) Context() context.Context {
+	args := m.Called()
+	return args.Get(0).(context.Context)
+}
+
+func (m *GophKeeper_CreateFileServerMockedObject) onSendAndClose(res *proto.CreateFileResponse, retErr error) {
+	m.On("SendAndClose", res).Return(retErr)
+}
+
+func (m *GophKeeper_CreateFileServerMockedObject) onRecvWithOnce(retReq *proto.CreateFileRequest, retErr error) {
+	m.On("Recv").Return(retReq, retErr).Once()
+}
+
+func (m *GophKeeper_CreateFileServerMockedObject) onContext(retCtx context.Context) {
+	m.On("Context").Return(retCtx)
+}
+
+type GophKeeper_CreateFileStreamServerMockedObject struct {
+	mock.Mock
+	grpc.ServerStream
+
+	sent []*proto.CreateFileStreamResponse
+}
+
+func (m *GophKeeper_CreateFileStreamServerMockedObject) Send(res *proto.CreateFileStreamResponse) error {
+	m.sent = append(m.sent, res)
+
+	args := m.Called(res)
+	return args.Error(0)
+}
+
+func (m *GophKeeper_CreateFileStreamServerMockedObject) Recv() (*proto.CreateFileStreamRequest, error) {
+	args := m.Called()
+	return args.Get(0).(*proto.CreateFileStreamRequest), args.Error(1)
+}
+
+func (m *GophKeeper_CreateFileStreamServerMockedObject) Context() context.Context {
+	args := m.Called()
+	return args.Get(0).(context.Context)
+}
+
+func (m *GophKeeper_CreateFileStreamServerMockedObject) onSend(retErr error) {
+	m.On("Send", mock.Anything).Return(retErr)
+}
+
+func (m *GophKeeper_CreateFileStreamServerMockedObject) onRecvWithOnce(retReq *proto.CreateFileStreamRequest, retErr error) {
+	m.On("Recv").Return(retReq, retErr).Once()
+}
+
+func (m *GophKeeper_CreateFileStreamServerMockedObject) onContext(retCtx context.Context) {
+	m.On("Context").Return(retCtx)
+}
+
+type GophKeeper_UpdateFileServerMockedObject struct {
+	mock.Mock
+	grpc.ServerStream
+}
+
+func (m *GophKeeper_UpdateFileServerMockedObject) Context() context.Context {
+	args := m.Called()
+	return args.Get(0).(context.Context)
+}
+
+func (m *GophKeeper_UpdateFileServerMockedObject) SendAndClose(res *proto.UpdateFileResponse) error {
+	args := m.Called(res)
+	return args.Error(0)
+}
+
+func (m *GophKeeper_UpdateFileServerMockedObject) Recv() (*proto.UpdateFileRequest, error) {
+	args := m.Called()
+	return args.Get(0).(*proto.UpdateFileRequest), args.Error(1)
+}
+
+func (m *GophKeeper_UpdateFileServerMockedObject) onContext(ctx context.Context) {
+	m.On("Context").Return(ctx)
+}
+
+func (m *GophKeeper_UpdateFileServerMockedObject) onSendAndClose(res *proto.UpdateFileResponse, err error) {
+	m.On("SendAndClose", res).Return(err)
+}
+
+func (m *GophKeeper_UpdateFileServerMockedObject) onRecvWithOnce(req *proto.UpdateFileRequest, err error) {
+	m.On("Recv").Return(req, err).Once()
+}
+
+type GophKeeper_GetFileServerMockedObject struct {
+	mock.Mock
+	grpc.ServerStream
+}
+
+func (m *GophKeeper_GetFileServerMockedObject) Send(res *proto.GetFileResponse) error {
+	args := m.Called(res)
+	return args.Error(0)
+}
+
+func (m *GophKeeper_GetFileServerMockedObject) Recv() (*proto.GetFileRequest, error) {
+	args := m.Called()
+	return args.Get(0).(*proto.GetFileRequest), args.Error(1)
+}
+
+func (m *GophKeeper_GetFileServerMockedObject) Context() context.Context {
+	args := m.Called()
+	return args.Get(0).(context.Context)
+}
+
+func (m *GophKeeper_GetFileServerMockedObject) onSendOnce(res *proto.GetFileResponse, retErr error) {
+	m.On("Send", res).Return(retErr).Once()
+}
+
+func (m *GophKeeper_GetFileServerMockedObject) onRecvWithOnce(retReq *proto.GetFileRequest, retErr error) {
+	m.On("Recv").Return(retReq, retErr).Once()
+}
+
+func (m *GophKeeper_GetFileServerMockedObject) onContext(retCtx context.Context) {
+	m.On("Context").Return(retCtx)
+}
@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bufbuild/protovalidate-go"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Validator struct that embeds the protovalidate.Validator
+type Validator struct {
+	*protovalidate.Validator
+}
+
+// NewValidator creates a new Validator instance with the provided protovalidate.Validator
+func NewValidator(validator *protovalidate.Validator) *Validator {
+	return &Validator{
+		validator,
+	}
+}
+
+// UnaryServerInterceptor is a method of Validator that acts as a gRPC Unary Server Interceptor
+func (v *Validator) UnaryServerInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	msg, ok := req.(protoreflect.ProtoMessage)
+
+	if ok {
+		err := v.Validate(msg)
+
+		if err != nil {
+			return nil, validationStatus(err).Err()
+		}
+	}
+
+	return handler(ctx, req)
+}
+
+// validationStatus converts a validation error into an InvalidArgument
+// status carrying a BadRequest detail with one field violation per broken
+// constraint, so clients can highlight every bad field at once rather than
+// only the first one.
+func validationStatus(err error) *status.Status {
+	st := status.New(codes.InvalidArgument, err.Error())
+
+	var valErr *protovalidate.ValidationError
+
+	if !errors.As(err, &valErr) {
+		return st
+	}
+
+	br := &errdetails.BadRequest{}
+
+	for _, violation := range valErr.Violations {
+		br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       violation.GetFieldPath(),
+			Description: violation.GetMessage(),
+		})
+	}
+
+	stWithDetails, detailsErr := st.WithDetails(br)
+
+	if detailsErr != nil {
+		return st
+	}
+
+	return stWithDetails
+}
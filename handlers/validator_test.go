@@ -0,0 +1,73 @@
+//go:build unit
+
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	proto "github.com/Tomap-Tomap/GophKeeper/proto/gophkeeper/v1"
+	"github.com/bufbuild/protovalidate-go"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestValidatorUnaryServerInterceptor(t *testing.T) {
+	validator, err := protovalidate.New()
+	require.NoError(t, err)
+
+	v := NewValidator(validator)
+
+	handlerCalled := false
+	handler := func(_ context.Context, _ any) (any, error) {
+		handlerCalled = true
+		return nil, nil
+	}
+
+	t.Run("valid request reaches the handler", func(t *testing.T) {
+		handlerCalled = false
+
+		_, err := v.UnaryServerInterceptor(context.Background(), &proto.RegisterRequest{
+			Login:    "testLogin",
+			Password: "testPassword",
+		}, nil, handler)
+
+		require.NoError(t, err)
+		require.True(t, handlerCalled)
+	})
+
+	t.Run("all violations are reported", func(t *testing.T) {
+		handlerCalled = false
+
+		_, err := v.UnaryServerInterceptor(context.Background(), &proto.RegisterRequest{}, nil, handler)
+
+		require.Error(t, err)
+		require.False(t, handlerCalled)
+
+		st, ok := status.FromError(err)
+		require.True(t, ok)
+		require.Equal(t, codes.InvalidArgument, st.Code())
+
+		var br *errdetails.BadRequest
+
+		for _, detail := range st.Details() {
+			if d, ok := detail.(*errdetails.BadRequest); ok {
+				br = d
+			}
+		}
+
+		require.NotNil(t, br)
+		require.Len(t, br.GetFieldViolations(), 2)
+
+		fields := make([]string, 0, len(br.GetFieldViolations()))
+
+		for _, fv := range br.GetFieldViolations() {
+			require.NotEmpty(t, fv.GetDescription())
+			fields = append(fields, fv.GetField())
+		}
+
+		require.ElementsMatch(t, []string{"login", "password"}, fields)
+	})
+}
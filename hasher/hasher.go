@@ -0,0 +1,107 @@
+// Package hasher defines methods and structures for generating hashes and salts.
+package hasher
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Supported password hash algorithms.
+const (
+	AlgoSHA256 = "sha256"
+	AlgoBcrypt = "bcrypt"
+)
+
+// ErrUnknownAlgo is returned when an unsupported hash algorithm is requested.
+var ErrUnknownAlgo = errors.New("unknown hash algorithm")
+
+// SupportedAlgo reports whether algo names a supported password hash
+// algorithm. The empty string counts as supported: it marks records created
+// before the algorithm marker existed and verifies as sha256.
+func SupportedAlgo(algo string) bool {
+	switch algo {
+	case AlgoSHA256, AlgoBcrypt, "":
+		return true
+	}
+
+	return false
+}
+
+// Hasher provides methods to work with hashing functionalities.
+type Hasher struct {
+}
+
+// NewHasher allocates and returns a new Hasher instance.
+func NewHasher() *Hasher {
+	return &Hasher{}
+}
+
+// GenerateSalt generates and returns a salt of the specified length.
+func (h *Hasher) GenerateSalt(length int) (string, error) {
+	salt := make([]byte, length)
+
+	_, err := rand.Read(salt)
+
+	if err != nil {
+		return "", fmt.Errorf("connot read random data: %w", err)
+	}
+
+	return hex.EncodeToString(salt), nil
+}
+
+// GenerateHash generates a hash for the given string.
+func (h *Hasher) GenerateHash(str string) string {
+	hash := sha256.Sum256([]byte(str))
+
+	return hex.EncodeToString(hash[:])
+}
+
+// GenerateHashWithSalt generates a hash for the given string combined with the provided salt.
+func (h *Hasher) GenerateHashWithSalt(str, salt string) (string, error) {
+	decodeSalt, err := hex.DecodeString(salt)
+
+	if err != nil {
+		return "", fmt.Errorf("cannot decode salt: %w", err)
+	}
+
+	hash := sha256.New()
+	data := append(decodeSalt, str...)
+	hash.Write(data)
+	dst := hash.Sum(nil)
+
+	return hex.EncodeToString(dst), nil
+}
+
+// VerifyHashWithSalt reports whether the given string matches the stored hash,
+// dispatching on the algorithm the hash was produced with. An empty algorithm
+// is treated as sha256 for records created before the algorithm marker existed.
+func (h *Hasher) VerifyHashWithSalt(str, salt, hash, algo string) (bool, error) {
+	switch algo {
+	case AlgoSHA256, "":
+		calculated, err := h.GenerateHashWithSalt(str, salt)
+
+		if err != nil {
+			return false, err
+		}
+
+		return calculated == hash, nil
+	case AlgoBcrypt:
+		err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(str))
+
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return false, nil
+		}
+		if err != nil {
+			return false, fmt.Errorf("cannot compare bcrypt hash: %w", err)
+		}
+
+		return true, nil
+	}
+
+	return false, fmt.Errorf("%s: %w", algo, ErrUnknownAlgo)
+}
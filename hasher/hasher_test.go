@@ -0,0 +1,119 @@
+//go:build unit
+
+package hasher
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestHasher_GenerateSalt(t *testing.T) {
+	t.Run("positive test", func(t *testing.T) {
+		hasher := NewHasher()
+
+		salt, err := hasher.GenerateSalt(75)
+		require.NoError(t, err)
+		assert.NotEmpty(t, salt)
+	})
+}
+
+func TestHasher_GetHash(t *testing.T) {
+	hasher := NewHasher()
+
+	testStr := "Password"
+	hashStr := hasher.GenerateHash(testStr)
+
+	require.NotEmpty(t, hashStr)
+	assert.NotEqual(t, testStr, hashStr)
+}
+
+func TestHasher_GetHashWithSalt(t *testing.T) {
+	t.Run("decode error", func(t *testing.T) {
+		hasher := NewHasher()
+		got, err := hasher.GenerateHashWithSalt("", "asd")
+		require.ErrorContains(t, err, "cannot decode salt")
+		assert.Empty(t, got)
+	})
+
+	t.Run("positive test", func(t *testing.T) {
+		salt := make([]byte, 10)
+
+		_, err := rand.Read(salt)
+		require.NoError(t, err)
+
+		hasher := NewHasher()
+		got, err := hasher.GenerateHashWithSalt("", hex.EncodeToString(salt))
+		require.NoError(t, err)
+		assert.NotEmpty(t, got)
+	})
+}
+
+func TestHasher_VerifyHashWithSalt(t *testing.T) {
+	hasher := NewHasher()
+
+	testPassword := "Password"
+
+	t.Run("sha256 positive test", func(t *testing.T) {
+		salt, err := hasher.GenerateSalt(75)
+		require.NoError(t, err)
+
+		hash, err := hasher.GenerateHashWithSalt(testPassword, salt)
+		require.NoError(t, err)
+
+		ok, err := hasher.VerifyHashWithSalt(testPassword, salt, hash, AlgoSHA256)
+		require.NoError(t, err)
+		assert.True(t, ok)
+
+		ok, err = hasher.VerifyHashWithSalt("invalidPassword", salt, hash, AlgoSHA256)
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("empty algo is sha256", func(t *testing.T) {
+		salt, err := hasher.GenerateSalt(75)
+		require.NoError(t, err)
+
+		hash, err := hasher.GenerateHashWithSalt(testPassword, salt)
+		require.NoError(t, err)
+
+		ok, err := hasher.VerifyHashWithSalt(testPassword, salt, hash, "")
+		require.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("sha256 salt error", func(t *testing.T) {
+		ok, err := hasher.VerifyHashWithSalt(testPassword, "asd", "", AlgoSHA256)
+		require.ErrorContains(t, err, "cannot decode salt")
+		assert.False(t, ok)
+	})
+
+	t.Run("bcrypt imported hash positive test", func(t *testing.T) {
+		hash, err := bcrypt.GenerateFromPassword([]byte(testPassword), bcrypt.MinCost)
+		require.NoError(t, err)
+
+		ok, err := hasher.VerifyHashWithSalt(testPassword, "", string(hash), AlgoBcrypt)
+		require.NoError(t, err)
+		assert.True(t, ok)
+
+		ok, err = hasher.VerifyHashWithSalt("invalidPassword", "", string(hash), AlgoBcrypt)
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("bcrypt invalid hash error", func(t *testing.T) {
+		ok, err := hasher.VerifyHashWithSalt(testPassword, "", "notAHash", AlgoBcrypt)
+		require.ErrorContains(t, err, "cannot compare bcrypt hash")
+		assert.False(t, ok)
+	})
+
+	t.Run("unknown algo error", func(t *testing.T) {
+		ok, err := hasher.VerifyHashWithSalt(testPassword, "", "", "md5")
+		require.ErrorIs(t, err, ErrUnknownAlgo)
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,238 @@
+// Package logger provides structures and functionality for application logging.
+package logger
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Log is a singleton variable that allows for centralized logging across the application.
+var Log *zap.Logger = zap.NewNop()
+
+// atomicLevel is the level of the logger built by Initialize. Keeping it
+// around lets SetLevel change the level of the running logger in place.
+var atomicLevel = zap.NewAtomicLevel()
+
+// Initialize sets up the logging environment by configuring the log level and output path.
+// Returns an error if there is an issue with parsing the log level or building the logger
+func Initialize(level string, outputPath string) error {
+	lvl, err := zap.ParseAtomicLevel(level)
+	if err != nil {
+		return fmt.Errorf("parse level %s: %w", level, err)
+	}
+
+	atomicLevel.SetLevel(lvl.Level())
+
+	cfg := zap.NewProductionConfig()
+	cfg.Level = atomicLevel
+	cfg.OutputPaths = []string{outputPath}
+	zl, err := cfg.Build()
+	if err != nil {
+		return fmt.Errorf("build logger: %w", err)
+	}
+
+	Log = zl
+
+	return nil
+}
+
+// SetLevel changes the level of the logger built by Initialize without
+// rebuilding it, so it is safe to call while the logger is in use.
+// Returns an error if there is an issue with parsing the log level.
+func SetLevel(level string) error {
+	lvl, err := zap.ParseAtomicLevel(level)
+	if err != nil {
+		return fmt.Errorf("parse level %s: %w", level, err)
+	}
+
+	atomicLevel.SetLevel(lvl.Level())
+
+	return nil
+}
+
+// leakAlertMessage is written instead of details when a secret slips
+// into a log entry, so the alert itself cannot leak the value.
+const leakAlertMessage = "Sensitive value leaked into a log entry"
+
+// EnableStrictRedaction wraps the current logger so every entry, message
+// and fields alike, is scanned for the given secret values before it is
+// written. On a hit the guard panics when develop is true and writes an
+// error entry otherwise; the original entry is still written, the alert
+// is the signal to fix the call site. Empty secrets are ignored. It is a
+// development safety net for accidental secret logging, not a redaction
+// mechanism.
+func EnableStrictRedaction(develop bool, secrets ...string) {
+	guarded := make([]string, 0, len(secrets))
+
+	for _, secret := range secrets {
+		if secret != "" {
+			guarded = append(guarded, secret)
+		}
+	}
+
+	Log = Log.WithOptions(zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+		return &redactionGuardCore{Core: core, secrets: guarded, develop: develop}
+	}))
+}
+
+// redactionGuardCore scans entries for secret values on their way to the
+// wrapped core.
+type redactionGuardCore struct {
+	zapcore.Core
+
+	secrets []string
+	develop bool
+}
+
+// With scans the accumulated fields, which would otherwise bypass Write,
+// and keeps the guard on the derived core.
+func (c *redactionGuardCore) With(fields []zapcore.Field) zapcore.Core {
+	c.scan(zapcore.Entry{}, fields)
+
+	return &redactionGuardCore{Core: c.Core.With(fields), secrets: c.secrets, develop: c.develop}
+}
+
+// Check registers the guard as the writing core for enabled entries.
+func (c *redactionGuardCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(ent.Level) {
+		return ce.AddCore(ent, c)
+	}
+
+	return ce
+}
+
+// Write scans the entry before delegating to the wrapped core.
+func (c *redactionGuardCore) Write(ent zapcore.Entry, fields []zapcore.Field) error {
+	c.scan(ent, fields)
+
+	return c.Core.Write(ent, fields)
+}
+
+// scan checks the entry message and formatted field values for secrets
+// and raises the alert on a hit.
+func (c *redactionGuardCore) scan(ent zapcore.Entry, fields []zapcore.Field) {
+	if len(c.secrets) == 0 {
+		return
+	}
+
+	enc := zapcore.NewMapObjectEncoder()
+
+	for _, field := range fields {
+		field.AddTo(enc)
+	}
+
+	if !c.leaks(ent.Message) && !c.leaks(fmt.Sprint(enc.Fields)) {
+		return
+	}
+
+	if c.develop {
+		panic(leakAlertMessage)
+	}
+
+	_ = c.Core.Write(zapcore.Entry{
+		Level:   zapcore.ErrorLevel,
+		Time:    time.Now(),
+		Message: leakAlertMessage,
+	}, nil)
+}
+
+// leaks reports whether the formatted text contains one of the secrets.
+func (c *redactionGuardCore) leaks(text string) bool {
+	for _, secret := range c.secrets {
+		if strings.Contains(text, secret) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// newErrorID returns a short random ID correlating a failed response with
+// its log line. Internal errors are masked before leaving the server, so
+// the ID is what a user reports and an operator greps for.
+func newErrorID() string {
+	b := make([]byte, 4)
+
+	if _, err := rand.Read(b); err != nil {
+		return "unknown"
+	}
+
+	return hex.EncodeToString(b)
+}
+
+// UnaryInterceptorLogger is a gRPC interceptor for logging unary requests and responses.
+// This function logs the incoming request, processes it, and then logs the response along with the duration of the request.
+func UnaryInterceptorLogger(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+	start := time.Now()
+
+	if v, ok := req.(proto.Message); ok {
+		Log.Info("Got incoming grpc request",
+			zap.String("full method", info.FullMethod),
+			zap.Any("body", v),
+		)
+	} else {
+		Log.Warn("Payload is not a google.golang.org/protobuf/proto.Message; programmatic error?",
+			zap.String("full method", info.FullMethod))
+	}
+
+	resp, err = handler(ctx, req)
+
+	if err != nil {
+		errorID := newErrorID()
+
+		Log.Warn("Failed request", zap.String("error id", errorID), zap.Error(err))
+
+		if status.Code(err) == codes.Internal {
+			err = status.Errorf(codes.Internal, "internal error on method %s, error id %s", info.FullMethod, errorID)
+		}
+	} else {
+		duration := time.Since(start)
+
+		Log.Info("Sending grpc response",
+			zap.String("duration", duration.String()),
+		)
+	}
+
+	return
+}
+
+// StreamInterceptorLogger is a gRPC interceptor for logging streaming requests and responses.
+// This function logs the incoming stream request, processes it, and then logs the stream response along with the duration of the request.
+func StreamInterceptorLogger(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	start := time.Now()
+
+	Log.Info("Got incoming stream grpc request",
+		zap.String("full method", info.FullMethod),
+	)
+
+	err = handler(srv, stream)
+
+	if err != nil {
+		errorID := newErrorID()
+
+		Log.Warn("Failed stream request", zap.String("error id", errorID), zap.Error(err))
+
+		if status.Code(err) == codes.Internal {
+			err = status.Errorf(codes.Internal, "internal error on method %s, error id %s", info.FullMethod, errorID)
+		}
+	} else {
+		duration := time.Since(start)
+
+		Log.Info("Sending stream grpc response",
+			zap.String("duration", duration.String()),
+		)
+	}
+
+	return
+}
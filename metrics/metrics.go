@@ -0,0 +1,65 @@
+// Package metrics provides gRPC interceptors that record request and
+// response payload sizes for capacity planning.
+package metrics
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// Interceptor records the serialized size of unary requests and responses
+// into histograms labeled by method. Streaming content bodies are not
+// observed, so file chunks never reach the metrics pipeline; only sizes of
+// unary messages are recorded, never content.
+type Interceptor struct {
+	requestSize  *prometheus.HistogramVec
+	responseSize *prometheus.HistogramVec
+}
+
+// NewInterceptor creates an Interceptor and registers its histograms in the
+// given registry.
+func NewInterceptor(reg prometheus.Registerer) (*Interceptor, error) {
+	m := &Interceptor{
+		requestSize: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "grpc_request_size_bytes",
+			Help:    "Serialized size of unary gRPC requests.",
+			Buckets: prometheus.ExponentialBuckets(64, 4, 8),
+		}, []string{"method"}),
+		responseSize: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "grpc_response_size_bytes",
+			Help:    "Serialized size of unary gRPC responses.",
+			Buckets: prometheus.ExponentialBuckets(64, 4, 8),
+		}, []string{"method"}),
+	}
+
+	if err := reg.Register(m.requestSize); err != nil {
+		return nil, err
+	}
+
+	if err := reg.Register(m.responseSize); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// UnaryServerInterceptor observes the serialized request size, invokes the
+// handler and observes the response size on success.
+func (m *Interceptor) UnaryServerInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if msg, ok := req.(proto.Message); ok {
+		m.requestSize.WithLabelValues(info.FullMethod).Observe(float64(proto.Size(msg)))
+	}
+
+	resp, err := handler(ctx, req)
+
+	if err == nil {
+		if msg, ok := resp.(proto.Message); ok {
+			m.responseSize.WithLabelValues(info.FullMethod).Observe(float64(proto.Size(msg)))
+		}
+	}
+
+	return resp, err
+}
@@ -0,0 +1,65 @@
+//go:build unit
+
+package metrics
+
+import (
+	"context"
+	"testing"
+
+	gkproto "github.com/Tomap-Tomap/GophKeeper/proto/gophkeeper/v1"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	reg := prometheus.NewRegistry()
+
+	m, err := NewInterceptor(reg)
+	require.NoError(t, err)
+
+	info := &grpc.UnaryServerInfo{
+		FullMethod: "/gophkeeper.v1.GophKeeperService/GetPassword",
+	}
+
+	req := &gkproto.GetPasswordRequest{
+		Id: "testID",
+	}
+
+	res := &gkproto.GetPasswordResponse{
+		Password: &gkproto.Password{
+			Id:   "testID",
+			Name: "testName",
+		},
+	}
+
+	resp, err := m.UnaryServerInterceptor(context.Background(), req, info, func(context.Context, any) (any, error) {
+		return res, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, res, resp)
+
+	mfs, err := reg.Gather()
+	require.NoError(t, err)
+	require.Len(t, mfs, 2)
+
+	for _, mf := range mfs {
+		require.Len(t, mf.GetMetric(), 1)
+
+		metric := mf.GetMetric()[0]
+		require.Equal(t, "method", metric.GetLabel()[0].GetName())
+		require.Equal(t, info.FullMethod, metric.GetLabel()[0].GetValue())
+		require.Equal(t, uint64(1), metric.GetHistogram().GetSampleCount())
+		require.Greater(t, metric.GetHistogram().GetSampleSum(), float64(0))
+	}
+}
+
+func TestNewInterceptorDuplicateRegistration(t *testing.T) {
+	reg := prometheus.NewRegistry()
+
+	_, err := NewInterceptor(reg)
+	require.NoError(t, err)
+
+	_, err = NewInterceptor(reg)
+	require.Error(t, err)
+}
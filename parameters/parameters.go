@@ -0,0 +1,270 @@
+// Package parameters defines structure's parameters for client/servers work.
+package parameters
+
+import (
+	"flag"
+	"net/url"
+	"os"
+	"regexp"
+	"strconv"
+)
+
+// redactedPassword replaces the password component of a redacted DSN.
+const redactedPassword = "xxxxx"
+
+var (
+	dsnKeywordPasswordPattern = regexp.MustCompile("password=[^\\s`'\"]*")
+	dsnURLPasswordPattern     = regexp.MustCompile(`(://[^:/?#@\s]+:)[^@\s]+@`)
+)
+
+// RedactDSN masks the password component of a connection string so the
+// value can appear in logs and error messages. Both the URL and the
+// keyword=value DSN forms are handled, including a DSN embedded in
+// driver error text; a value without a password comes back unchanged.
+func RedactDSN(dsn string) string {
+	if u, err := url.Parse(dsn); err == nil && u.User != nil {
+		if _, ok := u.User.Password(); ok {
+			u.User = url.UserPassword(u.User.Username(), redactedPassword)
+			return u.String()
+		}
+	}
+
+	dsn = dsnURLPasswordPattern.ReplaceAllString(dsn, "${1}"+redactedPassword+"@")
+
+	return dsnKeywordPasswordPattern.ReplaceAllString(dsn, "password="+redactedPassword)
+}
+
+// ServerParameters contains parameters for server.
+type ServerParameters struct {
+	DSN               string
+	ReadDSN           string
+	TokenSecret       string
+	AdminToken        string
+	PathToFileStorage string
+	GRPCAddr          string
+	LogLevel          string
+	QueryExecMode     string
+	FileBackend       string
+	RateLimitBackend  string
+	ChallengeKeyPath  string
+	AdminAPIAddr      string
+	AdminOrigins      string
+	ServerStorageKey  string
+
+	TokenDuration uint
+	ChunkSize     uint
+
+	RequestTimeout       uint
+	StreamRequestTimeout uint
+
+	MaxConnectionIdle    uint
+	MaxConnectionAge     uint
+	MinPingInterval      uint
+	MaxConcurrentStreams uint
+
+	ListLimit uint
+
+	IntegrityScanInterval uint
+
+	AllowEmptyFiles    bool
+	StrictLogRedaction bool
+	DBWarmup           bool
+	Version            bool
+}
+
+// ParseFlagsServer return server's parameters from console or env.
+func ParseFlagsServer() (p ServerParameters) {
+	f := flag.NewFlagSet(os.Args[0], flag.ContinueOnError)
+	f.StringVar(&p.DSN, "dsn", "host=localhost port=5433 user=gophkeeper password=gophkeeper dbname=gophkeeper sslmode=disable", "dsn to DB")
+	f.StringVar(&p.ReadDSN, "read-dsn", "", "dsn to a read replica for entry reads, empty keeps all queries on the primary")
+	f.StringVar(&p.TokenSecret, "secret", "secret", "secret for token sign")
+	f.StringVar(&p.AdminToken, "admin-token", "", "token for admin methods, empty disables them")
+	f.StringVar(&p.PathToFileStorage, "f", "/tmp", "path to file storage")
+	f.StringVar(&p.GRPCAddr, "a", "localhost:3388", "address and port to run grpc server")
+	f.StringVar(&p.LogLevel, "log-level", "INFO", "logging level, reloadable with SIGHUP")
+	f.StringVar(&p.QueryExecMode, "query-exec-mode", "", "pgx query exec mode: cache_statement, cache_describe, describe, exec or simple; empty keeps the pgx default")
+	f.StringVar(&p.FileBackend, "file-backend", "disk", "where file content is stored: disk or db")
+	f.StringVar(&p.RateLimitBackend, "rate-limit-backend", "memory", "where failed login counters live: memory for a single node or db to share lockouts across instances")
+	f.StringVar(&p.ChallengeKeyPath, "challenge-key", "", "path to PEM RSA private key for identity challenges, empty disables them")
+	f.StringVar(&p.AdminAPIAddr, "admin-addr", "", "address for the HTTP admin API, empty disables it")
+	f.StringVar(&p.AdminOrigins, "admin-allow-origin", "", "comma-separated origins allowed to call the admin API from a browser")
+	f.StringVar(&p.ServerStorageKey, "server-storage-key", "", "path to AES key file encrypting the files path column, empty disables it")
+	f.UintVar(&p.TokenDuration, "td", 60, "how much token to be valid in minutes")
+	f.UintVar(&p.ChunkSize, "cs", 1024, "how much bytes grpc server push on client")
+	f.BoolVar(&p.AllowEmptyFiles, "allow-empty-files", false, "allow storing files without content")
+	f.BoolVar(&p.StrictLogRedaction, "strict-log-redaction", false, "guard log output against configured secrets: panic on DEBUG level, alert otherwise")
+	f.BoolVar(&p.DBWarmup, "db-warmup", false, "open and ping the pool's minimum connections on startup so the first request is not penalized")
+	f.BoolVar(&p.Version, "version", false, "print build information and exit")
+	f.UintVar(&p.RequestTimeout, "request-timeout", 30, "default deadline for unary requests without one in seconds")
+	f.UintVar(&p.StreamRequestTimeout, "stream-request-timeout", 300, "default deadline for streaming requests without one in seconds")
+	f.UintVar(&p.MaxConnectionIdle, "max-conn-idle", 300, "how long a connection can stay idle in seconds, 0 disables")
+	f.UintVar(&p.MaxConnectionAge, "max-conn-age", 0, "how long a connection can live in seconds, 0 disables")
+	f.UintVar(&p.MinPingInterval, "min-ping-interval", 5, "minimal interval between client pings in seconds")
+	f.UintVar(&p.MaxConcurrentStreams, "max-concurrent-streams", 100, "maximum concurrent streams one connection may open, 0 disables the limit")
+	f.UintVar(&p.ListLimit, "list-limit", 1000, "maximum entries returned per list call, responses over the cap are truncated and flagged")
+	f.UintVar(&p.IntegrityScanInterval, "integrity-scan-interval", 0, "minutes between blob integrity scans, 0 disables the background scan")
+
+	f.Parse(os.Args[1:])
+
+	if DSN := os.Getenv("DSN"); DSN != "" {
+		p.DSN = DSN
+	}
+
+	if readDSN := os.Getenv("READ_DSN"); readDSN != "" {
+		p.ReadDSN = readDSN
+	}
+
+	if tokenSecret := os.Getenv("TOKEN_SECRET"); tokenSecret != "" {
+		p.TokenSecret = tokenSecret
+	}
+
+	if adminToken := os.Getenv("ADMIN_TOKEN"); adminToken != "" {
+		p.AdminToken = adminToken
+	}
+
+	if pathToFileStorage := os.Getenv("FILE_STORAGE_PATH"); pathToFileStorage != "" {
+		p.PathToFileStorage = pathToFileStorage
+	}
+
+	if grpcAddr := os.Getenv("GRPC_ADDR"); grpcAddr != "" {
+		p.GRPCAddr = grpcAddr
+	}
+
+	if logLevel := os.Getenv("LOG_LEVEL"); logLevel != "" {
+		p.LogLevel = logLevel
+	}
+
+	if queryExecMode := os.Getenv("QUERY_EXEC_MODE"); queryExecMode != "" {
+		p.QueryExecMode = queryExecMode
+	}
+
+	if fileBackend := os.Getenv("FILE_BACKEND"); fileBackend != "" {
+		p.FileBackend = fileBackend
+	}
+
+	if rateLimitBackend := os.Getenv("RATE_LIMIT_BACKEND"); rateLimitBackend != "" {
+		p.RateLimitBackend = rateLimitBackend
+	}
+
+	if challengeKeyPath := os.Getenv("CHALLENGE_KEY_PATH"); challengeKeyPath != "" {
+		p.ChallengeKeyPath = challengeKeyPath
+	}
+
+	if adminAPIAddr := os.Getenv("ADMIN_ADDR"); adminAPIAddr != "" {
+		p.AdminAPIAddr = adminAPIAddr
+	}
+
+	if adminOrigins := os.Getenv("ADMIN_ALLOW_ORIGIN"); adminOrigins != "" {
+		p.AdminOrigins = adminOrigins
+	}
+
+	if serverStorageKey := os.Getenv("SERVER_STORAGE_KEY"); serverStorageKey != "" {
+		p.ServerStorageKey = serverStorageKey
+	}
+
+	if tokenDuration := os.Getenv("TOKEN_DURATION"); tokenDuration != "" {
+		intTD, err := strconv.ParseUint(tokenDuration, 10, 32)
+
+		if err == nil {
+			p.TokenDuration = uint(intTD)
+		}
+	}
+
+	if chunkSize := os.Getenv("CHUNK_SIZE"); chunkSize != "" {
+		intCS, err := strconv.ParseUint(chunkSize, 10, 32)
+
+		if err == nil {
+			p.ChunkSize = uint(intCS)
+		}
+	}
+
+	if requestTimeout := os.Getenv("REQUEST_TIMEOUT"); requestTimeout != "" {
+		intRT, err := strconv.ParseUint(requestTimeout, 10, 32)
+
+		if err == nil {
+			p.RequestTimeout = uint(intRT)
+		}
+	}
+
+	if streamRequestTimeout := os.Getenv("STREAM_REQUEST_TIMEOUT"); streamRequestTimeout != "" {
+		intSRT, err := strconv.ParseUint(streamRequestTimeout, 10, 32)
+
+		if err == nil {
+			p.StreamRequestTimeout = uint(intSRT)
+		}
+	}
+
+	if maxConnectionIdle := os.Getenv("MAX_CONN_IDLE"); maxConnectionIdle != "" {
+		intMCI, err := strconv.ParseUint(maxConnectionIdle, 10, 32)
+
+		if err == nil {
+			p.MaxConnectionIdle = uint(intMCI)
+		}
+	}
+
+	if maxConnectionAge := os.Getenv("MAX_CONN_AGE"); maxConnectionAge != "" {
+		intMCA, err := strconv.ParseUint(maxConnectionAge, 10, 32)
+
+		if err == nil {
+			p.MaxConnectionAge = uint(intMCA)
+		}
+	}
+
+	if minPingInterval := os.Getenv("MIN_PING_INTERVAL"); minPingInterval != "" {
+		intMPI, err := strconv.ParseUint(minPingInterval, 10, 32)
+
+		if err == nil {
+			p.MinPingInterval = uint(intMPI)
+		}
+	}
+
+	if maxConcurrentStreams := os.Getenv("MAX_CONCURRENT_STREAMS"); maxConcurrentStreams != "" {
+		intMCS, err := strconv.ParseUint(maxConcurrentStreams, 10, 32)
+
+		if err == nil {
+			p.MaxConcurrentStreams = uint(intMCS)
+		}
+	}
+
+	if listLimit := os.Getenv("LIST_LIMIT"); listLimit != "" {
+		intLL, err := strconv.ParseUint(listLimit, 10, 32)
+
+		if err == nil {
+			p.ListLimit = uint(intLL)
+		}
+	}
+
+	if integrityScanInterval := os.Getenv("INTEGRITY_SCAN_INTERVAL"); integrityScanInterval != "" {
+		intISI, err := strconv.ParseUint(integrityScanInterval, 10, 32)
+
+		if err == nil {
+			p.IntegrityScanInterval = uint(intISI)
+		}
+	}
+
+	if allowEmptyFiles := os.Getenv("ALLOW_EMPTY_FILES"); allowEmptyFiles != "" {
+		boolAEF, err := strconv.ParseBool(allowEmptyFiles)
+
+		if err == nil {
+			p.AllowEmptyFiles = boolAEF
+		}
+	}
+
+	if strictLogRedaction := os.Getenv("STRICT_LOG_REDACTION"); strictLogRedaction != "" {
+		boolSLR, err := strconv.ParseBool(strictLogRedaction)
+
+		if err == nil {
+			p.StrictLogRedaction = boolSLR
+		}
+	}
+
+	if dbWarmup := os.Getenv("DB_WARMUP"); dbWarmup != "" {
+		boolDW, err := strconv.ParseBool(dbWarmup)
+
+		if err == nil {
+			p.DBWarmup = boolDW
+		}
+	}
+
+	return
+}
@@ -0,0 +1,11529 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: gophkeeper/v1/gophkeeper.proto
+
+package proto
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on RegisterRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RegisterRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RegisterRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RegisterRequestMultiError, or nil if none found.
+func (m *RegisterRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RegisterRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Login
+
+	// no validation rules for Password
+
+	if len(errors) > 0 {
+		return RegisterRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RegisterRequestMultiError is an error wrapping multiple validation errors
+// returned by RegisterRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RegisterRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RegisterRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RegisterRequestMultiError) AllErrors() []error { return m }
+
+// RegisterRequestValidationError is the validation error returned by
+// RegisterRequest.Validate if the designated constraints aren't met.
+type RegisterRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RegisterRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RegisterRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RegisterRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RegisterRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RegisterRequestValidationError) ErrorName() string { return "RegisterRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RegisterRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRegisterRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RegisterRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RegisterRequestValidationError{}
+
+// Validate checks the field values on RegisterResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RegisterResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RegisterResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RegisterResponseMultiError, or nil if none found.
+func (m *RegisterResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RegisterResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if len(errors) > 0 {
+		return RegisterResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RegisterResponseMultiError is an error wrapping multiple validation errors
+// returned by RegisterResponse.ValidateAll() if the designated constraints
+// aren't met.
+type RegisterResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RegisterResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RegisterResponseMultiError) AllErrors() []error { return m }
+
+// RegisterResponseValidationError is the validation error returned by
+// RegisterResponse.Validate if the designated constraints aren't met.
+type RegisterResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RegisterResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RegisterResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RegisterResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RegisterResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RegisterResponseValidationError) ErrorName() string { return "RegisterResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RegisterResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRegisterResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RegisterResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RegisterResponseValidationError{}
+
+// Validate checks the field values on AuthRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AuthRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuthRequestMultiError, or
+// nil if none found.
+func (m *AuthRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Login
+
+	// no validation rules for Password
+
+	if len(errors) > 0 {
+		return AuthRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthRequestMultiError is an error wrapping multiple validation errors
+// returned by AuthRequest.ValidateAll() if the designated constraints aren't met.
+type AuthRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthRequestMultiError) AllErrors() []error { return m }
+
+// AuthRequestValidationError is the validation error returned by
+// AuthRequest.Validate if the designated constraints aren't met.
+type AuthRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthRequestValidationError) ErrorName() string { return "AuthRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuthRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthRequestValidationError{}
+
+// Validate checks the field values on AuthResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AuthResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuthResponseMultiError, or
+// nil if none found.
+func (m *AuthResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if len(errors) > 0 {
+		return AuthResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthResponseMultiError is an error wrapping multiple validation errors
+// returned by AuthResponse.ValidateAll() if the designated constraints aren't met.
+type AuthResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthResponseMultiError) AllErrors() []error { return m }
+
+// AuthResponseValidationError is the validation error returned by
+// AuthResponse.Validate if the designated constraints aren't met.
+type AuthResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthResponseValidationError) ErrorName() string { return "AuthResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuthResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthResponseValidationError{}
+
+// Validate checks the field values on ChallengeRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ChallengeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChallengeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ChallengeRequestMultiError, or nil if none found.
+func (m *ChallengeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChallengeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Nonce
+
+	if len(errors) > 0 {
+		return ChallengeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChallengeRequestMultiError is an error wrapping multiple validation errors
+// returned by ChallengeRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ChallengeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChallengeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChallengeRequestMultiError) AllErrors() []error { return m }
+
+// ChallengeRequestValidationError is the validation error returned by
+// ChallengeRequest.Validate if the designated constraints aren't met.
+type ChallengeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChallengeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChallengeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChallengeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChallengeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChallengeRequestValidationError) ErrorName() string { return "ChallengeRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ChallengeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChallengeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChallengeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChallengeRequestValidationError{}
+
+// Validate checks the field values on ChallengeResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ChallengeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChallengeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ChallengeResponseMultiError, or nil if none found.
+func (m *ChallengeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChallengeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Signature
+
+	if len(errors) > 0 {
+		return ChallengeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChallengeResponseMultiError is an error wrapping multiple validation errors
+// returned by ChallengeResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ChallengeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChallengeResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChallengeResponseMultiError) AllErrors() []error { return m }
+
+// ChallengeResponseValidationError is the validation error returned by
+// ChallengeResponse.Validate if the designated constraints aren't met.
+type ChallengeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChallengeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChallengeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChallengeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChallengeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChallengeResponseValidationError) ErrorName() string {
+	return "ChallengeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ChallengeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChallengeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChallengeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChallengeResponseValidationError{}
+
+// Validate checks the field values on Password with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Password) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Password with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PasswordMultiError, or nil
+// if none found.
+func (m *Password) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Password) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Login
+
+	// no validation rules for Password
+
+	// no validation rules for Meta
+
+	if all {
+		switch v := interface{}(m.GetUpdateAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PasswordValidationError{
+					field:  "UpdateAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PasswordValidationError{
+					field:  "UpdateAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PasswordValidationError{
+				field:  "UpdateAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for WrappedKey
+
+	// no validation rules for Pinned
+
+	// no validation rules for RequiresReauth
+
+	if len(errors) > 0 {
+		return PasswordMultiError(errors)
+	}
+
+	return nil
+}
+
+// PasswordMultiError is an error wrapping multiple validation errors returned
+// by Password.ValidateAll() if the designated constraints aren't met.
+type PasswordMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PasswordMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PasswordMultiError) AllErrors() []error { return m }
+
+// PasswordValidationError is the validation error returned by
+// Password.Validate if the designated constraints aren't met.
+type PasswordValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PasswordValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PasswordValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PasswordValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PasswordValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PasswordValidationError) ErrorName() string { return "PasswordValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PasswordValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPassword.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PasswordValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PasswordValidationError{}
+
+// Validate checks the field values on CreatePasswordRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreatePasswordRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreatePasswordRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreatePasswordRequestMultiError, or nil if none found.
+func (m *CreatePasswordRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreatePasswordRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Login
+
+	// no validation rules for Password
+
+	// no validation rules for Meta
+
+	// no validation rules for WrappedKey
+
+	// no validation rules for Fingerprint
+
+	// no validation rules for Pinned
+
+	// no validation rules for RequiresReauth
+
+	if len(errors) > 0 {
+		return CreatePasswordRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreatePasswordRequestMultiError is an error wrapping multiple validation
+// errors returned by CreatePasswordRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreatePasswordRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreatePasswordRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreatePasswordRequestMultiError) AllErrors() []error { return m }
+
+// CreatePasswordRequestValidationError is the validation error returned by
+// CreatePasswordRequest.Validate if the designated constraints aren't met.
+type CreatePasswordRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreatePasswordRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreatePasswordRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreatePasswordRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreatePasswordRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreatePasswordRequestValidationError) ErrorName() string {
+	return "CreatePasswordRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreatePasswordRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreatePasswordRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreatePasswordRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreatePasswordRequestValidationError{}
+
+// Validate checks the field values on CreatePasswordResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreatePasswordResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreatePasswordResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreatePasswordResponseMultiError, or nil if none found.
+func (m *CreatePasswordResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreatePasswordResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return CreatePasswordResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreatePasswordResponseMultiError is an error wrapping multiple validation
+// errors returned by CreatePasswordResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreatePasswordResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreatePasswordResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreatePasswordResponseMultiError) AllErrors() []error { return m }
+
+// CreatePasswordResponseValidationError is the validation error returned by
+// CreatePasswordResponse.Validate if the designated constraints aren't met.
+type CreatePasswordResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreatePasswordResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreatePasswordResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreatePasswordResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreatePasswordResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreatePasswordResponseValidationError) ErrorName() string {
+	return "CreatePasswordResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreatePasswordResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreatePasswordResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreatePasswordResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreatePasswordResponseValidationError{}
+
+// Validate checks the field values on UpdatePasswordRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdatePasswordRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdatePasswordRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdatePasswordRequestMultiError, or nil if none found.
+func (m *UpdatePasswordRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdatePasswordRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Login
+
+	// no validation rules for Password
+
+	// no validation rules for Meta
+
+	// no validation rules for WrappedKey
+
+	// no validation rules for Fingerprint
+
+	// no validation rules for Pinned
+
+	// no validation rules for RequiresReauth
+
+	if len(errors) > 0 {
+		return UpdatePasswordRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdatePasswordRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdatePasswordRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdatePasswordRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdatePasswordRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdatePasswordRequestMultiError) AllErrors() []error { return m }
+
+// UpdatePasswordRequestValidationError is the validation error returned by
+// UpdatePasswordRequest.Validate if the designated constraints aren't met.
+type UpdatePasswordRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdatePasswordRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdatePasswordRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdatePasswordRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdatePasswordRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdatePasswordRequestValidationError) ErrorName() string {
+	return "UpdatePasswordRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdatePasswordRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdatePasswordRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdatePasswordRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdatePasswordRequestValidationError{}
+
+// Validate checks the field values on UpdatePasswordResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdatePasswordResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdatePasswordResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdatePasswordResponseMultiError, or nil if none found.
+func (m *UpdatePasswordResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdatePasswordResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return UpdatePasswordResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdatePasswordResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdatePasswordResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdatePasswordResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdatePasswordResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdatePasswordResponseMultiError) AllErrors() []error { return m }
+
+// UpdatePasswordResponseValidationError is the validation error returned by
+// UpdatePasswordResponse.Validate if the designated constraints aren't met.
+type UpdatePasswordResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdatePasswordResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdatePasswordResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdatePasswordResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdatePasswordResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdatePasswordResponseValidationError) ErrorName() string {
+	return "UpdatePasswordResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdatePasswordResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdatePasswordResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdatePasswordResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdatePasswordResponseValidationError{}
+
+// Validate checks the field values on GetPasswordRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPasswordRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPasswordRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPasswordRequestMultiError, or nil if none found.
+func (m *GetPasswordRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPasswordRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetIfModifiedSince()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetPasswordRequestValidationError{
+					field:  "IfModifiedSince",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetPasswordRequestValidationError{
+					field:  "IfModifiedSince",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetIfModifiedSince()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetPasswordRequestValidationError{
+				field:  "IfModifiedSince",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetPasswordRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPasswordRequestMultiError is an error wrapping multiple validation errors
+// returned by GetPasswordRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetPasswordRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPasswordRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPasswordRequestMultiError) AllErrors() []error { return m }
+
+// GetPasswordRequestValidationError is the validation error returned by
+// GetPasswordRequest.Validate if the designated constraints aren't met.
+type GetPasswordRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPasswordRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPasswordRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPasswordRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPasswordRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPasswordRequestValidationError) ErrorName() string {
+	return "GetPasswordRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPasswordRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPasswordRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPasswordRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPasswordRequestValidationError{}
+
+// Validate checks the field values on GetPasswordResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPasswordResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPasswordResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPasswordResponseMultiError, or nil if none found.
+func (m *GetPasswordResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPasswordResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPassword()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetPasswordResponseValidationError{
+					field:  "Password",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetPasswordResponseValidationError{
+					field:  "Password",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPassword()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetPasswordResponseValidationError{
+				field:  "Password",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for NotModified
+
+	if len(errors) > 0 {
+		return GetPasswordResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPasswordResponseMultiError is an error wrapping multiple validation
+// errors returned by GetPasswordResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetPasswordResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPasswordResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPasswordResponseMultiError) AllErrors() []error { return m }
+
+// GetPasswordResponseValidationError is the validation error returned by
+// GetPasswordResponse.Validate if the designated constraints aren't met.
+type GetPasswordResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPasswordResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPasswordResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPasswordResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPasswordResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPasswordResponseValidationError) ErrorName() string {
+	return "GetPasswordResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPasswordResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPasswordResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPasswordResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPasswordResponseValidationError{}
+
+// Validate checks the field values on GetPasswordsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPasswordsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPasswordsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPasswordsRequestMultiError, or nil if none found.
+func (m *GetPasswordsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPasswordsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetPasswordsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPasswordsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetPasswordsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetPasswordsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPasswordsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPasswordsRequestMultiError) AllErrors() []error { return m }
+
+// GetPasswordsRequestValidationError is the validation error returned by
+// GetPasswordsRequest.Validate if the designated constraints aren't met.
+type GetPasswordsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPasswordsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPasswordsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPasswordsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPasswordsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPasswordsRequestValidationError) ErrorName() string {
+	return "GetPasswordsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPasswordsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPasswordsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPasswordsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPasswordsRequestValidationError{}
+
+// Validate checks the field values on GetPasswordsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPasswordsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPasswordsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPasswordsResponseMultiError, or nil if none found.
+func (m *GetPasswordsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPasswordsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPasswords() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetPasswordsResponseValidationError{
+						field:  fmt.Sprintf("Passwords[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetPasswordsResponseValidationError{
+						field:  fmt.Sprintf("Passwords[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetPasswordsResponseValidationError{
+					field:  fmt.Sprintf("Passwords[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Truncated
+
+	if len(errors) > 0 {
+		return GetPasswordsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPasswordsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetPasswordsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetPasswordsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPasswordsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPasswordsResponseMultiError) AllErrors() []error { return m }
+
+// GetPasswordsResponseValidationError is the validation error returned by
+// GetPasswordsResponse.Validate if the designated constraints aren't met.
+type GetPasswordsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPasswordsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPasswordsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPasswordsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPasswordsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPasswordsResponseValidationError) ErrorName() string {
+	return "GetPasswordsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPasswordsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPasswordsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPasswordsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPasswordsResponseValidationError{}
+
+// Validate checks the field values on GetPasswordsByCategoryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPasswordsByCategoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPasswordsByCategoryRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetPasswordsByCategoryRequestMultiError, or nil if none found.
+func (m *GetPasswordsByCategoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPasswordsByCategoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Category
+
+	if len(errors) > 0 {
+		return GetPasswordsByCategoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPasswordsByCategoryRequestMultiError is an error wrapping multiple
+// validation errors returned by GetPasswordsByCategoryRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetPasswordsByCategoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPasswordsByCategoryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPasswordsByCategoryRequestMultiError) AllErrors() []error { return m }
+
+// GetPasswordsByCategoryRequestValidationError is the validation error
+// returned by GetPasswordsByCategoryRequest.Validate if the designated
+// constraints aren't met.
+type GetPasswordsByCategoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPasswordsByCategoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPasswordsByCategoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPasswordsByCategoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPasswordsByCategoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPasswordsByCategoryRequestValidationError) ErrorName() string {
+	return "GetPasswordsByCategoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPasswordsByCategoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPasswordsByCategoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPasswordsByCategoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPasswordsByCategoryRequestValidationError{}
+
+// Validate checks the field values on GetPasswordsByCategoryResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPasswordsByCategoryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPasswordsByCategoryResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetPasswordsByCategoryResponseMultiError, or nil if none found.
+func (m *GetPasswordsByCategoryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPasswordsByCategoryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPasswords() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetPasswordsByCategoryResponseValidationError{
+						field:  fmt.Sprintf("Passwords[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetPasswordsByCategoryResponseValidationError{
+						field:  fmt.Sprintf("Passwords[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetPasswordsByCategoryResponseValidationError{
+					field:  fmt.Sprintf("Passwords[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Truncated
+
+	if len(errors) > 0 {
+		return GetPasswordsByCategoryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPasswordsByCategoryResponseMultiError is an error wrapping multiple
+// validation errors returned by GetPasswordsByCategoryResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetPasswordsByCategoryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPasswordsByCategoryResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPasswordsByCategoryResponseMultiError) AllErrors() []error { return m }
+
+// GetPasswordsByCategoryResponseValidationError is the validation error
+// returned by GetPasswordsByCategoryResponse.Validate if the designated
+// constraints aren't met.
+type GetPasswordsByCategoryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPasswordsByCategoryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPasswordsByCategoryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPasswordsByCategoryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPasswordsByCategoryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPasswordsByCategoryResponseValidationError) ErrorName() string {
+	return "GetPasswordsByCategoryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPasswordsByCategoryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPasswordsByCategoryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPasswordsByCategoryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPasswordsByCategoryResponseValidationError{}
+
+// Validate checks the field values on DeletePasswordRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeletePasswordRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeletePasswordRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeletePasswordRequestMultiError, or nil if none found.
+func (m *DeletePasswordRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeletePasswordRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeletePasswordRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeletePasswordRequestMultiError is an error wrapping multiple validation
+// errors returned by DeletePasswordRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeletePasswordRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeletePasswordRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeletePasswordRequestMultiError) AllErrors() []error { return m }
+
+// DeletePasswordRequestValidationError is the validation error returned by
+// DeletePasswordRequest.Validate if the designated constraints aren't met.
+type DeletePasswordRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeletePasswordRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeletePasswordRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeletePasswordRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeletePasswordRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeletePasswordRequestValidationError) ErrorName() string {
+	return "DeletePasswordRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeletePasswordRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeletePasswordRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeletePasswordRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeletePasswordRequestValidationError{}
+
+// Validate checks the field values on DeletePasswordResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeletePasswordResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeletePasswordResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeletePasswordResponseMultiError, or nil if none found.
+func (m *DeletePasswordResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeletePasswordResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeletePasswordResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeletePasswordResponseMultiError is an error wrapping multiple validation
+// errors returned by DeletePasswordResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeletePasswordResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeletePasswordResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeletePasswordResponseMultiError) AllErrors() []error { return m }
+
+// DeletePasswordResponseValidationError is the validation error returned by
+// DeletePasswordResponse.Validate if the designated constraints aren't met.
+type DeletePasswordResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeletePasswordResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeletePasswordResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeletePasswordResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeletePasswordResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeletePasswordResponseValidationError) ErrorName() string {
+	return "DeletePasswordResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeletePasswordResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeletePasswordResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeletePasswordResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeletePasswordResponseValidationError{}
+
+// Validate checks the field values on TouchPasswordRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TouchPasswordRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TouchPasswordRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TouchPasswordRequestMultiError, or nil if none found.
+func (m *TouchPasswordRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TouchPasswordRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return TouchPasswordRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TouchPasswordRequestMultiError is an error wrapping multiple validation
+// errors returned by TouchPasswordRequest.ValidateAll() if the designated
+// constraints aren't met.
+type TouchPasswordRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TouchPasswordRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TouchPasswordRequestMultiError) AllErrors() []error { return m }
+
+// TouchPasswordRequestValidationError is the validation error returned by
+// TouchPasswordRequest.Validate if the designated constraints aren't met.
+type TouchPasswordRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TouchPasswordRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TouchPasswordRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TouchPasswordRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TouchPasswordRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TouchPasswordRequestValidationError) ErrorName() string {
+	return "TouchPasswordRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TouchPasswordRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTouchPasswordRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TouchPasswordRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TouchPasswordRequestValidationError{}
+
+// Validate checks the field values on TouchPasswordResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TouchPasswordResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TouchPasswordResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TouchPasswordResponseMultiError, or nil if none found.
+func (m *TouchPasswordResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TouchPasswordResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetUpdateAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TouchPasswordResponseValidationError{
+					field:  "UpdateAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TouchPasswordResponseValidationError{
+					field:  "UpdateAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TouchPasswordResponseValidationError{
+				field:  "UpdateAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TouchPasswordResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TouchPasswordResponseMultiError is an error wrapping multiple validation
+// errors returned by TouchPasswordResponse.ValidateAll() if the designated
+// constraints aren't met.
+type TouchPasswordResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TouchPasswordResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TouchPasswordResponseMultiError) AllErrors() []error { return m }
+
+// TouchPasswordResponseValidationError is the validation error returned by
+// TouchPasswordResponse.Validate if the designated constraints aren't met.
+type TouchPasswordResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TouchPasswordResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TouchPasswordResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TouchPasswordResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TouchPasswordResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TouchPasswordResponseValidationError) ErrorName() string {
+	return "TouchPasswordResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TouchPasswordResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTouchPasswordResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TouchPasswordResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TouchPasswordResponseValidationError{}
+
+// Validate checks the field values on ReusedPasswords with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReusedPasswords) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReusedPasswords with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReusedPasswordsMultiError, or nil if none found.
+func (m *ReusedPasswords) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReusedPasswords) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Fingerprint
+
+	if len(errors) > 0 {
+		return ReusedPasswordsMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReusedPasswordsMultiError is an error wrapping multiple validation errors
+// returned by ReusedPasswords.ValidateAll() if the designated constraints
+// aren't met.
+type ReusedPasswordsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReusedPasswordsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReusedPasswordsMultiError) AllErrors() []error { return m }
+
+// ReusedPasswordsValidationError is the validation error returned by
+// ReusedPasswords.Validate if the designated constraints aren't met.
+type ReusedPasswordsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReusedPasswordsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReusedPasswordsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReusedPasswordsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReusedPasswordsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReusedPasswordsValidationError) ErrorName() string { return "ReusedPasswordsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReusedPasswordsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReusedPasswords.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReusedPasswordsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReusedPasswordsValidationError{}
+
+// Validate checks the field values on GetReusedPasswordsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetReusedPasswordsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetReusedPasswordsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetReusedPasswordsRequestMultiError, or nil if none found.
+func (m *GetReusedPasswordsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetReusedPasswordsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetReusedPasswordsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetReusedPasswordsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetReusedPasswordsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetReusedPasswordsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetReusedPasswordsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetReusedPasswordsRequestMultiError) AllErrors() []error { return m }
+
+// GetReusedPasswordsRequestValidationError is the validation error returned by
+// GetReusedPasswordsRequest.Validate if the designated constraints aren't met.
+type GetReusedPasswordsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetReusedPasswordsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetReusedPasswordsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetReusedPasswordsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetReusedPasswordsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetReusedPasswordsRequestValidationError) ErrorName() string {
+	return "GetReusedPasswordsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetReusedPasswordsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetReusedPasswordsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetReusedPasswordsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetReusedPasswordsRequestValidationError{}
+
+// Validate checks the field values on GetReusedPasswordsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetReusedPasswordsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetReusedPasswordsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetReusedPasswordsResponseMultiError, or nil if none found.
+func (m *GetReusedPasswordsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetReusedPasswordsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetReused() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetReusedPasswordsResponseValidationError{
+						field:  fmt.Sprintf("Reused[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetReusedPasswordsResponseValidationError{
+						field:  fmt.Sprintf("Reused[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetReusedPasswordsResponseValidationError{
+					field:  fmt.Sprintf("Reused[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetReusedPasswordsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetReusedPasswordsResponseMultiError is an error wrapping multiple
+// validation errors returned by GetReusedPasswordsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetReusedPasswordsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetReusedPasswordsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetReusedPasswordsResponseMultiError) AllErrors() []error { return m }
+
+// GetReusedPasswordsResponseValidationError is the validation error returned
+// by GetReusedPasswordsResponse.Validate if the designated constraints aren't met.
+type GetReusedPasswordsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetReusedPasswordsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetReusedPasswordsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetReusedPasswordsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetReusedPasswordsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetReusedPasswordsResponseValidationError) ErrorName() string {
+	return "GetReusedPasswordsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetReusedPasswordsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetReusedPasswordsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetReusedPasswordsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetReusedPasswordsResponseValidationError{}
+
+// Validate checks the field values on File with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *File) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on File with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in FileMultiError, or nil if none found.
+func (m *File) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *File) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Meta
+
+	if all {
+		switch v := interface{}(m.GetUpdateAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FileValidationError{
+					field:  "UpdateAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FileValidationError{
+					field:  "UpdateAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FileValidationError{
+				field:  "UpdateAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Pinned
+
+	// no validation rules for RequiresReauth
+
+	if len(errors) > 0 {
+		return FileMultiError(errors)
+	}
+
+	return nil
+}
+
+// FileMultiError is an error wrapping multiple validation errors returned by
+// File.ValidateAll() if the designated constraints aren't met.
+type FileMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FileMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FileMultiError) AllErrors() []error { return m }
+
+// FileValidationError is the validation error returned by File.Validate if the
+// designated constraints aren't met.
+type FileValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FileValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FileValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FileValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FileValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FileValidationError) ErrorName() string { return "FileValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FileValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFile.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FileValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FileValidationError{}
+
+// Validate checks the field values on CreateFileRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateFileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateFileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateFileRequestMultiError, or nil if none found.
+func (m *CreateFileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateFileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Data.(type) {
+	case *CreateFileRequest_FileInfo:
+		if v == nil {
+			err := CreateFileRequestValidationError{
+				field:  "Data",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetFileInfo()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateFileRequestValidationError{
+						field:  "FileInfo",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateFileRequestValidationError{
+						field:  "FileInfo",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetFileInfo()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateFileRequestValidationError{
+					field:  "FileInfo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *CreateFileRequest_Content:
+		if v == nil {
+			err := CreateFileRequestValidationError{
+				field:  "Data",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Content
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return CreateFileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateFileRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateFileRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateFileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateFileRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateFileRequestMultiError) AllErrors() []error { return m }
+
+// CreateFileRequestValidationError is the validation error returned by
+// CreateFileRequest.Validate if the designated constraints aren't met.
+type CreateFileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateFileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateFileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateFileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateFileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateFileRequestValidationError) ErrorName() string {
+	return "CreateFileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateFileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateFileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateFileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateFileRequestValidationError{}
+
+// Validate checks the field values on CreateFileResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateFileResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateFileResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateFileResponseMultiError, or nil if none found.
+func (m *CreateFileResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateFileResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return CreateFileResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateFileResponseMultiError is an error wrapping multiple validation errors
+// returned by CreateFileResponse.ValidateAll() if the designated constraints
+// aren't met.
+type CreateFileResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateFileResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateFileResponseMultiError) AllErrors() []error { return m }
+
+// CreateFileResponseValidationError is the validation error returned by
+// CreateFileResponse.Validate if the designated constraints aren't met.
+type CreateFileResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateFileResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateFileResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateFileResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateFileResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateFileResponseValidationError) ErrorName() string {
+	return "CreateFileResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateFileResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateFileResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateFileResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateFileResponseValidationError{}
+
+// Validate checks the field values on CreateFileStreamRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateFileStreamRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateFileStreamRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateFileStreamRequestMultiError, or nil if none found.
+func (m *CreateFileStreamRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateFileStreamRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Data.(type) {
+	case *CreateFileStreamRequest_FileInfo:
+		if v == nil {
+			err := CreateFileStreamRequestValidationError{
+				field:  "Data",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetFileInfo()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateFileStreamRequestValidationError{
+						field:  "FileInfo",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateFileStreamRequestValidationError{
+						field:  "FileInfo",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetFileInfo()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateFileStreamRequestValidationError{
+					field:  "FileInfo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *CreateFileStreamRequest_Content:
+		if v == nil {
+			err := CreateFileStreamRequestValidationError{
+				field:  "Data",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Content
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return CreateFileStreamRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateFileStreamRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateFileStreamRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateFileStreamRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateFileStreamRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateFileStreamRequestMultiError) AllErrors() []error { return m }
+
+// CreateFileStreamRequestValidationError is the validation error returned by
+// CreateFileStreamRequest.Validate if the designated constraints aren't met.
+type CreateFileStreamRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateFileStreamRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateFileStreamRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateFileStreamRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateFileStreamRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateFileStreamRequestValidationError) ErrorName() string {
+	return "CreateFileStreamRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateFileStreamRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateFileStreamRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateFileStreamRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateFileStreamRequestValidationError{}
+
+// Validate checks the field values on CreateFileStreamResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateFileStreamResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateFileStreamResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateFileStreamResponseMultiError, or nil if none found.
+func (m *CreateFileStreamResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateFileStreamResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Data.(type) {
+	case *CreateFileStreamResponse_BytesReceived:
+		if v == nil {
+			err := CreateFileStreamResponseValidationError{
+				field:  "Data",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for BytesReceived
+	case *CreateFileStreamResponse_Id:
+		if v == nil {
+			err := CreateFileStreamResponseValidationError{
+				field:  "Data",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Id
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return CreateFileStreamResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateFileStreamResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateFileStreamResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateFileStreamResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateFileStreamResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateFileStreamResponseMultiError) AllErrors() []error { return m }
+
+// CreateFileStreamResponseValidationError is the validation error returned by
+// CreateFileStreamResponse.Validate if the designated constraints aren't met.
+type CreateFileStreamResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateFileStreamResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateFileStreamResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateFileStreamResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateFileStreamResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateFileStreamResponseValidationError) ErrorName() string {
+	return "CreateFileStreamResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateFileStreamResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateFileStreamResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateFileStreamResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateFileStreamResponseValidationError{}
+
+// Validate checks the field values on UpdateFileRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateFileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateFileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateFileRequestMultiError, or nil if none found.
+func (m *UpdateFileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateFileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Data.(type) {
+	case *UpdateFileRequest_FileInfo:
+		if v == nil {
+			err := UpdateFileRequestValidationError{
+				field:  "Data",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetFileInfo()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateFileRequestValidationError{
+						field:  "FileInfo",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateFileRequestValidationError{
+						field:  "FileInfo",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetFileInfo()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateFileRequestValidationError{
+					field:  "FileInfo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *UpdateFileRequest_Content:
+		if v == nil {
+			err := UpdateFileRequestValidationError{
+				field:  "Data",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Content
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return UpdateFileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateFileRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateFileRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateFileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateFileRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateFileRequestMultiError) AllErrors() []error { return m }
+
+// UpdateFileRequestValidationError is the validation error returned by
+// UpdateFileRequest.Validate if the designated constraints aren't met.
+type UpdateFileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateFileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateFileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateFileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateFileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateFileRequestValidationError) ErrorName() string {
+	return "UpdateFileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateFileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateFileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateFileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateFileRequestValidationError{}
+
+// Validate checks the field values on UpdateFileResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateFileResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateFileResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateFileResponseMultiError, or nil if none found.
+func (m *UpdateFileResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateFileResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return UpdateFileResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateFileResponseMultiError is an error wrapping multiple validation errors
+// returned by UpdateFileResponse.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateFileResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateFileResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateFileResponseMultiError) AllErrors() []error { return m }
+
+// UpdateFileResponseValidationError is the validation error returned by
+// UpdateFileResponse.Validate if the designated constraints aren't met.
+type UpdateFileResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateFileResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateFileResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateFileResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateFileResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateFileResponseValidationError) ErrorName() string {
+	return "UpdateFileResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateFileResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateFileResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateFileResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateFileResponseValidationError{}
+
+// Validate checks the field values on UpdateFileInfoRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateFileInfoRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateFileInfoRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateFileInfoRequestMultiError, or nil if none found.
+func (m *UpdateFileInfoRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateFileInfoRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Meta
+
+	// no validation rules for Pinned
+
+	// no validation rules for RequiresReauth
+
+	if len(errors) > 0 {
+		return UpdateFileInfoRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateFileInfoRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateFileInfoRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateFileInfoRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateFileInfoRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateFileInfoRequestMultiError) AllErrors() []error { return m }
+
+// UpdateFileInfoRequestValidationError is the validation error returned by
+// UpdateFileInfoRequest.Validate if the designated constraints aren't met.
+type UpdateFileInfoRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateFileInfoRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateFileInfoRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateFileInfoRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateFileInfoRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateFileInfoRequestValidationError) ErrorName() string {
+	return "UpdateFileInfoRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateFileInfoRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateFileInfoRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateFileInfoRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateFileInfoRequestValidationError{}
+
+// Validate checks the field values on UpdateFileInfoResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateFileInfoResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateFileInfoResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateFileInfoResponseMultiError, or nil if none found.
+func (m *UpdateFileInfoResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateFileInfoResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return UpdateFileInfoResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateFileInfoResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateFileInfoResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateFileInfoResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateFileInfoResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateFileInfoResponseMultiError) AllErrors() []error { return m }
+
+// UpdateFileInfoResponseValidationError is the validation error returned by
+// UpdateFileInfoResponse.Validate if the designated constraints aren't met.
+type UpdateFileInfoResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateFileInfoResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateFileInfoResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateFileInfoResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateFileInfoResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateFileInfoResponseValidationError) ErrorName() string {
+	return "UpdateFileInfoResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateFileInfoResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateFileInfoResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateFileInfoResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateFileInfoResponseValidationError{}
+
+// Validate checks the field values on GetFileRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetFileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetFileRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetFileRequestMultiError,
+// or nil if none found.
+func (m *GetFileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetFileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetFileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetFileRequestMultiError is an error wrapping multiple validation errors
+// returned by GetFileRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetFileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetFileRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetFileRequestMultiError) AllErrors() []error { return m }
+
+// GetFileRequestValidationError is the validation error returned by
+// GetFileRequest.Validate if the designated constraints aren't met.
+type GetFileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetFileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetFileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetFileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetFileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetFileRequestValidationError) ErrorName() string { return "GetFileRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetFileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetFileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetFileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetFileRequestValidationError{}
+
+// Validate checks the field values on GetFileResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetFileResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetFileResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetFileResponseMultiError, or nil if none found.
+func (m *GetFileResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetFileResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Data.(type) {
+	case *GetFileResponse_FileInfo:
+		if v == nil {
+			err := GetFileResponseValidationError{
+				field:  "Data",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetFileInfo()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetFileResponseValidationError{
+						field:  "FileInfo",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetFileResponseValidationError{
+						field:  "FileInfo",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetFileInfo()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetFileResponseValidationError{
+					field:  "FileInfo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *GetFileResponse_Content:
+		if v == nil {
+			err := GetFileResponseValidationError{
+				field:  "Data",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Content
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return GetFileResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetFileResponseMultiError is an error wrapping multiple validation errors
+// returned by GetFileResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetFileResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetFileResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetFileResponseMultiError) AllErrors() []error { return m }
+
+// GetFileResponseValidationError is the validation error returned by
+// GetFileResponse.Validate if the designated constraints aren't met.
+type GetFileResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetFileResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetFileResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetFileResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetFileResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetFileResponseValidationError) ErrorName() string { return "GetFileResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetFileResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetFileResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetFileResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetFileResponseValidationError{}
+
+// Validate checks the field values on GetFilesRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetFilesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetFilesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetFilesRequestMultiError, or nil if none found.
+func (m *GetFilesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetFilesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetFilesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetFilesRequestMultiError is an error wrapping multiple validation errors
+// returned by GetFilesRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetFilesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetFilesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetFilesRequestMultiError) AllErrors() []error { return m }
+
+// GetFilesRequestValidationError is the validation error returned by
+// GetFilesRequest.Validate if the designated constraints aren't met.
+type GetFilesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetFilesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetFilesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetFilesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetFilesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetFilesRequestValidationError) ErrorName() string { return "GetFilesRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetFilesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetFilesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetFilesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetFilesRequestValidationError{}
+
+// Validate checks the field values on GetFilesResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetFilesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetFilesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetFilesResponseMultiError, or nil if none found.
+func (m *GetFilesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetFilesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetFileInfo() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetFilesResponseValidationError{
+						field:  fmt.Sprintf("FileInfo[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetFilesResponseValidationError{
+						field:  fmt.Sprintf("FileInfo[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetFilesResponseValidationError{
+					field:  fmt.Sprintf("FileInfo[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Truncated
+
+	if len(errors) > 0 {
+		return GetFilesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetFilesResponseMultiError is an error wrapping multiple validation errors
+// returned by GetFilesResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetFilesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetFilesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetFilesResponseMultiError) AllErrors() []error { return m }
+
+// GetFilesResponseValidationError is the validation error returned by
+// GetFilesResponse.Validate if the designated constraints aren't met.
+type GetFilesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetFilesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetFilesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetFilesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetFilesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetFilesResponseValidationError) ErrorName() string { return "GetFilesResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetFilesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetFilesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetFilesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetFilesResponseValidationError{}
+
+// Validate checks the field values on DeleteFileRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteFileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteFileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteFileRequestMultiError, or nil if none found.
+func (m *DeleteFileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteFileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteFileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteFileRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteFileRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteFileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteFileRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteFileRequestMultiError) AllErrors() []error { return m }
+
+// DeleteFileRequestValidationError is the validation error returned by
+// DeleteFileRequest.Validate if the designated constraints aren't met.
+type DeleteFileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteFileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteFileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteFileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteFileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteFileRequestValidationError) ErrorName() string {
+	return "DeleteFileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteFileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteFileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteFileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteFileRequestValidationError{}
+
+// Validate checks the field values on DeleteFileResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteFileResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteFileResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteFileResponseMultiError, or nil if none found.
+func (m *DeleteFileResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteFileResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteFileResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteFileResponseMultiError is an error wrapping multiple validation errors
+// returned by DeleteFileResponse.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteFileResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteFileResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteFileResponseMultiError) AllErrors() []error { return m }
+
+// DeleteFileResponseValidationError is the validation error returned by
+// DeleteFileResponse.Validate if the designated constraints aren't met.
+type DeleteFileResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteFileResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteFileResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteFileResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteFileResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteFileResponseValidationError) ErrorName() string {
+	return "DeleteFileResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteFileResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteFileResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteFileResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteFileResponseValidationError{}
+
+// Validate checks the field values on Bank with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Bank) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Bank with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in BankMultiError, or nil if none found.
+func (m *Bank) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Bank) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for CardNumber
+
+	// no validation rules for Cvc
+
+	// no validation rules for Owner
+
+	// no validation rules for Exp
+
+	// no validation rules for Meta
+
+	if all {
+		switch v := interface{}(m.GetUpdateAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BankValidationError{
+					field:  "UpdateAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BankValidationError{
+					field:  "UpdateAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BankValidationError{
+				field:  "UpdateAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Pinned
+
+	// no validation rules for RequiresReauth
+
+	if len(errors) > 0 {
+		return BankMultiError(errors)
+	}
+
+	return nil
+}
+
+// BankMultiError is an error wrapping multiple validation errors returned by
+// Bank.ValidateAll() if the designated constraints aren't met.
+type BankMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BankMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BankMultiError) AllErrors() []error { return m }
+
+// BankValidationError is the validation error returned by Bank.Validate if the
+// designated constraints aren't met.
+type BankValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BankValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BankValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BankValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BankValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BankValidationError) ErrorName() string { return "BankValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BankValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBank.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BankValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BankValidationError{}
+
+// Validate checks the field values on CreateBankRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateBankRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateBankRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateBankRequestMultiError, or nil if none found.
+func (m *CreateBankRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateBankRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for CardNumber
+
+	// no validation rules for Cvc
+
+	// no validation rules for Owner
+
+	// no validation rules for Exp
+
+	// no validation rules for Meta
+
+	// no validation rules for Pinned
+
+	// no validation rules for RequiresReauth
+
+	if len(errors) > 0 {
+		return CreateBankRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateBankRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateBankRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateBankRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateBankRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateBankRequestMultiError) AllErrors() []error { return m }
+
+// CreateBankRequestValidationError is the validation error returned by
+// CreateBankRequest.Validate if the designated constraints aren't met.
+type CreateBankRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateBankRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateBankRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateBankRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateBankRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateBankRequestValidationError) ErrorName() string {
+	return "CreateBankRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateBankRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateBankRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateBankRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateBankRequestValidationError{}
+
+// Validate checks the field values on CreateBankResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateBankResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateBankResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateBankResponseMultiError, or nil if none found.
+func (m *CreateBankResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateBankResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return CreateBankResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateBankResponseMultiError is an error wrapping multiple validation errors
+// returned by CreateBankResponse.ValidateAll() if the designated constraints
+// aren't met.
+type CreateBankResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateBankResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateBankResponseMultiError) AllErrors() []error { return m }
+
+// CreateBankResponseValidationError is the validation error returned by
+// CreateBankResponse.Validate if the designated constraints aren't met.
+type CreateBankResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateBankResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateBankResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateBankResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateBankResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateBankResponseValidationError) ErrorName() string {
+	return "CreateBankResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateBankResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateBankResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateBankResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateBankResponseValidationError{}
+
+// Validate checks the field values on UpdateBankRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateBankRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateBankRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateBankRequestMultiError, or nil if none found.
+func (m *UpdateBankRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateBankRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for CardNumber
+
+	// no validation rules for Cvc
+
+	// no validation rules for Owner
+
+	// no validation rules for Exp
+
+	// no validation rules for Meta
+
+	// no validation rules for Pinned
+
+	// no validation rules for RequiresReauth
+
+	if len(errors) > 0 {
+		return UpdateBankRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateBankRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateBankRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateBankRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateBankRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateBankRequestMultiError) AllErrors() []error { return m }
+
+// UpdateBankRequestValidationError is the validation error returned by
+// UpdateBankRequest.Validate if the designated constraints aren't met.
+type UpdateBankRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateBankRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateBankRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateBankRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateBankRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateBankRequestValidationError) ErrorName() string {
+	return "UpdateBankRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateBankRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateBankRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateBankRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateBankRequestValidationError{}
+
+// Validate checks the field values on UpdateBankResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateBankResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateBankResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateBankResponseMultiError, or nil if none found.
+func (m *UpdateBankResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateBankResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return UpdateBankResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateBankResponseMultiError is an error wrapping multiple validation errors
+// returned by UpdateBankResponse.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateBankResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateBankResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateBankResponseMultiError) AllErrors() []error { return m }
+
+// UpdateBankResponseValidationError is the validation error returned by
+// UpdateBankResponse.Validate if the designated constraints aren't met.
+type UpdateBankResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateBankResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateBankResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateBankResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateBankResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateBankResponseValidationError) ErrorName() string {
+	return "UpdateBankResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateBankResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateBankResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateBankResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateBankResponseValidationError{}
+
+// Validate checks the field values on GetBankRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetBankRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBankRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetBankRequestMultiError,
+// or nil if none found.
+func (m *GetBankRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBankRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetBankRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBankRequestMultiError is an error wrapping multiple validation errors
+// returned by GetBankRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetBankRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBankRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBankRequestMultiError) AllErrors() []error { return m }
+
+// GetBankRequestValidationError is the validation error returned by
+// GetBankRequest.Validate if the designated constraints aren't met.
+type GetBankRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBankRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBankRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBankRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBankRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBankRequestValidationError) ErrorName() string { return "GetBankRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetBankRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBankRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBankRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBankRequestValidationError{}
+
+// Validate checks the field values on GetBankResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetBankResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBankResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBankResponseMultiError, or nil if none found.
+func (m *GetBankResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBankResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBank()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetBankResponseValidationError{
+					field:  "Bank",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetBankResponseValidationError{
+					field:  "Bank",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBank()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetBankResponseValidationError{
+				field:  "Bank",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetBankResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBankResponseMultiError is an error wrapping multiple validation errors
+// returned by GetBankResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetBankResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBankResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBankResponseMultiError) AllErrors() []error { return m }
+
+// GetBankResponseValidationError is the validation error returned by
+// GetBankResponse.Validate if the designated constraints aren't met.
+type GetBankResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBankResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBankResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBankResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBankResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBankResponseValidationError) ErrorName() string { return "GetBankResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetBankResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBankResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBankResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBankResponseValidationError{}
+
+// Validate checks the field values on GetBanksRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetBanksRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBanksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBanksRequestMultiError, or nil if none found.
+func (m *GetBanksRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBanksRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetBanksRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBanksRequestMultiError is an error wrapping multiple validation errors
+// returned by GetBanksRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetBanksRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBanksRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBanksRequestMultiError) AllErrors() []error { return m }
+
+// GetBanksRequestValidationError is the validation error returned by
+// GetBanksRequest.Validate if the designated constraints aren't met.
+type GetBanksRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBanksRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBanksRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBanksRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBanksRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBanksRequestValidationError) ErrorName() string { return "GetBanksRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetBanksRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBanksRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBanksRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBanksRequestValidationError{}
+
+// Validate checks the field values on GetBanksResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetBanksResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBanksResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBanksResponseMultiError, or nil if none found.
+func (m *GetBanksResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBanksResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetBanks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetBanksResponseValidationError{
+						field:  fmt.Sprintf("Banks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetBanksResponseValidationError{
+						field:  fmt.Sprintf("Banks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetBanksResponseValidationError{
+					field:  fmt.Sprintf("Banks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Truncated
+
+	if len(errors) > 0 {
+		return GetBanksResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBanksResponseMultiError is an error wrapping multiple validation errors
+// returned by GetBanksResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetBanksResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBanksResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBanksResponseMultiError) AllErrors() []error { return m }
+
+// GetBanksResponseValidationError is the validation error returned by
+// GetBanksResponse.Validate if the designated constraints aren't met.
+type GetBanksResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBanksResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBanksResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBanksResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBanksResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBanksResponseValidationError) ErrorName() string { return "GetBanksResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetBanksResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBanksResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBanksResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBanksResponseValidationError{}
+
+// Validate checks the field values on DeleteBankRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteBankRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteBankRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteBankRequestMultiError, or nil if none found.
+func (m *DeleteBankRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteBankRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteBankRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteBankRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteBankRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteBankRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteBankRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteBankRequestMultiError) AllErrors() []error { return m }
+
+// DeleteBankRequestValidationError is the validation error returned by
+// DeleteBankRequest.Validate if the designated constraints aren't met.
+type DeleteBankRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteBankRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteBankRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteBankRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteBankRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteBankRequestValidationError) ErrorName() string {
+	return "DeleteBankRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteBankRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteBankRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteBankRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteBankRequestValidationError{}
+
+// Validate checks the field values on DeleteBankResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteBankResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteBankResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteBankResponseMultiError, or nil if none found.
+func (m *DeleteBankResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteBankResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteBankResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteBankResponseMultiError is an error wrapping multiple validation errors
+// returned by DeleteBankResponse.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteBankResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteBankResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteBankResponseMultiError) AllErrors() []error { return m }
+
+// DeleteBankResponseValidationError is the validation error returned by
+// DeleteBankResponse.Validate if the designated constraints aren't met.
+type DeleteBankResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteBankResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteBankResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteBankResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteBankResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteBankResponseValidationError) ErrorName() string {
+	return "DeleteBankResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteBankResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteBankResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteBankResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteBankResponseValidationError{}
+
+// Validate checks the field values on Text with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Text) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Text with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TextMultiError, or nil if none found.
+func (m *Text) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Text) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Text
+
+	// no validation rules for Meta
+
+	if all {
+		switch v := interface{}(m.GetUpdateAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TextValidationError{
+					field:  "UpdateAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TextValidationError{
+					field:  "UpdateAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TextValidationError{
+				field:  "UpdateAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Pinned
+
+	// no validation rules for RequiresReauth
+
+	if len(errors) > 0 {
+		return TextMultiError(errors)
+	}
+
+	return nil
+}
+
+// TextMultiError is an error wrapping multiple validation errors returned by
+// Text.ValidateAll() if the designated constraints aren't met.
+type TextMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TextMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TextMultiError) AllErrors() []error { return m }
+
+// TextValidationError is the validation error returned by Text.Validate if the
+// designated constraints aren't met.
+type TextValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TextValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TextValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TextValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TextValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TextValidationError) ErrorName() string { return "TextValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TextValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sText.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TextValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TextValidationError{}
+
+// Validate checks the field values on CreateTextRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateTextRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateTextRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateTextRequestMultiError, or nil if none found.
+func (m *CreateTextRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateTextRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Text
+
+	// no validation rules for Meta
+
+	// no validation rules for Pinned
+
+	// no validation rules for RequiresReauth
+
+	if len(errors) > 0 {
+		return CreateTextRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateTextRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateTextRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateTextRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateTextRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateTextRequestMultiError) AllErrors() []error { return m }
+
+// CreateTextRequestValidationError is the validation error returned by
+// CreateTextRequest.Validate if the designated constraints aren't met.
+type CreateTextRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateTextRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateTextRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateTextRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateTextRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateTextRequestValidationError) ErrorName() string {
+	return "CreateTextRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateTextRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateTextRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateTextRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateTextRequestValidationError{}
+
+// Validate checks the field values on CreateTextResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateTextResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateTextResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateTextResponseMultiError, or nil if none found.
+func (m *CreateTextResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateTextResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return CreateTextResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateTextResponseMultiError is an error wrapping multiple validation errors
+// returned by CreateTextResponse.ValidateAll() if the designated constraints
+// aren't met.
+type CreateTextResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateTextResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateTextResponseMultiError) AllErrors() []error { return m }
+
+// CreateTextResponseValidationError is the validation error returned by
+// CreateTextResponse.Validate if the designated constraints aren't met.
+type CreateTextResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateTextResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateTextResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateTextResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateTextResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateTextResponseValidationError) ErrorName() string {
+	return "CreateTextResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateTextResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateTextResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateTextResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateTextResponseValidationError{}
+
+// Validate checks the field values on UpdateTextRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateTextRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateTextRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateTextRequestMultiError, or nil if none found.
+func (m *UpdateTextRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateTextRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Text
+
+	// no validation rules for Meta
+
+	// no validation rules for Pinned
+
+	// no validation rules for RequiresReauth
+
+	if len(errors) > 0 {
+		return UpdateTextRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateTextRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateTextRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateTextRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateTextRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateTextRequestMultiError) AllErrors() []error { return m }
+
+// UpdateTextRequestValidationError is the validation error returned by
+// UpdateTextRequest.Validate if the designated constraints aren't met.
+type UpdateTextRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateTextRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateTextRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateTextRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateTextRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateTextRequestValidationError) ErrorName() string {
+	return "UpdateTextRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateTextRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateTextRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateTextRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateTextRequestValidationError{}
+
+// Validate checks the field values on UpdateTextResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateTextResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateTextResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateTextResponseMultiError, or nil if none found.
+func (m *UpdateTextResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateTextResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return UpdateTextResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateTextResponseMultiError is an error wrapping multiple validation errors
+// returned by UpdateTextResponse.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateTextResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateTextResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateTextResponseMultiError) AllErrors() []error { return m }
+
+// UpdateTextResponseValidationError is the validation error returned by
+// UpdateTextResponse.Validate if the designated constraints aren't met.
+type UpdateTextResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateTextResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateTextResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateTextResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateTextResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateTextResponseValidationError) ErrorName() string {
+	return "UpdateTextResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateTextResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateTextResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateTextResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateTextResponseValidationError{}
+
+// Validate checks the field values on GetTextRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetTextRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTextRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetTextRequestMultiError,
+// or nil if none found.
+func (m *GetTextRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTextRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetTextRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTextRequestMultiError is an error wrapping multiple validation errors
+// returned by GetTextRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetTextRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTextRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTextRequestMultiError) AllErrors() []error { return m }
+
+// GetTextRequestValidationError is the validation error returned by
+// GetTextRequest.Validate if the designated constraints aren't met.
+type GetTextRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTextRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTextRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTextRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTextRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTextRequestValidationError) ErrorName() string { return "GetTextRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetTextRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTextRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTextRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTextRequestValidationError{}
+
+// Validate checks the field values on GetTextResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetTextResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTextResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTextResponseMultiError, or nil if none found.
+func (m *GetTextResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTextResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetText()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetTextResponseValidationError{
+					field:  "Text",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetTextResponseValidationError{
+					field:  "Text",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetText()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetTextResponseValidationError{
+				field:  "Text",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetTextResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTextResponseMultiError is an error wrapping multiple validation errors
+// returned by GetTextResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetTextResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTextResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTextResponseMultiError) AllErrors() []error { return m }
+
+// GetTextResponseValidationError is the validation error returned by
+// GetTextResponse.Validate if the designated constraints aren't met.
+type GetTextResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTextResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTextResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTextResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTextResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTextResponseValidationError) ErrorName() string { return "GetTextResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetTextResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTextResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTextResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTextResponseValidationError{}
+
+// Validate checks the field values on GetTextsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetTextsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTextsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTextsRequestMultiError, or nil if none found.
+func (m *GetTextsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTextsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrderBy
+
+	// no validation rules for OrderDir
+
+	// no validation rules for Limit
+
+	// no validation rules for Offset
+
+	if len(errors) > 0 {
+		return GetTextsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTextsRequestMultiError is an error wrapping multiple validation errors
+// returned by GetTextsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetTextsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTextsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTextsRequestMultiError) AllErrors() []error { return m }
+
+// GetTextsRequestValidationError is the validation error returned by
+// GetTextsRequest.Validate if the designated constraints aren't met.
+type GetTextsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTextsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTextsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTextsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTextsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTextsRequestValidationError) ErrorName() string { return "GetTextsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetTextsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTextsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTextsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTextsRequestValidationError{}
+
+// Validate checks the field values on GetTextsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetTextsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTextsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTextsResponseMultiError, or nil if none found.
+func (m *GetTextsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTextsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTexts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetTextsResponseValidationError{
+						field:  fmt.Sprintf("Texts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetTextsResponseValidationError{
+						field:  fmt.Sprintf("Texts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetTextsResponseValidationError{
+					field:  fmt.Sprintf("Texts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Truncated
+
+	if len(errors) > 0 {
+		return GetTextsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTextsResponseMultiError is an error wrapping multiple validation errors
+// returned by GetTextsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetTextsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTextsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTextsResponseMultiError) AllErrors() []error { return m }
+
+// GetTextsResponseValidationError is the validation error returned by
+// GetTextsResponse.Validate if the designated constraints aren't met.
+type GetTextsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTextsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTextsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTextsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTextsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTextsResponseValidationError) ErrorName() string { return "GetTextsResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetTextsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTextsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTextsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTextsResponseValidationError{}
+
+// Validate checks the field values on DeleteTextRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTextRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteTextRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteTextRequestMultiError, or nil if none found.
+func (m *DeleteTextRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteTextRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteTextRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteTextRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteTextRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteTextRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteTextRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteTextRequestMultiError) AllErrors() []error { return m }
+
+// DeleteTextRequestValidationError is the validation error returned by
+// DeleteTextRequest.Validate if the designated constraints aren't met.
+type DeleteTextRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteTextRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteTextRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteTextRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteTextRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteTextRequestValidationError) ErrorName() string {
+	return "DeleteTextRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteTextRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteTextRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteTextRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteTextRequestValidationError{}
+
+// Validate checks the field values on DeleteTextResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTextResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteTextResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteTextResponseMultiError, or nil if none found.
+func (m *DeleteTextResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteTextResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteTextResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteTextResponseMultiError is an error wrapping multiple validation errors
+// returned by DeleteTextResponse.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteTextResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteTextResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteTextResponseMultiError) AllErrors() []error { return m }
+
+// DeleteTextResponseValidationError is the validation error returned by
+// DeleteTextResponse.Validate if the designated constraints aren't met.
+type DeleteTextResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteTextResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteTextResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteTextResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteTextResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteTextResponseValidationError) ErrorName() string {
+	return "DeleteTextResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteTextResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteTextResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteTextResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteTextResponseValidationError{}
+
+// Validate checks the field values on ImportUserRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ImportUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportUserRequestMultiError, or nil if none found.
+func (m *ImportUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Login
+
+	// no validation rules for PasswordHash
+
+	// no validation rules for Salt
+
+	// no validation rules for Algo
+
+	if len(errors) > 0 {
+		return ImportUserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportUserRequestMultiError is an error wrapping multiple validation errors
+// returned by ImportUserRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ImportUserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportUserRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportUserRequestMultiError) AllErrors() []error { return m }
+
+// ImportUserRequestValidationError is the validation error returned by
+// ImportUserRequest.Validate if the designated constraints aren't met.
+type ImportUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportUserRequestValidationError) ErrorName() string {
+	return "ImportUserRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportUserRequestValidationError{}
+
+// Validate checks the field values on ImportUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportUserResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportUserResponseMultiError, or nil if none found.
+func (m *ImportUserResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportUserResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ImportUserResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportUserResponseMultiError is an error wrapping multiple validation errors
+// returned by ImportUserResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ImportUserResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportUserResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportUserResponseMultiError) AllErrors() []error { return m }
+
+// ImportUserResponseValidationError is the validation error returned by
+// ImportUserResponse.Validate if the designated constraints aren't met.
+type ImportUserResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportUserResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportUserResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportUserResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportUserResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportUserResponseValidationError) ErrorName() string {
+	return "ImportUserResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportUserResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportUserResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportUserResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportUserResponseValidationError{}
+
+// Validate checks the field values on ActivityEntry with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ActivityEntry) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ActivityEntry with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ActivityEntryMultiError, or
+// nil if none found.
+func (m *ActivityEntry) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ActivityEntry) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Kind
+
+	// no validation rules for Name
+
+	if all {
+		switch v := interface{}(m.GetUpdateAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ActivityEntryValidationError{
+					field:  "UpdateAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ActivityEntryValidationError{
+					field:  "UpdateAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ActivityEntryValidationError{
+				field:  "UpdateAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ActivityEntryMultiError(errors)
+	}
+
+	return nil
+}
+
+// ActivityEntryMultiError is an error wrapping multiple validation errors
+// returned by ActivityEntry.ValidateAll() if the designated constraints
+// aren't met.
+type ActivityEntryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ActivityEntryMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ActivityEntryMultiError) AllErrors() []error { return m }
+
+// ActivityEntryValidationError is the validation error returned by
+// ActivityEntry.Validate if the designated constraints aren't met.
+type ActivityEntryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ActivityEntryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ActivityEntryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ActivityEntryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ActivityEntryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ActivityEntryValidationError) ErrorName() string { return "ActivityEntryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ActivityEntryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sActivityEntry.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ActivityEntryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ActivityEntryValidationError{}
+
+// Validate checks the field values on GetRecentActivityRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRecentActivityRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRecentActivityRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRecentActivityRequestMultiError, or nil if none found.
+func (m *GetRecentActivityRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRecentActivityRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Limit
+
+	if len(errors) > 0 {
+		return GetRecentActivityRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRecentActivityRequestMultiError is an error wrapping multiple validation
+// errors returned by GetRecentActivityRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetRecentActivityRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRecentActivityRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRecentActivityRequestMultiError) AllErrors() []error { return m }
+
+// GetRecentActivityRequestValidationError is the validation error returned by
+// GetRecentActivityRequest.Validate if the designated constraints aren't met.
+type GetRecentActivityRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRecentActivityRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRecentActivityRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRecentActivityRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRecentActivityRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRecentActivityRequestValidationError) ErrorName() string {
+	return "GetRecentActivityRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRecentActivityRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRecentActivityRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRecentActivityRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRecentActivityRequestValidationError{}
+
+// Validate checks the field values on GetRecentActivityResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRecentActivityResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRecentActivityResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRecentActivityResponseMultiError, or nil if none found.
+func (m *GetRecentActivityResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRecentActivityResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetActivities() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetRecentActivityResponseValidationError{
+						field:  fmt.Sprintf("Activities[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetRecentActivityResponseValidationError{
+						field:  fmt.Sprintf("Activities[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetRecentActivityResponseValidationError{
+					field:  fmt.Sprintf("Activities[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetRecentActivityResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRecentActivityResponseMultiError is an error wrapping multiple validation
+// errors returned by GetRecentActivityResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetRecentActivityResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRecentActivityResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRecentActivityResponseMultiError) AllErrors() []error { return m }
+
+// GetRecentActivityResponseValidationError is the validation error returned by
+// GetRecentActivityResponse.Validate if the designated constraints aren't met.
+type GetRecentActivityResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRecentActivityResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRecentActivityResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRecentActivityResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRecentActivityResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRecentActivityResponseValidationError) ErrorName() string {
+	return "GetRecentActivityResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRecentActivityResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRecentActivityResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRecentActivityResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRecentActivityResponseValidationError{}
+
+// Validate checks the field values on GetFavoritesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetFavoritesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetFavoritesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetFavoritesRequestMultiError, or nil if none found.
+func (m *GetFavoritesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetFavoritesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetFavoritesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetFavoritesRequestMultiError is an error wrapping multiple validation
+// errors returned by GetFavoritesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetFavoritesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetFavoritesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetFavoritesRequestMultiError) AllErrors() []error { return m }
+
+// GetFavoritesRequestValidationError is the validation error returned by
+// GetFavoritesRequest.Validate if the designated constraints aren't met.
+type GetFavoritesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetFavoritesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetFavoritesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetFavoritesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetFavoritesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetFavoritesRequestValidationError) ErrorName() string {
+	return "GetFavoritesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetFavoritesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetFavoritesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetFavoritesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetFavoritesRequestValidationError{}
+
+// Validate checks the field values on GetFavoritesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetFavoritesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetFavoritesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetFavoritesResponseMultiError, or nil if none found.
+func (m *GetFavoritesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetFavoritesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPasswords() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetFavoritesResponseValidationError{
+						field:  fmt.Sprintf("Passwords[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetFavoritesResponseValidationError{
+						field:  fmt.Sprintf("Passwords[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetFavoritesResponseValidationError{
+					field:  fmt.Sprintf("Passwords[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetFileInfo() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetFavoritesResponseValidationError{
+						field:  fmt.Sprintf("FileInfo[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetFavoritesResponseValidationError{
+						field:  fmt.Sprintf("FileInfo[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetFavoritesResponseValidationError{
+					field:  fmt.Sprintf("FileInfo[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetBanks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetFavoritesResponseValidationError{
+						field:  fmt.Sprintf("Banks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetFavoritesResponseValidationError{
+						field:  fmt.Sprintf("Banks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetFavoritesResponseValidationError{
+					field:  fmt.Sprintf("Banks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetTexts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetFavoritesResponseValidationError{
+						field:  fmt.Sprintf("Texts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetFavoritesResponseValidationError{
+						field:  fmt.Sprintf("Texts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetFavoritesResponseValidationError{
+					field:  fmt.Sprintf("Texts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetFavoritesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetFavoritesResponseMultiError is an error wrapping multiple validation
+// errors returned by GetFavoritesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetFavoritesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetFavoritesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetFavoritesResponseMultiError) AllErrors() []error { return m }
+
+// GetFavoritesResponseValidationError is the validation error returned by
+// GetFavoritesResponse.Validate if the designated constraints aren't met.
+type GetFavoritesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetFavoritesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetFavoritesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetFavoritesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetFavoritesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetFavoritesResponseValidationError) ErrorName() string {
+	return "GetFavoritesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetFavoritesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetFavoritesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetFavoritesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetFavoritesResponseValidationError{}
+
+// Validate checks the field values on EntryID with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *EntryID) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EntryID with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in EntryIDMultiError, or nil if none found.
+func (m *EntryID) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EntryID) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetUpdateAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EntryIDValidationError{
+					field:  "UpdateAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EntryIDValidationError{
+					field:  "UpdateAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EntryIDValidationError{
+				field:  "UpdateAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return EntryIDMultiError(errors)
+	}
+
+	return nil
+}
+
+// EntryIDMultiError is an error wrapping multiple validation errors returned
+// by EntryID.ValidateAll() if the designated constraints aren't met.
+type EntryIDMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EntryIDMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EntryIDMultiError) AllErrors() []error { return m }
+
+// EntryIDValidationError is the validation error returned by EntryID.Validate
+// if the designated constraints aren't met.
+type EntryIDValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EntryIDValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EntryIDValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EntryIDValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EntryIDValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EntryIDValidationError) ErrorName() string { return "EntryIDValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EntryIDValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEntryID.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EntryIDValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EntryIDValidationError{}
+
+// Validate checks the field values on ListEntryIDsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListEntryIDsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListEntryIDsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListEntryIDsRequestMultiError, or nil if none found.
+func (m *ListEntryIDsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListEntryIDsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Kind
+
+	if len(errors) > 0 {
+		return ListEntryIDsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListEntryIDsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListEntryIDsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListEntryIDsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListEntryIDsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListEntryIDsRequestMultiError) AllErrors() []error { return m }
+
+// ListEntryIDsRequestValidationError is the validation error returned by
+// ListEntryIDsRequest.Validate if the designated constraints aren't met.
+type ListEntryIDsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListEntryIDsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListEntryIDsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListEntryIDsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListEntryIDsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListEntryIDsRequestValidationError) ErrorName() string {
+	return "ListEntryIDsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListEntryIDsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListEntryIDsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListEntryIDsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListEntryIDsRequestValidationError{}
+
+// Validate checks the field values on ListEntryIDsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListEntryIDsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListEntryIDsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListEntryIDsResponseMultiError, or nil if none found.
+func (m *ListEntryIDsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListEntryIDsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEntries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListEntryIDsResponseValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListEntryIDsResponseValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListEntryIDsResponseValidationError{
+					field:  fmt.Sprintf("Entries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListEntryIDsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListEntryIDsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListEntryIDsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListEntryIDsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListEntryIDsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListEntryIDsResponseMultiError) AllErrors() []error { return m }
+
+// ListEntryIDsResponseValidationError is the validation error returned by
+// ListEntryIDsResponse.Validate if the designated constraints aren't met.
+type ListEntryIDsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListEntryIDsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListEntryIDsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListEntryIDsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListEntryIDsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListEntryIDsResponseValidationError) ErrorName() string {
+	return "ListEntryIDsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListEntryIDsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListEntryIDsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListEntryIDsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListEntryIDsResponseValidationError{}
+
+// Validate checks the field values on ManifestEntry with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ManifestEntry) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ManifestEntry with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ManifestEntryMultiError, or
+// nil if none found.
+func (m *ManifestEntry) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ManifestEntry) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Kind
+
+	if all {
+		switch v := interface{}(m.GetUpdateAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ManifestEntryValidationError{
+					field:  "UpdateAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ManifestEntryValidationError{
+					field:  "UpdateAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ManifestEntryValidationError{
+				field:  "UpdateAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ManifestEntryMultiError(errors)
+	}
+
+	return nil
+}
+
+// ManifestEntryMultiError is an error wrapping multiple validation errors
+// returned by ManifestEntry.ValidateAll() if the designated constraints
+// aren't met.
+type ManifestEntryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ManifestEntryMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ManifestEntryMultiError) AllErrors() []error { return m }
+
+// ManifestEntryValidationError is the validation error returned by
+// ManifestEntry.Validate if the designated constraints aren't met.
+type ManifestEntryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ManifestEntryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ManifestEntryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ManifestEntryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ManifestEntryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ManifestEntryValidationError) ErrorName() string { return "ManifestEntryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ManifestEntryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sManifestEntry.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ManifestEntryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ManifestEntryValidationError{}
+
+// Validate checks the field values on GetVaultManifestRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetVaultManifestRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetVaultManifestRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetVaultManifestRequestMultiError, or nil if none found.
+func (m *GetVaultManifestRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetVaultManifestRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Limit
+
+	// no validation rules for Offset
+
+	if len(errors) > 0 {
+		return GetVaultManifestRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetVaultManifestRequestMultiError is an error wrapping multiple validation
+// errors returned by GetVaultManifestRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetVaultManifestRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetVaultManifestRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetVaultManifestRequestMultiError) AllErrors() []error { return m }
+
+// GetVaultManifestRequestValidationError is the validation error returned by
+// GetVaultManifestRequest.Validate if the designated constraints aren't met.
+type GetVaultManifestRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetVaultManifestRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetVaultManifestRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetVaultManifestRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetVaultManifestRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetVaultManifestRequestValidationError) ErrorName() string {
+	return "GetVaultManifestRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetVaultManifestRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetVaultManifestRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetVaultManifestRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetVaultManifestRequestValidationError{}
+
+// Validate checks the field values on GetVaultManifestResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetVaultManifestResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetVaultManifestResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetVaultManifestResponseMultiError, or nil if none found.
+func (m *GetVaultManifestResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetVaultManifestResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEntries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetVaultManifestResponseValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetVaultManifestResponseValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetVaultManifestResponseValidationError{
+					field:  fmt.Sprintf("Entries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetVaultManifestResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetVaultManifestResponseMultiError is an error wrapping multiple validation
+// errors returned by GetVaultManifestResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetVaultManifestResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetVaultManifestResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetVaultManifestResponseMultiError) AllErrors() []error { return m }
+
+// GetVaultManifestResponseValidationError is the validation error returned by
+// GetVaultManifestResponse.Validate if the designated constraints aren't met.
+type GetVaultManifestResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetVaultManifestResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetVaultManifestResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetVaultManifestResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetVaultManifestResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetVaultManifestResponseValidationError) ErrorName() string {
+	return "GetVaultManifestResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetVaultManifestResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetVaultManifestResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetVaultManifestResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetVaultManifestResponseValidationError{}
+
+// Validate checks the field values on ValidateTokenRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ValidateTokenRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ValidateTokenRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ValidateTokenRequestMultiError, or nil if none found.
+func (m *ValidateTokenRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ValidateTokenRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ValidateTokenRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ValidateTokenRequestMultiError is an error wrapping multiple validation
+// errors returned by ValidateTokenRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ValidateTokenRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ValidateTokenRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ValidateTokenRequestMultiError) AllErrors() []error { return m }
+
+// ValidateTokenRequestValidationError is the validation error returned by
+// ValidateTokenRequest.Validate if the designated constraints aren't met.
+type ValidateTokenRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ValidateTokenRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ValidateTokenRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ValidateTokenRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ValidateTokenRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ValidateTokenRequestValidationError) ErrorName() string {
+	return "ValidateTokenRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ValidateTokenRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sValidateTokenRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ValidateTokenRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ValidateTokenRequestValidationError{}
+
+// Validate checks the field values on ValidateTokenResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ValidateTokenResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ValidateTokenResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ValidateTokenResponseMultiError, or nil if none found.
+func (m *ValidateTokenResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ValidateTokenResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ValidateTokenResponseValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ValidateTokenResponseValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ValidateTokenResponseValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ValidateTokenResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ValidateTokenResponseMultiError is an error wrapping multiple validation
+// errors returned by ValidateTokenResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ValidateTokenResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ValidateTokenResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ValidateTokenResponseMultiError) AllErrors() []error { return m }
+
+// ValidateTokenResponseValidationError is the validation error returned by
+// ValidateTokenResponse.Validate if the designated constraints aren't met.
+type ValidateTokenResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ValidateTokenResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ValidateTokenResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ValidateTokenResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ValidateTokenResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ValidateTokenResponseValidationError) ErrorName() string {
+	return "ValidateTokenResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ValidateTokenResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sValidateTokenResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ValidateTokenResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ValidateTokenResponseValidationError{}
+
+// Validate checks the field values on MaintenanceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MaintenanceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MaintenanceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MaintenanceRequestMultiError, or nil if none found.
+func (m *MaintenanceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MaintenanceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return MaintenanceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MaintenanceRequestMultiError is an error wrapping multiple validation errors
+// returned by MaintenanceRequest.ValidateAll() if the designated constraints
+// aren't met.
+type MaintenanceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MaintenanceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MaintenanceRequestMultiError) AllErrors() []error { return m }
+
+// MaintenanceRequestValidationError is the validation error returned by
+// MaintenanceRequest.Validate if the designated constraints aren't met.
+type MaintenanceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MaintenanceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MaintenanceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MaintenanceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MaintenanceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MaintenanceRequestValidationError) ErrorName() string {
+	return "MaintenanceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MaintenanceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMaintenanceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MaintenanceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MaintenanceRequestValidationError{}
+
+// Validate checks the field values on MaintenanceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MaintenanceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MaintenanceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MaintenanceResponseMultiError, or nil if none found.
+func (m *MaintenanceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MaintenanceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDuration()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MaintenanceResponseValidationError{
+					field:  "Duration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MaintenanceResponseValidationError{
+					field:  "Duration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDuration()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MaintenanceResponseValidationError{
+				field:  "Duration",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return MaintenanceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// MaintenanceResponseMultiError is an error wrapping multiple validation
+// errors returned by MaintenanceResponse.ValidateAll() if the designated
+// constraints aren't met.
+type MaintenanceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MaintenanceResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MaintenanceResponseMultiError) AllErrors() []error { return m }
+
+// MaintenanceResponseValidationError is the validation error returned by
+// MaintenanceResponse.Validate if the designated constraints aren't met.
+type MaintenanceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MaintenanceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MaintenanceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MaintenanceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MaintenanceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MaintenanceResponseValidationError) ErrorName() string {
+	return "MaintenanceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MaintenanceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMaintenanceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MaintenanceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MaintenanceResponseValidationError{}
+
+// Validate checks the field values on GetIntegrityReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetIntegrityReportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetIntegrityReportRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetIntegrityReportRequestMultiError, or nil if none found.
+func (m *GetIntegrityReportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetIntegrityReportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetIntegrityReportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetIntegrityReportRequestMultiError is an error wrapping multiple validation
+// errors returned by GetIntegrityReportRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetIntegrityReportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetIntegrityReportRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetIntegrityReportRequestMultiError) AllErrors() []error { return m }
+
+// GetIntegrityReportRequestValidationError is the validation error returned by
+// GetIntegrityReportRequest.Validate if the designated constraints aren't met.
+type GetIntegrityReportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetIntegrityReportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetIntegrityReportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetIntegrityReportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetIntegrityReportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetIntegrityReportRequestValidationError) ErrorName() string {
+	return "GetIntegrityReportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetIntegrityReportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetIntegrityReportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetIntegrityReportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetIntegrityReportRequestValidationError{}
+
+// Validate checks the field values on IntegrityAnomaly with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *IntegrityAnomaly) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IntegrityAnomaly with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IntegrityAnomalyMultiError, or nil if none found.
+func (m *IntegrityAnomaly) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IntegrityAnomaly) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FileId
+
+	// no validation rules for UserId
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return IntegrityAnomalyMultiError(errors)
+	}
+
+	return nil
+}
+
+// IntegrityAnomalyMultiError is an error wrapping multiple validation errors
+// returned by IntegrityAnomaly.ValidateAll() if the designated constraints
+// aren't met.
+type IntegrityAnomalyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IntegrityAnomalyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IntegrityAnomalyMultiError) AllErrors() []error { return m }
+
+// IntegrityAnomalyValidationError is the validation error returned by
+// IntegrityAnomaly.Validate if the designated constraints aren't met.
+type IntegrityAnomalyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IntegrityAnomalyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IntegrityAnomalyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IntegrityAnomalyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IntegrityAnomalyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IntegrityAnomalyValidationError) ErrorName() string { return "IntegrityAnomalyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e IntegrityAnomalyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIntegrityAnomaly.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IntegrityAnomalyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IntegrityAnomalyValidationError{}
+
+// Validate checks the field values on GetIntegrityReportResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetIntegrityReportResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetIntegrityReportResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetIntegrityReportResponseMultiError, or nil if none found.
+func (m *GetIntegrityReportResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetIntegrityReportResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetScannedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetIntegrityReportResponseValidationError{
+					field:  "ScannedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetIntegrityReportResponseValidationError{
+					field:  "ScannedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetScannedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetIntegrityReportResponseValidationError{
+				field:  "ScannedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for FilesChecked
+
+	for idx, item := range m.GetAnomalies() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetIntegrityReportResponseValidationError{
+						field:  fmt.Sprintf("Anomalies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetIntegrityReportResponseValidationError{
+						field:  fmt.Sprintf("Anomalies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetIntegrityReportResponseValidationError{
+					field:  fmt.Sprintf("Anomalies[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetIntegrityReportResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetIntegrityReportResponseMultiError is an error wrapping multiple
+// validation errors returned by GetIntegrityReportResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetIntegrityReportResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetIntegrityReportResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetIntegrityReportResponseMultiError) AllErrors() []error { return m }
+
+// GetIntegrityReportResponseValidationError is the validation error returned
+// by GetIntegrityReportResponse.Validate if the designated constraints aren't met.
+type GetIntegrityReportResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetIntegrityReportResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetIntegrityReportResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetIntegrityReportResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetIntegrityReportResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetIntegrityReportResponseValidationError) ErrorName() string {
+	return "GetIntegrityReportResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetIntegrityReportResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetIntegrityReportResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetIntegrityReportResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetIntegrityReportResponseValidationError{}
+
+// Validate checks the field values on GenerateRecoveryCodesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenerateRecoveryCodesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateRecoveryCodesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenerateRecoveryCodesRequestMultiError, or nil if none found.
+func (m *GenerateRecoveryCodesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateRecoveryCodesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GenerateRecoveryCodesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateRecoveryCodesRequestMultiError is an error wrapping multiple
+// validation errors returned by GenerateRecoveryCodesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GenerateRecoveryCodesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateRecoveryCodesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateRecoveryCodesRequestMultiError) AllErrors() []error { return m }
+
+// GenerateRecoveryCodesRequestValidationError is the validation error returned
+// by GenerateRecoveryCodesRequest.Validate if the designated constraints
+// aren't met.
+type GenerateRecoveryCodesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateRecoveryCodesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateRecoveryCodesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateRecoveryCodesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateRecoveryCodesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateRecoveryCodesRequestValidationError) ErrorName() string {
+	return "GenerateRecoveryCodesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GenerateRecoveryCodesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateRecoveryCodesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateRecoveryCodesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateRecoveryCodesRequestValidationError{}
+
+// Validate checks the field values on GenerateRecoveryCodesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenerateRecoveryCodesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateRecoveryCodesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GenerateRecoveryCodesResponseMultiError, or nil if none found.
+func (m *GenerateRecoveryCodesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateRecoveryCodesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GenerateRecoveryCodesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateRecoveryCodesResponseMultiError is an error wrapping multiple
+// validation errors returned by GenerateRecoveryCodesResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GenerateRecoveryCodesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateRecoveryCodesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateRecoveryCodesResponseMultiError) AllErrors() []error { return m }
+
+// GenerateRecoveryCodesResponseValidationError is the validation error
+// returned by GenerateRecoveryCodesResponse.Validate if the designated
+// constraints aren't met.
+type GenerateRecoveryCodesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateRecoveryCodesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateRecoveryCodesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateRecoveryCodesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateRecoveryCodesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateRecoveryCodesResponseValidationError) ErrorName() string {
+	return "GenerateRecoveryCodesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GenerateRecoveryCodesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateRecoveryCodesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateRecoveryCodesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateRecoveryCodesResponseValidationError{}
+
+// Validate checks the field values on RecoverWithCodeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecoverWithCodeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecoverWithCodeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecoverWithCodeRequestMultiError, or nil if none found.
+func (m *RecoverWithCodeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecoverWithCodeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Login
+
+	// no validation rules for Code
+
+	if len(errors) > 0 {
+		return RecoverWithCodeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecoverWithCodeRequestMultiError is an error wrapping multiple validation
+// errors returned by RecoverWithCodeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RecoverWithCodeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecoverWithCodeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecoverWithCodeRequestMultiError) AllErrors() []error { return m }
+
+// RecoverWithCodeRequestValidationError is the validation error returned by
+// RecoverWithCodeRequest.Validate if the designated constraints aren't met.
+type RecoverWithCodeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecoverWithCodeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecoverWithCodeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecoverWithCodeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecoverWithCodeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecoverWithCodeRequestValidationError) ErrorName() string {
+	return "RecoverWithCodeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecoverWithCodeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecoverWithCodeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecoverWithCodeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecoverWithCodeRequestValidationError{}
+
+// Validate checks the field values on RecoverWithCodeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecoverWithCodeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecoverWithCodeResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecoverWithCodeResponseMultiError, or nil if none found.
+func (m *RecoverWithCodeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecoverWithCodeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if len(errors) > 0 {
+		return RecoverWithCodeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecoverWithCodeResponseMultiError is an error wrapping multiple validation
+// errors returned by RecoverWithCodeResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RecoverWithCodeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecoverWithCodeResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecoverWithCodeResponseMultiError) AllErrors() []error { return m }
+
+// RecoverWithCodeResponseValidationError is the validation error returned by
+// RecoverWithCodeResponse.Validate if the designated constraints aren't met.
+type RecoverWithCodeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecoverWithCodeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecoverWithCodeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecoverWithCodeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecoverWithCodeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecoverWithCodeResponseValidationError) ErrorName() string {
+	return "RecoverWithCodeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecoverWithCodeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecoverWithCodeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecoverWithCodeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecoverWithCodeResponseValidationError{}
+
+// Validate checks the field values on UploadPublicKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadPublicKeyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadPublicKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadPublicKeyRequestMultiError, or nil if none found.
+func (m *UploadPublicKeyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadPublicKeyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PublicKey
+
+	if len(errors) > 0 {
+		return UploadPublicKeyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadPublicKeyRequestMultiError is an error wrapping multiple validation
+// errors returned by UploadPublicKeyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UploadPublicKeyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadPublicKeyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadPublicKeyRequestMultiError) AllErrors() []error { return m }
+
+// UploadPublicKeyRequestValidationError is the validation error returned by
+// UploadPublicKeyRequest.Validate if the designated constraints aren't met.
+type UploadPublicKeyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadPublicKeyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadPublicKeyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadPublicKeyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadPublicKeyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadPublicKeyRequestValidationError) ErrorName() string {
+	return "UploadPublicKeyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadPublicKeyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadPublicKeyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadPublicKeyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadPublicKeyRequestValidationError{}
+
+// Validate checks the field values on UploadPublicKeyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadPublicKeyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadPublicKeyResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadPublicKeyResponseMultiError, or nil if none found.
+func (m *UploadPublicKeyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadPublicKeyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UploadPublicKeyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadPublicKeyResponseMultiError is an error wrapping multiple validation
+// errors returned by UploadPublicKeyResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UploadPublicKeyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadPublicKeyResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadPublicKeyResponseMultiError) AllErrors() []error { return m }
+
+// UploadPublicKeyResponseValidationError is the validation error returned by
+// UploadPublicKeyResponse.Validate if the designated constraints aren't met.
+type UploadPublicKeyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadPublicKeyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadPublicKeyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadPublicKeyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadPublicKeyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadPublicKeyResponseValidationError) ErrorName() string {
+	return "UploadPublicKeyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadPublicKeyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadPublicKeyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadPublicKeyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadPublicKeyResponseValidationError{}
+
+// Validate checks the field values on GetPublicKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPublicKeyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPublicKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPublicKeyRequestMultiError, or nil if none found.
+func (m *GetPublicKeyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPublicKeyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Login
+
+	if len(errors) > 0 {
+		return GetPublicKeyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPublicKeyRequestMultiError is an error wrapping multiple validation
+// errors returned by GetPublicKeyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetPublicKeyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPublicKeyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPublicKeyRequestMultiError) AllErrors() []error { return m }
+
+// GetPublicKeyRequestValidationError is the validation error returned by
+// GetPublicKeyRequest.Validate if the designated constraints aren't met.
+type GetPublicKeyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPublicKeyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPublicKeyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPublicKeyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPublicKeyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPublicKeyRequestValidationError) ErrorName() string {
+	return "GetPublicKeyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPublicKeyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPublicKeyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPublicKeyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPublicKeyRequestValidationError{}
+
+// Validate checks the field values on GetPublicKeyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPublicKeyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPublicKeyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPublicKeyResponseMultiError, or nil if none found.
+func (m *GetPublicKeyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPublicKeyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PublicKey
+
+	if len(errors) > 0 {
+		return GetPublicKeyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPublicKeyResponseMultiError is an error wrapping multiple validation
+// errors returned by GetPublicKeyResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetPublicKeyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPublicKeyResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPublicKeyResponseMultiError) AllErrors() []error { return m }
+
+// GetPublicKeyResponseValidationError is the validation error returned by
+// GetPublicKeyResponse.Validate if the designated constraints aren't met.
+type GetPublicKeyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPublicKeyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPublicKeyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPublicKeyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPublicKeyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPublicKeyResponseValidationError) ErrorName() string {
+	return "GetPublicKeyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPublicKeyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPublicKeyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPublicKeyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPublicKeyResponseValidationError{}
+
+// Validate checks the field values on Session with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Session) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Session with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in SessionMultiError, or nil if none found.
+func (m *Session) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Session) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DeviceId
+
+	if all {
+		switch v := interface{}(m.GetLastSeen()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SessionValidationError{
+					field:  "LastSeen",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SessionValidationError{
+					field:  "LastSeen",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastSeen()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SessionValidationError{
+				field:  "LastSeen",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Ip
+
+	// no validation rules for Revoked
+
+	if len(errors) > 0 {
+		return SessionMultiError(errors)
+	}
+
+	return nil
+}
+
+// SessionMultiError is an error wrapping multiple validation errors returned
+// by Session.ValidateAll() if the designated constraints aren't met.
+type SessionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SessionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SessionMultiError) AllErrors() []error { return m }
+
+// SessionValidationError is the validation error returned by Session.Validate
+// if the designated constraints aren't met.
+type SessionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SessionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SessionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SessionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SessionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SessionValidationError) ErrorName() string { return "SessionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SessionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSession.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SessionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SessionValidationError{}
+
+// Validate checks the field values on ListSessionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSessionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSessionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSessionsRequestMultiError, or nil if none found.
+func (m *ListSessionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSessionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListSessionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSessionsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListSessionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListSessionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSessionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSessionsRequestMultiError) AllErrors() []error { return m }
+
+// ListSessionsRequestValidationError is the validation error returned by
+// ListSessionsRequest.Validate if the designated constraints aren't met.
+type ListSessionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSessionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSessionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSessionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSessionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSessionsRequestValidationError) ErrorName() string {
+	return "ListSessionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSessionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSessionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSessionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSessionsRequestValidationError{}
+
+// Validate checks the field values on ListSessionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSessionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSessionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSessionsResponseMultiError, or nil if none found.
+func (m *ListSessionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSessionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSessions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSessionsResponseValidationError{
+						field:  fmt.Sprintf("Sessions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSessionsResponseValidationError{
+						field:  fmt.Sprintf("Sessions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSessionsResponseValidationError{
+					field:  fmt.Sprintf("Sessions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListSessionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSessionsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListSessionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListSessionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSessionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSessionsResponseMultiError) AllErrors() []error { return m }
+
+// ListSessionsResponseValidationError is the validation error returned by
+// ListSessionsResponse.Validate if the designated constraints aren't met.
+type ListSessionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSessionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSessionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSessionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSessionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSessionsResponseValidationError) ErrorName() string {
+	return "ListSessionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSessionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSessionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSessionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSessionsResponseValidationError{}
+
+// Validate checks the field values on RevokeSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeSessionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeSessionRequestMultiError, or nil if none found.
+func (m *RevokeSessionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeSessionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DeviceId
+
+	if len(errors) > 0 {
+		return RevokeSessionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeSessionRequestMultiError is an error wrapping multiple validation
+// errors returned by RevokeSessionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeSessionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeSessionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeSessionRequestMultiError) AllErrors() []error { return m }
+
+// RevokeSessionRequestValidationError is the validation error returned by
+// RevokeSessionRequest.Validate if the designated constraints aren't met.
+type RevokeSessionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeSessionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeSessionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeSessionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeSessionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeSessionRequestValidationError) ErrorName() string {
+	return "RevokeSessionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeSessionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeSessionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeSessionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeSessionRequestValidationError{}
+
+// Validate checks the field values on RevokeSessionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeSessionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeSessionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeSessionResponseMultiError, or nil if none found.
+func (m *RevokeSessionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeSessionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RevokeSessionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeSessionResponseMultiError is an error wrapping multiple validation
+// errors returned by RevokeSessionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeSessionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeSessionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeSessionResponseMultiError) AllErrors() []error { return m }
+
+// RevokeSessionResponseValidationError is the validation error returned by
+// RevokeSessionResponse.Validate if the designated constraints aren't met.
+type RevokeSessionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeSessionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeSessionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeSessionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeSessionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeSessionResponseValidationError) ErrorName() string {
+	return "RevokeSessionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeSessionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeSessionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeSessionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeSessionResponseValidationError{}
+
+// Validate checks the field values on ServerInfoRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ServerInfoRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ServerInfoRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ServerInfoRequestMultiError, or nil if none found.
+func (m *ServerInfoRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ServerInfoRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ServerInfoRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ServerInfoRequestMultiError is an error wrapping multiple validation errors
+// returned by ServerInfoRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ServerInfoRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ServerInfoRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ServerInfoRequestMultiError) AllErrors() []error { return m }
+
+// ServerInfoRequestValidationError is the validation error returned by
+// ServerInfoRequest.Validate if the designated constraints aren't met.
+type ServerInfoRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ServerInfoRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ServerInfoRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ServerInfoRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ServerInfoRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ServerInfoRequestValidationError) ErrorName() string {
+	return "ServerInfoRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ServerInfoRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sServerInfoRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ServerInfoRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ServerInfoRequestValidationError{}
+
+// Validate checks the field values on ServerInfoResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ServerInfoResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ServerInfoResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ServerInfoResponseMultiError, or nil if none found.
+func (m *ServerInfoResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ServerInfoResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetServerTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ServerInfoResponseValidationError{
+					field:  "ServerTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ServerInfoResponseValidationError{
+					field:  "ServerTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetServerTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ServerInfoResponseValidationError{
+				field:  "ServerTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ServerInfoResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ServerInfoResponseMultiError is an error wrapping multiple validation errors
+// returned by ServerInfoResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ServerInfoResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ServerInfoResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ServerInfoResponseMultiError) AllErrors() []error { return m }
+
+// ServerInfoResponseValidationError is the validation error returned by
+// ServerInfoResponse.Validate if the designated constraints aren't met.
+type ServerInfoResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ServerInfoResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ServerInfoResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ServerInfoResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ServerInfoResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ServerInfoResponseValidationError) ErrorName() string {
+	return "ServerInfoResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ServerInfoResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sServerInfoResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ServerInfoResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ServerInfoResponseValidationError{}
+
+// Validate checks the field values on GetChunkSizeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetChunkSizeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetChunkSizeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetChunkSizeRequestMultiError, or nil if none found.
+func (m *GetChunkSizeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetChunkSizeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetChunkSizeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetChunkSizeRequestMultiError is an error wrapping multiple validation
+// errors returned by GetChunkSizeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetChunkSizeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetChunkSizeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetChunkSizeRequestMultiError) AllErrors() []error { return m }
+
+// GetChunkSizeRequestValidationError is the validation error returned by
+// GetChunkSizeRequest.Validate if the designated constraints aren't met.
+type GetChunkSizeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetChunkSizeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetChunkSizeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetChunkSizeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetChunkSizeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetChunkSizeRequestValidationError) ErrorName() string {
+	return "GetChunkSizeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetChunkSizeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetChunkSizeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetChunkSizeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetChunkSizeRequestValidationError{}
+
+// Validate checks the field values on GetChunkSizeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetChunkSizeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetChunkSizeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetChunkSizeResponseMultiError, or nil if none found.
+func (m *GetChunkSizeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetChunkSizeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Size
+
+	if len(errors) > 0 {
+		return GetChunkSizeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetChunkSizeResponseMultiError is an error wrapping multiple validation
+// errors returned by GetChunkSizeResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetChunkSizeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetChunkSizeResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetChunkSizeResponseMultiError) AllErrors() []error { return m }
+
+// GetChunkSizeResponseValidationError is the validation error returned by
+// GetChunkSizeResponse.Validate if the designated constraints aren't met.
+type GetChunkSizeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetChunkSizeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetChunkSizeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetChunkSizeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetChunkSizeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetChunkSizeResponseValidationError) ErrorName() string {
+	return "GetChunkSizeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetChunkSizeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetChunkSizeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetChunkSizeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetChunkSizeResponseValidationError{}
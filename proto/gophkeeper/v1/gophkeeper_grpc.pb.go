@@ -0,0 +1,1896 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: gophkeeper/v1/gophkeeper.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	GophKeeperService_Register_FullMethodName               = "/gophkeeper.v1.GophKeeperService/Register"
+	GophKeeperService_Auth_FullMethodName                   = "/gophkeeper.v1.GophKeeperService/Auth"
+	GophKeeperService_ImportUser_FullMethodName             = "/gophkeeper.v1.GophKeeperService/ImportUser"
+	GophKeeperService_Maintenance_FullMethodName            = "/gophkeeper.v1.GophKeeperService/Maintenance"
+	GophKeeperService_GetIntegrityReport_FullMethodName     = "/gophkeeper.v1.GophKeeperService/GetIntegrityReport"
+	GophKeeperService_ValidateToken_FullMethodName          = "/gophkeeper.v1.GophKeeperService/ValidateToken"
+	GophKeeperService_Challenge_FullMethodName              = "/gophkeeper.v1.GophKeeperService/Challenge"
+	GophKeeperService_GenerateRecoveryCodes_FullMethodName  = "/gophkeeper.v1.GophKeeperService/GenerateRecoveryCodes"
+	GophKeeperService_RecoverWithCode_FullMethodName        = "/gophkeeper.v1.GophKeeperService/RecoverWithCode"
+	GophKeeperService_UploadPublicKey_FullMethodName        = "/gophkeeper.v1.GophKeeperService/UploadPublicKey"
+	GophKeeperService_GetPublicKey_FullMethodName           = "/gophkeeper.v1.GophKeeperService/GetPublicKey"
+	GophKeeperService_GetChunkSize_FullMethodName           = "/gophkeeper.v1.GophKeeperService/GetChunkSize"
+	GophKeeperService_ServerInfo_FullMethodName             = "/gophkeeper.v1.GophKeeperService/ServerInfo"
+	GophKeeperService_CreatePassword_FullMethodName         = "/gophkeeper.v1.GophKeeperService/CreatePassword"
+	GophKeeperService_UpdatePassword_FullMethodName         = "/gophkeeper.v1.GophKeeperService/UpdatePassword"
+	GophKeeperService_GetPassword_FullMethodName            = "/gophkeeper.v1.GophKeeperService/GetPassword"
+	GophKeeperService_GetPasswords_FullMethodName           = "/gophkeeper.v1.GophKeeperService/GetPasswords"
+	GophKeeperService_GetPasswordsByCategory_FullMethodName = "/gophkeeper.v1.GophKeeperService/GetPasswordsByCategory"
+	GophKeeperService_DeletePassword_FullMethodName         = "/gophkeeper.v1.GophKeeperService/DeletePassword"
+	GophKeeperService_TouchPassword_FullMethodName          = "/gophkeeper.v1.GophKeeperService/TouchPassword"
+	GophKeeperService_GetReusedPasswords_FullMethodName     = "/gophkeeper.v1.GophKeeperService/GetReusedPasswords"
+	GophKeeperService_CreateFile_FullMethodName             = "/gophkeeper.v1.GophKeeperService/CreateFile"
+	GophKeeperService_CreateFileStream_FullMethodName       = "/gophkeeper.v1.GophKeeperService/CreateFileStream"
+	GophKeeperService_UpdateFile_FullMethodName             = "/gophkeeper.v1.GophKeeperService/UpdateFile"
+	GophKeeperService_UpdateFileInfo_FullMethodName         = "/gophkeeper.v1.GophKeeperService/UpdateFileInfo"
+	GophKeeperService_GetFile_FullMethodName                = "/gophkeeper.v1.GophKeeperService/GetFile"
+	GophKeeperService_GetFiles_FullMethodName               = "/gophkeeper.v1.GophKeeperService/GetFiles"
+	GophKeeperService_DeleteFile_FullMethodName             = "/gophkeeper.v1.GophKeeperService/DeleteFile"
+	GophKeeperService_CreateBank_FullMethodName             = "/gophkeeper.v1.GophKeeperService/CreateBank"
+	GophKeeperService_UpdateBank_FullMethodName             = "/gophkeeper.v1.GophKeeperService/UpdateBank"
+	GophKeeperService_GetBank_FullMethodName                = "/gophkeeper.v1.GophKeeperService/GetBank"
+	GophKeeperService_GetBanks_FullMethodName               = "/gophkeeper.v1.GophKeeperService/GetBanks"
+	GophKeeperService_DeleteBank_FullMethodName             = "/gophkeeper.v1.GophKeeperService/DeleteBank"
+	GophKeeperService_CreateText_FullMethodName             = "/gophkeeper.v1.GophKeeperService/CreateText"
+	GophKeeperService_UpdateText_FullMethodName             = "/gophkeeper.v1.GophKeeperService/UpdateText"
+	GophKeeperService_GetText_FullMethodName                = "/gophkeeper.v1.GophKeeperService/GetText"
+	GophKeeperService_GetTexts_FullMethodName               = "/gophkeeper.v1.GophKeeperService/GetTexts"
+	GophKeeperService_DeleteText_FullMethodName             = "/gophkeeper.v1.GophKeeperService/DeleteText"
+	GophKeeperService_GetRecentActivity_FullMethodName      = "/gophkeeper.v1.GophKeeperService/GetRecentActivity"
+	GophKeeperService_ListEntryIDs_FullMethodName           = "/gophkeeper.v1.GophKeeperService/ListEntryIDs"
+	GophKeeperService_GetFavorites_FullMethodName           = "/gophkeeper.v1.GophKeeperService/GetFavorites"
+	GophKeeperService_GetVaultManifest_FullMethodName       = "/gophkeeper.v1.GophKeeperService/GetVaultManifest"
+	GophKeeperService_ListSessions_FullMethodName           = "/gophkeeper.v1.GophKeeperService/ListSessions"
+	GophKeeperService_RevokeSession_FullMethodName          = "/gophkeeper.v1.GophKeeperService/RevokeSession"
+)
+
+// GophKeeperServiceClient is the client API for GophKeeperService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GophKeeperServiceClient interface {
+	Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error)
+	Auth(ctx context.Context, in *AuthRequest, opts ...grpc.CallOption) (*AuthResponse, error)
+	ImportUser(ctx context.Context, in *ImportUserRequest, opts ...grpc.CallOption) (*ImportUserResponse, error)
+	Maintenance(ctx context.Context, in *MaintenanceRequest, opts ...grpc.CallOption) (*MaintenanceResponse, error)
+	// GetIntegrityReport returns the latest blob integrity scan results.
+	// Guarded by the admin token, like Maintenance.
+	GetIntegrityReport(ctx context.Context, in *GetIntegrityReportRequest, opts ...grpc.CallOption) (*GetIntegrityReportResponse, error)
+	ValidateToken(ctx context.Context, in *ValidateTokenRequest, opts ...grpc.CallOption) (*ValidateTokenResponse, error)
+	// Challenge signs the client's nonce with the server's signing key so
+	// the client can confirm the server's identity before sending
+	// credentials. Available without authentication.
+	Challenge(ctx context.Context, in *ChallengeRequest, opts ...grpc.CallOption) (*ChallengeResponse, error)
+	GenerateRecoveryCodes(ctx context.Context, in *GenerateRecoveryCodesRequest, opts ...grpc.CallOption) (*GenerateRecoveryCodesResponse, error)
+	RecoverWithCode(ctx context.Context, in *RecoverWithCodeRequest, opts ...grpc.CallOption) (*RecoverWithCodeResponse, error)
+	// UploadPublicKey stores the caller's public key so other users can
+	// discover it for sharing.
+	UploadPublicKey(ctx context.Context, in *UploadPublicKeyRequest, opts ...grpc.CallOption) (*UploadPublicKeyResponse, error)
+	// GetPublicKey returns the public key uploaded by the user with the
+	// given login, so a sharer can re-wrap data keys for that recipient.
+	GetPublicKey(ctx context.Context, in *GetPublicKeyRequest, opts ...grpc.CallOption) (*GetPublicKeyResponse, error)
+	GetChunkSize(ctx context.Context, in *GetChunkSizeRequest, opts ...grpc.CallOption) (*GetChunkSizeResponse, error)
+	// ServerInfo reports the server's current time so clients can compute
+	// their clock offset. Available without authentication.
+	ServerInfo(ctx context.Context, in *ServerInfoRequest, opts ...grpc.CallOption) (*ServerInfoResponse, error)
+	CreatePassword(ctx context.Context, in *CreatePasswordRequest, opts ...grpc.CallOption) (*CreatePasswordResponse, error)
+	UpdatePassword(ctx context.Context, in *UpdatePasswordRequest, opts ...grpc.CallOption) (*UpdatePasswordResponse, error)
+	GetPassword(ctx context.Context, in *GetPasswordRequest, opts ...grpc.CallOption) (*GetPasswordResponse, error)
+	GetPasswords(ctx context.Context, in *GetPasswordsRequest, opts ...grpc.CallOption) (*GetPasswordsResponse, error)
+	// GetPasswordsByCategory returns the passwords whose plaintext
+	// meta_json category matches, filtered server-side via the GIN index.
+	GetPasswordsByCategory(ctx context.Context, in *GetPasswordsByCategoryRequest, opts ...grpc.CallOption) (*GetPasswordsByCategoryResponse, error)
+	DeletePassword(ctx context.Context, in *DeletePasswordRequest, opts ...grpc.CallOption) (*DeletePasswordResponse, error)
+	// TouchPassword bumps the entry's update time without changing its
+	// fields, so clients can mark an entry as reviewed without re-sending
+	// the encrypted payload.
+	TouchPassword(ctx context.Context, in *TouchPasswordRequest, opts ...grpc.CallOption) (*TouchPasswordResponse, error)
+	// GetReusedPasswords groups the caller's password entries by their
+	// client-supplied fingerprint and returns the groups stored more than
+	// once. Entries without a fingerprint are ignored.
+	GetReusedPasswords(ctx context.Context, in *GetReusedPasswordsRequest, opts ...grpc.CallOption) (*GetReusedPasswordsResponse, error)
+	CreateFile(ctx context.Context, opts ...grpc.CallOption) (GophKeeperService_CreateFileClient, error)
+	// CreateFileStream uploads a file like CreateFile but acknowledges the
+	// total content bytes stored after every message, so a client can show
+	// confirmed progress and detect stalls. The final message carries the
+	// new file's id; clients that ignore the acks keep CreateFile behavior.
+	CreateFileStream(ctx context.Context, opts ...grpc.CallOption) (GophKeeperService_CreateFileStreamClient, error)
+	UpdateFile(ctx context.Context, opts ...grpc.CallOption) (GophKeeperService_UpdateFileClient, error)
+	// UpdateFileInfo updates a file's name and metadata without touching
+	// its stored content, so relabeling does not re-upload the blob.
+	UpdateFileInfo(ctx context.Context, in *UpdateFileInfoRequest, opts ...grpc.CallOption) (*UpdateFileInfoResponse, error)
+	GetFile(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (GophKeeperService_GetFileClient, error)
+	GetFiles(ctx context.Context, in *GetFilesRequest, opts ...grpc.CallOption) (*GetFilesResponse, error)
+	DeleteFile(ctx context.Context, in *DeleteFileRequest, opts ...grpc.CallOption) (*DeleteFileResponse, error)
+	CreateBank(ctx context.Context, in *CreateBankRequest, opts ...grpc.CallOption) (*CreateBankResponse, error)
+	UpdateBank(ctx context.Context, in *UpdateBankRequest, opts ...grpc.CallOption) (*UpdateBankResponse, error)
+	GetBank(ctx context.Context, in *GetBankRequest, opts ...grpc.CallOption) (*GetBankResponse, error)
+	GetBanks(ctx context.Context, in *GetBanksRequest, opts ...grpc.CallOption) (*GetBanksResponse, error)
+	DeleteBank(ctx context.Context, in *DeleteBankRequest, opts ...grpc.CallOption) (*DeleteBankResponse, error)
+	CreateText(ctx context.Context, in *CreateTextRequest, opts ...grpc.CallOption) (*CreateTextResponse, error)
+	UpdateText(ctx context.Context, in *UpdateTextRequest, opts ...grpc.CallOption) (*UpdateTextResponse, error)
+	GetText(ctx context.Context, in *GetTextRequest, opts ...grpc.CallOption) (*GetTextResponse, error)
+	GetTexts(ctx context.Context, in *GetTextsRequest, opts ...grpc.CallOption) (*GetTextsResponse, error)
+	DeleteText(ctx context.Context, in *DeleteTextRequest, opts ...grpc.CallOption) (*DeleteTextResponse, error)
+	GetRecentActivity(ctx context.Context, in *GetRecentActivityRequest, opts ...grpc.CallOption) (*GetRecentActivityResponse, error)
+	ListEntryIDs(ctx context.Context, in *ListEntryIDsRequest, opts ...grpc.CallOption) (*ListEntryIDsResponse, error)
+	// GetFavorites returns the caller's pinned entries across all kinds.
+	GetFavorites(ctx context.Context, in *GetFavoritesRequest, opts ...grpc.CallOption) (*GetFavoritesResponse, error)
+	// GetVaultManifest returns every entry of every kind in one paginated
+	// response, backed by a single UNION query.
+	GetVaultManifest(ctx context.Context, in *GetVaultManifestRequest, opts ...grpc.CallOption) (*GetVaultManifestResponse, error)
+	// ListSessions returns the caller's device sessions, so users can see
+	// where they are logged in.
+	ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error)
+	// RevokeSession kills one device session by bumping its token marker:
+	// tokens issued for that device before the call stop working. The marker
+	// is checked against the device-id request metadata, so a client that
+	// stops sending its device id bypasses the check until its token expires.
+	RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error)
+}
+
+type gophKeeperServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGophKeeperServiceClient(cc grpc.ClientConnInterface) GophKeeperServiceClient {
+	return &gophKeeperServiceClient{cc}
+}
+
+func (c *gophKeeperServiceClient) Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error) {
+	out := new(RegisterResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_Register_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperServiceClient) Auth(ctx context.Context, in *AuthRequest, opts ...grpc.CallOption) (*AuthResponse, error) {
+	out := new(AuthResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_Auth_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperServiceClient) ImportUser(ctx context.Context, in *ImportUserRequest, opts ...grpc.CallOption) (*ImportUserResponse, error) {
+	out := new(ImportUserResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_ImportUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperServiceClient) Maintenance(ctx context.Context, in *MaintenanceRequest, opts ...grpc.CallOption) (*MaintenanceResponse, error) {
+	out := new(MaintenanceResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_Maintenance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperServiceClient) GetIntegrityReport(ctx context.Context, in *GetIntegrityReportRequest, opts ...grpc.CallOption) (*GetIntegrityReportResponse, error) {
+	out := new(GetIntegrityReportResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_GetIntegrityReport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperServiceClient) ValidateToken(ctx context.Context, in *ValidateTokenRequest, opts ...grpc.CallOption) (*ValidateTokenResponse, error) {
+	out := new(ValidateTokenResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_ValidateToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperServiceClient) Challenge(ctx context.Context, in *ChallengeRequest, opts ...grpc.CallOption) (*ChallengeResponse, error) {
+	out := new(ChallengeResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_Challenge_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperServiceClient) GenerateRecoveryCodes(ctx context.Context, in *GenerateRecoveryCodesRequest, opts ...grpc.CallOption) (*GenerateRecoveryCodesResponse, error) {
+	out := new(GenerateRecoveryCodesResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_GenerateRecoveryCodes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperServiceClient) RecoverWithCode(ctx context.Context, in *RecoverWithCodeRequest, opts ...grpc.CallOption) (*RecoverWithCodeResponse, error) {
+	out := new(RecoverWithCodeResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_RecoverWithCode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperServiceClient) UploadPublicKey(ctx context.Context, in *UploadPublicKeyRequest, opts ...grpc.CallOption) (*UploadPublicKeyResponse, error) {
+	out := new(UploadPublicKeyResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_UploadPublicKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperServiceClient) GetPublicKey(ctx context.Context, in *GetPublicKeyRequest, opts ...grpc.CallOption) (*GetPublicKeyResponse, error) {
+	out := new(GetPublicKeyResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_GetPublicKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperServiceClient) GetChunkSize(ctx context.Context, in *GetChunkSizeRequest, opts ...grpc.CallOption) (*GetChunkSizeResponse, error) {
+	out := new(GetChunkSizeResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_GetChunkSize_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperServiceClient) ServerInfo(ctx context.Context, in *ServerInfoRequest, opts ...grpc.CallOption) (*ServerInfoResponse, error) {
+	out := new(ServerInfoResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_ServerInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperServiceClient) CreatePassword(ctx context.Context, in *CreatePasswordRequest, opts ...grpc.CallOption) (*CreatePasswordResponse, error) {
+	out := new(CreatePasswordResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_CreatePassword_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperServiceClient) UpdatePassword(ctx context.Context, in *UpdatePasswordRequest, opts ...grpc.CallOption) (*UpdatePasswordResponse, error) {
+	out := new(UpdatePasswordResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_UpdatePassword_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperServiceClient) GetPassword(ctx context.Context, in *GetPasswordRequest, opts ...grpc.CallOption) (*GetPasswordResponse, error) {
+	out := new(GetPasswordResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_GetPassword_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperServiceClient) GetPasswords(ctx context.Context, in *GetPasswordsRequest, opts ...grpc.CallOption) (*GetPasswordsResponse, error) {
+	out := new(GetPasswordsResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_GetPasswords_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperServiceClient) GetPasswordsByCategory(ctx context.Context, in *GetPasswordsByCategoryRequest, opts ...grpc.CallOption) (*GetPasswordsByCategoryResponse, error) {
+	out := new(GetPasswordsByCategoryResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_GetPasswordsByCategory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperServiceClient) DeletePassword(ctx context.Context, in *DeletePasswordRequest, opts ...grpc.CallOption) (*DeletePasswordResponse, error) {
+	out := new(DeletePasswordResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_DeletePassword_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperServiceClient) TouchPassword(ctx context.Context, in *TouchPasswordRequest, opts ...grpc.CallOption) (*TouchPasswordResponse, error) {
+	out := new(TouchPasswordResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_TouchPassword_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperServiceClient) GetReusedPasswords(ctx context.Context, in *GetReusedPasswordsRequest, opts ...grpc.CallOption) (*GetReusedPasswordsResponse, error) {
+	out := new(GetReusedPasswordsResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_GetReusedPasswords_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperServiceClient) CreateFile(ctx context.Context, opts ...grpc.CallOption) (GophKeeperService_CreateFileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GophKeeperService_ServiceDesc.Streams[0], GophKeeperService_CreateFile_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gophKeeperServiceCreateFileClient{stream}
+	return x, nil
+}
+
+type GophKeeperService_CreateFileClient interface {
+	Send(*CreateFileRequest) error
+	CloseAndRecv() (*CreateFileResponse, error)
+	grpc.ClientStream
+}
+
+type gophKeeperServiceCreateFileClient struct {
+	grpc.ClientStream
+}
+
+func (x *gophKeeperServiceCreateFileClient) Send(m *CreateFileRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *gophKeeperServiceCreateFileClient) CloseAndRecv() (*CreateFileResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(CreateFileResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gophKeeperServiceClient) CreateFileStream(ctx context.Context, opts ...grpc.CallOption) (GophKeeperService_CreateFileStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GophKeeperService_ServiceDesc.Streams[1], GophKeeperService_CreateFileStream_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gophKeeperServiceCreateFileStreamClient{stream}
+	return x, nil
+}
+
+type GophKeeperService_CreateFileStreamClient interface {
+	Send(*CreateFileStreamRequest) error
+	Recv() (*CreateFileStreamResponse, error)
+	grpc.ClientStream
+}
+
+type gophKeeperServiceCreateFileStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *gophKeeperServiceCreateFileStreamClient) Send(m *CreateFileStreamRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *gophKeeperServiceCreateFileStreamClient) Recv() (*CreateFileStreamResponse, error) {
+	m := new(CreateFileStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gophKeeperServiceClient) UpdateFile(ctx context.Context, opts ...grpc.CallOption) (GophKeeperService_UpdateFileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GophKeeperService_ServiceDesc.Streams[2], GophKeeperService_UpdateFile_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gophKeeperServiceUpdateFileClient{stream}
+	return x, nil
+}
+
+type GophKeeperService_UpdateFileClient interface {
+	Send(*UpdateFileRequest) error
+	CloseAndRecv() (*UpdateFileResponse, error)
+	grpc.ClientStream
+}
+
+type gophKeeperServiceUpdateFileClient struct {
+	grpc.ClientStream
+}
+
+func (x *gophKeeperServiceUpdateFileClient) Send(m *UpdateFileRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *gophKeeperServiceUpdateFileClient) CloseAndRecv() (*UpdateFileResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(UpdateFileResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gophKeeperServiceClient) UpdateFileInfo(ctx context.Context, in *UpdateFileInfoRequest, opts ...grpc.CallOption) (*UpdateFileInfoResponse, error) {
+	out := new(UpdateFileInfoResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_UpdateFileInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperServiceClient) GetFile(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (GophKeeperService_GetFileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GophKeeperService_ServiceDesc.Streams[3], GophKeeperService_GetFile_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gophKeeperServiceGetFileClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GophKeeperService_GetFileClient interface {
+	Recv() (*GetFileResponse, error)
+	grpc.ClientStream
+}
+
+type gophKeeperServiceGetFileClient struct {
+	grpc.ClientStream
+}
+
+func (x *gophKeeperServiceGetFileClient) Recv() (*GetFileResponse, error) {
+	m := new(GetFileResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gophKeeperServiceClient) GetFiles(ctx context.Context, in *GetFilesRequest, opts ...grpc.CallOption) (*GetFilesResponse, error) {
+	out := new(GetFilesResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_GetFiles_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperServiceClient) DeleteFile(ctx context.Context, in *DeleteFileRequest, opts ...grpc.CallOption) (*DeleteFileResponse, error) {
+	out := new(DeleteFileResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_DeleteFile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperServiceClient) CreateBank(ctx context.Context, in *CreateBankRequest, opts ...grpc.CallOption) (*CreateBankResponse, error) {
+	out := new(CreateBankResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_CreateBank_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperServiceClient) UpdateBank(ctx context.Context, in *UpdateBankRequest, opts ...grpc.CallOption) (*UpdateBankResponse, error) {
+	out := new(UpdateBankResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_UpdateBank_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperServiceClient) GetBank(ctx context.Context, in *GetBankRequest, opts ...grpc.CallOption) (*GetBankResponse, error) {
+	out := new(GetBankResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_GetBank_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperServiceClient) GetBanks(ctx context.Context, in *GetBanksRequest, opts ...grpc.CallOption) (*GetBanksResponse, error) {
+	out := new(GetBanksResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_GetBanks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperServiceClient) DeleteBank(ctx context.Context, in *DeleteBankRequest, opts ...grpc.CallOption) (*DeleteBankResponse, error) {
+	out := new(DeleteBankResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_DeleteBank_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperServiceClient) CreateText(ctx context.Context, in *CreateTextRequest, opts ...grpc.CallOption) (*CreateTextResponse, error) {
+	out := new(CreateTextResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_CreateText_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperServiceClient) UpdateText(ctx context.Context, in *UpdateTextRequest, opts ...grpc.CallOption) (*UpdateTextResponse, error) {
+	out := new(UpdateTextResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_UpdateText_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperServiceClient) GetText(ctx context.Context, in *GetTextRequest, opts ...grpc.CallOption) (*GetTextResponse, error) {
+	out := new(GetTextResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_GetText_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperServiceClient) GetTexts(ctx context.Context, in *GetTextsRequest, opts ...grpc.CallOption) (*GetTextsResponse, error) {
+	out := new(GetTextsResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_GetTexts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperServiceClient) DeleteText(ctx context.Context, in *DeleteTextRequest, opts ...grpc.CallOption) (*DeleteTextResponse, error) {
+	out := new(DeleteTextResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_DeleteText_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperServiceClient) GetRecentActivity(ctx context.Context, in *GetRecentActivityRequest, opts ...grpc.CallOption) (*GetRecentActivityResponse, error) {
+	out := new(GetRecentActivityResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_GetRecentActivity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperServiceClient) ListEntryIDs(ctx context.Context, in *ListEntryIDsRequest, opts ...grpc.CallOption) (*ListEntryIDsResponse, error) {
+	out := new(ListEntryIDsResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_ListEntryIDs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperServiceClient) GetFavorites(ctx context.Context, in *GetFavoritesRequest, opts ...grpc.CallOption) (*GetFavoritesResponse, error) {
+	out := new(GetFavoritesResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_GetFavorites_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperServiceClient) GetVaultManifest(ctx context.Context, in *GetVaultManifestRequest, opts ...grpc.CallOption) (*GetVaultManifestResponse, error) {
+	out := new(GetVaultManifestResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_GetVaultManifest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperServiceClient) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error) {
+	out := new(ListSessionsResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_ListSessions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperServiceClient) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error) {
+	out := new(RevokeSessionResponse)
+	err := c.cc.Invoke(ctx, GophKeeperService_RevokeSession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GophKeeperServiceServer is the server API for GophKeeperService service.
+// All implementations must embed UnimplementedGophKeeperServiceServer
+// for forward compatibility
+type GophKeeperServiceServer interface {
+	Register(context.Context, *RegisterRequest) (*RegisterResponse, error)
+	Auth(context.Context, *AuthRequest) (*AuthResponse, error)
+	ImportUser(context.Context, *ImportUserRequest) (*ImportUserResponse, error)
+	Maintenance(context.Context, *MaintenanceRequest) (*MaintenanceResponse, error)
+	// GetIntegrityReport returns the latest blob integrity scan results.
+	// Guarded by the admin token, like Maintenance.
+	GetIntegrityReport(context.Context, *GetIntegrityReportRequest) (*GetIntegrityReportResponse, error)
+	ValidateToken(context.Context, *ValidateTokenRequest) (*ValidateTokenResponse, error)
+	// Challenge signs the client's nonce with the server's signing key so
+	// the client can confirm the server's identity before sending
+	// credentials. Available without authentication.
+	Challenge(context.Context, *ChallengeRequest) (*ChallengeResponse, error)
+	GenerateRecoveryCodes(context.Context, *GenerateRecoveryCodesRequest) (*GenerateRecoveryCodesResponse, error)
+	RecoverWithCode(context.Context, *RecoverWithCodeRequest) (*RecoverWithCodeResponse, error)
+	// UploadPublicKey stores the caller's public key so other users can
+	// discover it for sharing.
+	UploadPublicKey(context.Context, *UploadPublicKeyRequest) (*UploadPublicKeyResponse, error)
+	// GetPublicKey returns the public key uploaded by the user with the
+	// given login, so a sharer can re-wrap data keys for that recipient.
+	GetPublicKey(context.Context, *GetPublicKeyRequest) (*GetPublicKeyResponse, error)
+	GetChunkSize(context.Context, *GetChunkSizeRequest) (*GetChunkSizeResponse, error)
+	// ServerInfo reports the server's current time so clients can compute
+	// their clock offset. Available without authentication.
+	ServerInfo(context.Context, *ServerInfoRequest) (*ServerInfoResponse, error)
+	CreatePassword(context.Context, *CreatePasswordRequest) (*CreatePasswordResponse, error)
+	UpdatePassword(context.Context, *UpdatePasswordRequest) (*UpdatePasswordResponse, error)
+	GetPassword(context.Context, *GetPasswordRequest) (*GetPasswordResponse, error)
+	GetPasswords(context.Context, *GetPasswordsRequest) (*GetPasswordsResponse, error)
+	// GetPasswordsByCategory returns the passwords whose plaintext
+	// meta_json category matches, filtered server-side via the GIN index.
+	GetPasswordsByCategory(context.Context, *GetPasswordsByCategoryRequest) (*GetPasswordsByCategoryResponse, error)
+	DeletePassword(context.Context, *DeletePasswordRequest) (*DeletePasswordResponse, error)
+	// TouchPassword bumps the entry's update time without changing its
+	// fields, so clients can mark an entry as reviewed without re-sending
+	// the encrypted payload.
+	TouchPassword(context.Context, *TouchPasswordRequest) (*TouchPasswordResponse, error)
+	// GetReusedPasswords groups the caller's password entries by their
+	// client-supplied fingerprint and returns the groups stored more than
+	// once. Entries without a fingerprint are ignored.
+	GetReusedPasswords(context.Context, *GetReusedPasswordsRequest) (*GetReusedPasswordsResponse, error)
+	CreateFile(GophKeeperService_CreateFileServer) error
+	// CreateFileStream uploads a file like CreateFile but acknowledges the
+	// total content bytes stored after every message, so a client can show
+	// confirmed progress and detect stalls. The final message carries the
+	// new file's id; clients that ignore the acks keep CreateFile behavior.
+	CreateFileStream(GophKeeperService_CreateFileStreamServer) error
+	UpdateFile(GophKeeperService_UpdateFileServer) error
+	// UpdateFileInfo updates a file's name and metadata without touching
+	// its stored content, so relabeling does not re-upload the blob.
+	UpdateFileInfo(context.Context, *UpdateFileInfoRequest) (*UpdateFileInfoResponse, error)
+	GetFile(*GetFileRequest, GophKeeperService_GetFileServer) error
+	GetFiles(context.Context, *GetFilesRequest) (*GetFilesResponse, error)
+	DeleteFile(context.Context, *DeleteFileRequest) (*DeleteFileResponse, error)
+	CreateBank(context.Context, *CreateBankRequest) (*CreateBankResponse, error)
+	UpdateBank(context.Context, *UpdateBankRequest) (*UpdateBankResponse, error)
+	GetBank(context.Context, *GetBankRequest) (*GetBankResponse, error)
+	GetBanks(context.Context, *GetBanksRequest) (*GetBanksResponse, error)
+	DeleteBank(context.Context, *DeleteBankRequest) (*DeleteBankResponse, error)
+	CreateText(context.Context, *CreateTextRequest) (*CreateTextResponse, error)
+	UpdateText(context.Context, *UpdateTextRequest) (*UpdateTextResponse, error)
+	GetText(context.Context, *GetTextRequest) (*GetTextResponse, error)
+	GetTexts(context.Context, *GetTextsRequest) (*GetTextsResponse, error)
+	DeleteText(context.Context, *DeleteTextRequest) (*DeleteTextResponse, error)
+	GetRecentActivity(context.Context, *GetRecentActivityRequest) (*GetRecentActivityResponse, error)
+	ListEntryIDs(context.Context, *ListEntryIDsRequest) (*ListEntryIDsResponse, error)
+	// GetFavorites returns the caller's pinned entries across all kinds.
+	GetFavorites(context.Context, *GetFavoritesRequest) (*GetFavoritesResponse, error)
+	// GetVaultManifest returns every entry of every kind in one paginated
+	// response, backed by a single UNION query.
+	GetVaultManifest(context.Context, *GetVaultManifestRequest) (*GetVaultManifestResponse, error)
+	// ListSessions returns the caller's device sessions, so users can see
+	// where they are logged in.
+	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error)
+	// RevokeSession kills one device session by bumping its token marker:
+	// tokens issued for that device before the call stop working. The marker
+	// is checked against the device-id request metadata, so a client that
+	// stops sending its device id bypasses the check until its token expires.
+	RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error)
+	mustEmbedUnimplementedGophKeeperServiceServer()
+}
+
+// UnimplementedGophKeeperServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedGophKeeperServiceServer struct {
+}
+
+func (UnimplementedGophKeeperServiceServer) Register(context.Context, *RegisterRequest) (*RegisterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) Auth(context.Context, *AuthRequest) (*AuthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Auth not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) ImportUser(context.Context, *ImportUserRequest) (*ImportUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportUser not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) Maintenance(context.Context, *MaintenanceRequest) (*MaintenanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Maintenance not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) GetIntegrityReport(context.Context, *GetIntegrityReportRequest) (*GetIntegrityReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIntegrityReport not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) ValidateToken(context.Context, *ValidateTokenRequest) (*ValidateTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateToken not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) Challenge(context.Context, *ChallengeRequest) (*ChallengeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Challenge not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) GenerateRecoveryCodes(context.Context, *GenerateRecoveryCodesRequest) (*GenerateRecoveryCodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateRecoveryCodes not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) RecoverWithCode(context.Context, *RecoverWithCodeRequest) (*RecoverWithCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoverWithCode not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) UploadPublicKey(context.Context, *UploadPublicKeyRequest) (*UploadPublicKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadPublicKey not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) GetPublicKey(context.Context, *GetPublicKeyRequest) (*GetPublicKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPublicKey not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) GetChunkSize(context.Context, *GetChunkSizeRequest) (*GetChunkSizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChunkSize not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) ServerInfo(context.Context, *ServerInfoRequest) (*ServerInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServerInfo not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) CreatePassword(context.Context, *CreatePasswordRequest) (*CreatePasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePassword not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) UpdatePassword(context.Context, *UpdatePasswordRequest) (*UpdatePasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePassword not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) GetPassword(context.Context, *GetPasswordRequest) (*GetPasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPassword not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) GetPasswords(context.Context, *GetPasswordsRequest) (*GetPasswordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPasswords not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) GetPasswordsByCategory(context.Context, *GetPasswordsByCategoryRequest) (*GetPasswordsByCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPasswordsByCategory not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) DeletePassword(context.Context, *DeletePasswordRequest) (*DeletePasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePassword not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) TouchPassword(context.Context, *TouchPasswordRequest) (*TouchPasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TouchPassword not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) GetReusedPasswords(context.Context, *GetReusedPasswordsRequest) (*GetReusedPasswordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReusedPasswords not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) CreateFile(GophKeeperService_CreateFileServer) error {
+	return status.Errorf(codes.Unimplemented, "method CreateFile not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) CreateFileStream(GophKeeperService_CreateFileStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method CreateFileStream not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) UpdateFile(GophKeeperService_UpdateFileServer) error {
+	return status.Errorf(codes.Unimplemented, "method UpdateFile not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) UpdateFileInfo(context.Context, *UpdateFileInfoRequest) (*UpdateFileInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFileInfo not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) GetFile(*GetFileRequest, GophKeeperService_GetFileServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetFile not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) GetFiles(context.Context, *GetFilesRequest) (*GetFilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFiles not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) DeleteFile(context.Context, *DeleteFileRequest) (*DeleteFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFile not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) CreateBank(context.Context, *CreateBankRequest) (*CreateBankResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBank not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) UpdateBank(context.Context, *UpdateBankRequest) (*UpdateBankResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBank not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) GetBank(context.Context, *GetBankRequest) (*GetBankResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBank not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) GetBanks(context.Context, *GetBanksRequest) (*GetBanksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBanks not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) DeleteBank(context.Context, *DeleteBankRequest) (*DeleteBankResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBank not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) CreateText(context.Context, *CreateTextRequest) (*CreateTextResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateText not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) UpdateText(context.Context, *UpdateTextRequest) (*UpdateTextResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateText not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) GetText(context.Context, *GetTextRequest) (*GetTextResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetText not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) GetTexts(context.Context, *GetTextsRequest) (*GetTextsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTexts not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) DeleteText(context.Context, *DeleteTextRequest) (*DeleteTextResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteText not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) GetRecentActivity(context.Context, *GetRecentActivityRequest) (*GetRecentActivityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecentActivity not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) ListEntryIDs(context.Context, *ListEntryIDsRequest) (*ListEntryIDsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEntryIDs not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) GetFavorites(context.Context, *GetFavoritesRequest) (*GetFavoritesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFavorites not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) GetVaultManifest(context.Context, *GetVaultManifestRequest) (*GetVaultManifestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVaultManifest not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (UnimplementedGophKeeperServiceServer) mustEmbedUnimplementedGophKeeperServiceServer() {}
+
+// UnsafeGophKeeperServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GophKeeperServiceServer will
+// result in compilation errors.
+type UnsafeGophKeeperServiceServer interface {
+	mustEmbedUnimplementedGophKeeperServiceServer()
+}
+
+func RegisterGophKeeperServiceServer(s grpc.ServiceRegistrar, srv GophKeeperServiceServer) {
+	s.RegisterService(&GophKeeperService_ServiceDesc, srv)
+}
+
+func _GophKeeperService_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).Register(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_Register_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).Register(ctx, req.(*RegisterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeperService_Auth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).Auth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_Auth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).Auth(ctx, req.(*AuthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeperService_ImportUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).ImportUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_ImportUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).ImportUser(ctx, req.(*ImportUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeperService_Maintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MaintenanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).Maintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_Maintenance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).Maintenance(ctx, req.(*MaintenanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeperService_GetIntegrityReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIntegrityReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).GetIntegrityReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_GetIntegrityReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).GetIntegrityReport(ctx, req.(*GetIntegrityReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeperService_ValidateToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).ValidateToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_ValidateToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).ValidateToken(ctx, req.(*ValidateTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeperService_Challenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).Challenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_Challenge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).Challenge(ctx, req.(*ChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeperService_GenerateRecoveryCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateRecoveryCodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).GenerateRecoveryCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_GenerateRecoveryCodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).GenerateRecoveryCodes(ctx, req.(*GenerateRecoveryCodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeperService_RecoverWithCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecoverWithCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).RecoverWithCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_RecoverWithCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).RecoverWithCode(ctx, req.(*RecoverWithCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeperService_UploadPublicKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadPublicKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).UploadPublicKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_UploadPublicKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).UploadPublicKey(ctx, req.(*UploadPublicKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeperService_GetPublicKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPublicKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).GetPublicKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_GetPublicKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).GetPublicKey(ctx, req.(*GetPublicKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeperService_GetChunkSize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChunkSizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).GetChunkSize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_GetChunkSize_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).GetChunkSize(ctx, req.(*GetChunkSizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeperService_ServerInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServerInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).ServerInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_ServerInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).ServerInfo(ctx, req.(*ServerInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeperService_CreatePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).CreatePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_CreatePassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).CreatePassword(ctx, req.(*CreatePasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeperService_UpdatePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).UpdatePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_UpdatePassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).UpdatePassword(ctx, req.(*UpdatePasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeperService_GetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).GetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_GetPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).GetPassword(ctx, req.(*GetPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeperService_GetPasswords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPasswordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).GetPasswords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_GetPasswords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).GetPasswords(ctx, req.(*GetPasswordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeperService_GetPasswordsByCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPasswordsByCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).GetPasswordsByCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_GetPasswordsByCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).GetPasswordsByCategory(ctx, req.(*GetPasswordsByCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeperService_DeletePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).DeletePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_DeletePassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).DeletePassword(ctx, req.(*DeletePasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeperService_TouchPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TouchPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).TouchPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_TouchPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).TouchPassword(ctx, req.(*TouchPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeperService_GetReusedPasswords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReusedPasswordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).GetReusedPasswords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_GetReusedPasswords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).GetReusedPasswords(ctx, req.(*GetReusedPasswordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeperService_CreateFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(GophKeeperServiceServer).CreateFile(&gophKeeperServiceCreateFileServer{stream})
+}
+
+type GophKeeperService_CreateFileServer interface {
+	SendAndClose(*CreateFileResponse) error
+	Recv() (*CreateFileRequest, error)
+	grpc.ServerStream
+}
+
+type gophKeeperServiceCreateFileServer struct {
+	grpc.ServerStream
+}
+
+func (x *gophKeeperServiceCreateFileServer) SendAndClose(m *CreateFileResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *gophKeeperServiceCreateFileServer) Recv() (*CreateFileRequest, error) {
+	m := new(CreateFileRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _GophKeeperService_CreateFileStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(GophKeeperServiceServer).CreateFileStream(&gophKeeperServiceCreateFileStreamServer{stream})
+}
+
+type GophKeeperService_CreateFileStreamServer interface {
+	Send(*CreateFileStreamResponse) error
+	Recv() (*CreateFileStreamRequest, error)
+	grpc.ServerStream
+}
+
+type gophKeeperServiceCreateFileStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *gophKeeperServiceCreateFileStreamServer) Send(m *CreateFileStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *gophKeeperServiceCreateFileStreamServer) Recv() (*CreateFileStreamRequest, error) {
+	m := new(CreateFileStreamRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _GophKeeperService_UpdateFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(GophKeeperServiceServer).UpdateFile(&gophKeeperServiceUpdateFileServer{stream})
+}
+
+type GophKeeperService_UpdateFileServer interface {
+	SendAndClose(*UpdateFileResponse) error
+	Recv() (*UpdateFileRequest, error)
+	grpc.ServerStream
+}
+
+type gophKeeperServiceUpdateFileServer struct {
+	grpc.ServerStream
+}
+
+func (x *gophKeeperServiceUpdateFileServer) SendAndClose(m *UpdateFileResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *gophKeeperServiceUpdateFileServer) Recv() (*UpdateFileRequest, error) {
+	m := new(UpdateFileRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _GophKeeperService_UpdateFileInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFileInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).UpdateFileInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_UpdateFileInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).UpdateFileInfo(ctx, req.(*UpdateFileInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeperService_GetFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetFileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GophKeeperServiceServer).GetFile(m, &gophKeeperServiceGetFileServer{stream})
+}
+
+type GophKeeperService_GetFileServer interface {
+	Send(*GetFileResponse) error
+	grpc.ServerStream
+}
+
+type gophKeeperServiceGetFileServer struct {
+	grpc.ServerStream
+}
+
+func (x *gophKeeperServiceGetFileServer) Send(m *GetFileResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GophKeeperService_GetFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).GetFiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_GetFiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).GetFiles(ctx, req.(*GetFilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeperService_DeleteFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).DeleteFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_DeleteFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).DeleteFile(ctx, req.(*DeleteFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeperService_CreateBank_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBankRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).CreateBank(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_CreateBank_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).CreateBank(ctx, req.(*CreateBankRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeperService_UpdateBank_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBankRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).UpdateBank(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_UpdateBank_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).UpdateBank(ctx, req.(*UpdateBankRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeperService_GetBank_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBankRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).GetBank(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_GetBank_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).GetBank(ctx, req.(*GetBankRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeperService_GetBanks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBanksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).GetBanks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_GetBanks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).GetBanks(ctx, req.(*GetBanksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeperService_DeleteBank_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBankRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).DeleteBank(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_DeleteBank_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).DeleteBank(ctx, req.(*DeleteBankRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeperService_CreateText_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTextRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).CreateText(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_CreateText_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).CreateText(ctx, req.(*CreateTextRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeperService_UpdateText_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTextRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).UpdateText(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_UpdateText_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).UpdateText(ctx, req.(*UpdateTextRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeperService_GetText_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTextRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).GetText(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_GetText_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).GetText(ctx, req.(*GetTextRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeperService_GetTexts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTextsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).GetTexts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_GetTexts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).GetTexts(ctx, req.(*GetTextsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeperService_DeleteText_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTextRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).DeleteText(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_DeleteText_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).DeleteText(ctx, req.(*DeleteTextRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeperService_GetRecentActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecentActivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).GetRecentActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_GetRecentActivity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).GetRecentActivity(ctx, req.(*GetRecentActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeperService_ListEntryIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEntryIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).ListEntryIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_ListEntryIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).ListEntryIDs(ctx, req.(*ListEntryIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeperService_GetFavorites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFavoritesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).GetFavorites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_GetFavorites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).GetFavorites(ctx, req.(*GetFavoritesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeperService_GetVaultManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVaultManifestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).GetVaultManifest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_GetVaultManifest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).GetVaultManifest(ctx, req.(*GetVaultManifestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeperService_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_ListSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).ListSessions(ctx, req.(*ListSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeperService_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServiceServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeperService_RevokeSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServiceServer).RevokeSession(ctx, req.(*RevokeSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GophKeeperService_ServiceDesc is the grpc.ServiceDesc for GophKeeperService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GophKeeperService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gophkeeper.v1.GophKeeperService",
+	HandlerType: (*GophKeeperServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Register",
+			Handler:    _GophKeeperService_Register_Handler,
+		},
+		{
+			MethodName: "Auth",
+			Handler:    _GophKeeperService_Auth_Handler,
+		},
+		{
+			MethodName: "ImportUser",
+			Handler:    _GophKeeperService_ImportUser_Handler,
+		},
+		{
+			MethodName: "Maintenance",
+			Handler:    _GophKeeperService_Maintenance_Handler,
+		},
+		{
+			MethodName: "GetIntegrityReport",
+			Handler:    _GophKeeperService_GetIntegrityReport_Handler,
+		},
+		{
+			MethodName: "ValidateToken",
+			Handler:    _GophKeeperService_ValidateToken_Handler,
+		},
+		{
+			MethodName: "Challenge",
+			Handler:    _GophKeeperService_Challenge_Handler,
+		},
+		{
+			MethodName: "GenerateRecoveryCodes",
+			Handler:    _GophKeeperService_GenerateRecoveryCodes_Handler,
+		},
+		{
+			MethodName: "RecoverWithCode",
+			Handler:    _GophKeeperService_RecoverWithCode_Handler,
+		},
+		{
+			MethodName: "UploadPublicKey",
+			Handler:    _GophKeeperService_UploadPublicKey_Handler,
+		},
+		{
+			MethodName: "GetPublicKey",
+			Handler:    _GophKeeperService_GetPublicKey_Handler,
+		},
+		{
+			MethodName: "GetChunkSize",
+			Handler:    _GophKeeperService_GetChunkSize_Handler,
+		},
+		{
+			MethodName: "ServerInfo",
+			Handler:    _GophKeeperService_ServerInfo_Handler,
+		},
+		{
+			MethodName: "CreatePassword",
+			Handler:    _GophKeeperService_CreatePassword_Handler,
+		},
+		{
+			MethodName: "UpdatePassword",
+			Handler:    _GophKeeperService_UpdatePassword_Handler,
+		},
+		{
+			MethodName: "GetPassword",
+			Handler:    _GophKeeperService_GetPassword_Handler,
+		},
+		{
+			MethodName: "GetPasswords",
+			Handler:    _GophKeeperService_GetPasswords_Handler,
+		},
+		{
+			MethodName: "GetPasswordsByCategory",
+			Handler:    _GophKeeperService_GetPasswordsByCategory_Handler,
+		},
+		{
+			MethodName: "DeletePassword",
+			Handler:    _GophKeeperService_DeletePassword_Handler,
+		},
+		{
+			MethodName: "TouchPassword",
+			Handler:    _GophKeeperService_TouchPassword_Handler,
+		},
+		{
+			MethodName: "GetReusedPasswords",
+			Handler:    _GophKeeperService_GetReusedPasswords_Handler,
+		},
+		{
+			MethodName: "UpdateFileInfo",
+			Handler:    _GophKeeperService_UpdateFileInfo_Handler,
+		},
+		{
+			MethodName: "GetFiles",
+			Handler:    _GophKeeperService_GetFiles_Handler,
+		},
+		{
+			MethodName: "DeleteFile",
+			Handler:    _GophKeeperService_DeleteFile_Handler,
+		},
+		{
+			MethodName: "CreateBank",
+			Handler:    _GophKeeperService_CreateBank_Handler,
+		},
+		{
+			MethodName: "UpdateBank",
+			Handler:    _GophKeeperService_UpdateBank_Handler,
+		},
+		{
+			MethodName: "GetBank",
+			Handler:    _GophKeeperService_GetBank_Handler,
+		},
+		{
+			MethodName: "GetBanks",
+			Handler:    _GophKeeperService_GetBanks_Handler,
+		},
+		{
+			MethodName: "DeleteBank",
+			Handler:    _GophKeeperService_DeleteBank_Handler,
+		},
+		{
+			MethodName: "CreateText",
+			Handler:    _GophKeeperService_CreateText_Handler,
+		},
+		{
+			MethodName: "UpdateText",
+			Handler:    _GophKeeperService_UpdateText_Handler,
+		},
+		{
+			MethodName: "GetText",
+			Handler:    _GophKeeperService_GetText_Handler,
+		},
+		{
+			MethodName: "GetTexts",
+			Handler:    _GophKeeperService_GetTexts_Handler,
+		},
+		{
+			MethodName: "DeleteText",
+			Handler:    _GophKeeperService_DeleteText_Handler,
+		},
+		{
+			MethodName: "GetRecentActivity",
+			Handler:    _GophKeeperService_GetRecentActivity_Handler,
+		},
+		{
+			MethodName: "ListEntryIDs",
+			Handler:    _GophKeeperService_ListEntryIDs_Handler,
+		},
+		{
+			MethodName: "GetFavorites",
+			Handler:    _GophKeeperService_GetFavorites_Handler,
+		},
+		{
+			MethodName: "GetVaultManifest",
+			Handler:    _GophKeeperService_GetVaultManifest_Handler,
+		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _GophKeeperService_ListSessions_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _GophKeeperService_RevokeSession_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "CreateFile",
+			Handler:       _GophKeeperService_CreateFile_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "CreateFileStream",
+			Handler:       _GophKeeperService_CreateFileStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "UpdateFile",
+			Handler:       _GophKeeperService_UpdateFile_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "GetFile",
+			Handler:       _GophKeeperService_GetFile_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "gophkeeper/v1/gophkeeper.proto",
+}
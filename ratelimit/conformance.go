@@ -0,0 +1,60 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Conformance exercises the Store contract — increment, reset and window
+// expiry — against the store newStore builds for a given window, so every
+// backend passes the same suite. The expiry check sleeps past a short
+// window; keys are unique per check, so reruns against a persistent backend
+// do not interfere.
+func Conformance(t *testing.T, newStore func(window time.Duration) Store) {
+	ctx := context.Background()
+	suffix := time.Now().Format("20060102150405.000000000")
+
+	t.Run("increment counts within the window", func(t *testing.T) {
+		store := newStore(time.Minute)
+		key := "increment" + suffix
+
+		count, err := store.Increment(ctx, key)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, count)
+
+		count, err = store.Increment(ctx, key)
+		require.NoError(t, err)
+		require.EqualValues(t, 2, count)
+	})
+
+	t.Run("reset clears the count", func(t *testing.T) {
+		store := newStore(time.Minute)
+		key := "reset" + suffix
+
+		_, err := store.Increment(ctx, key)
+		require.NoError(t, err)
+
+		require.NoError(t, store.Reset(ctx, key))
+
+		count, err := store.Increment(ctx, key)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, count)
+	})
+
+	t.Run("elapsed window starts a fresh count", func(t *testing.T) {
+		store := newStore(100 * time.Millisecond)
+		key := "expiry" + suffix
+
+		_, err := store.Increment(ctx, key)
+		require.NoError(t, err)
+
+		time.Sleep(150 * time.Millisecond)
+
+		count, err := store.Increment(ctx, key)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, count)
+	})
+}
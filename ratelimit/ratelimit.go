@@ -0,0 +1,106 @@
+// Package ratelimit counts failed authentication attempts per key within a
+// rolling window, so the server can lock out brute-force attempts. Two
+// backends implement the same Store contract: an in-memory one for a single
+// node, where counters reset on restart, and a database-backed one whose
+// counters survive restarts and are shared across server instances.
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Store counts failed attempts. Increment records one failure against key and
+// returns the count accumulated within the current window; a window that has
+// elapsed since the first failure starts a fresh count of one. Reset clears
+// the key's count, for example after a successful login.
+type Store interface {
+	Increment(ctx context.Context, key string) (int64, error)
+	Reset(ctx context.Context, key string) error
+}
+
+type memoryEntry struct {
+	count       int64
+	windowStart time.Time
+}
+
+// MemoryStore keeps the counters in process memory. It is the default
+// backend: counters reset on restart and are not shared between instances,
+// which is fine for a single node.
+type MemoryStore struct {
+	mu      sync.Mutex
+	window  time.Duration
+	entries map[string]memoryEntry
+}
+
+// NewMemoryStore creates a MemoryStore whose counts expire window after the
+// first failure.
+func NewMemoryStore(window time.Duration) *MemoryStore {
+	return &MemoryStore{
+		window:  window,
+		entries: make(map[string]memoryEntry),
+	}
+}
+
+// Increment records one failure against key and returns the count within the
+// current window.
+func (m *MemoryStore) Increment(_ context.Context, key string) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entry, ok := m.entries[key]
+
+	if !ok || time.Since(entry.windowStart) >= m.window {
+		entry = memoryEntry{windowStart: time.Now()}
+	}
+
+	entry.count++
+	m.entries[key] = entry
+
+	return entry.count, nil
+}
+
+// Reset clears the key's count.
+func (m *MemoryStore) Reset(_ context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.entries, key)
+
+	return nil
+}
+
+// Counter is the storage surface DBStore needs: a shared attempt counter
+// whose window logic lives next to the data, so concurrent increments from
+// several instances stay consistent.
+type Counter interface {
+	IncrementAttempt(ctx context.Context, key string, window time.Duration) (int64, error)
+	ResetAttempts(ctx context.Context, key string) error
+}
+
+// DBStore keeps the counters in the database, so limits survive restarts and
+// apply across every server instance sharing the database.
+type DBStore struct {
+	c      Counter
+	window time.Duration
+}
+
+// NewDBStore creates a DBStore counting against c with the given window.
+func NewDBStore(c Counter, window time.Duration) *DBStore {
+	return &DBStore{
+		c:      c,
+		window: window,
+	}
+}
+
+// Increment records one failure against key and returns the count within the
+// current window.
+func (d *DBStore) Increment(ctx context.Context, key string) (int64, error) {
+	return d.c.IncrementAttempt(ctx, key, d.window)
+}
+
+// Reset clears the key's count.
+func (d *DBStore) Reset(ctx context.Context, key string) error {
+	return d.c.ResetAttempts(ctx, key)
+}
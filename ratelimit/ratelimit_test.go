@@ -0,0 +1,14 @@
+//go:build unit
+
+package ratelimit
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMemoryStoreConformance(t *testing.T) {
+	Conformance(t, func(window time.Duration) Store {
+		return NewMemoryStore(window)
+	})
+}
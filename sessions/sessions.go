@@ -0,0 +1,164 @@
+// Package sessions defines gRPC server interceptors that track the user's
+// device sessions. Every authenticated request carrying a device id in its
+// metadata touches a session row (last seen time and IP), and requests
+// whose token was issued before the session's revocation marker are
+// rejected, so a revoked session dies without affecting other devices.
+package sessions
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/Tomap-Tomap/GophKeeper/logger"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	userIDHeader   = "user_id"
+	deviceIDHeader = "device-id"
+	tokenExpHeader = "token_exp"
+	authAtHeader   = "auth_at"
+)
+
+// Storage records the device sessions. TouchSession upserts the session's
+// last seen time and IP and returns its token marker, the time of the last
+// revocation.
+type Storage interface {
+	TouchSession(ctx context.Context, userID, deviceID, ip string) (time.Time, error)
+}
+
+// Interceptor tracks device sessions on authenticated requests. The
+// token's issue time, needed to tell tokens minted before a revocation
+// from ones minted after, is read from the auth_at metadata the tokener
+// publishes; tokenExp is the server's token lifetime and is only used to
+// recover the issue time from the expiration for tokens minted without
+// an issued-at claim.
+type Interceptor struct {
+	s        Storage
+	tokenExp time.Duration
+}
+
+// NewInterceptor allocates a new Interceptor backed by the given Storage.
+func NewInterceptor(s Storage, tokenExp time.Duration) *Interceptor {
+	return &Interceptor{
+		s:        s,
+		tokenExp: tokenExp,
+	}
+}
+
+// UnaryServerInterceptor is a gRPC unary server interceptor that touches
+// the request's device session and rejects tokens issued before the
+// session was last revoked. Requests without a user id or device id pass
+// through untracked.
+func (i *Interceptor) UnaryServerInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if err := i.track(ctx); err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+// StreamServerInterceptor is a gRPC stream server interceptor that applies
+// the same session tracking as UnaryServerInterceptor.
+func (i *Interceptor) StreamServerInterceptor(srv any, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := i.track(stream.Context()); err != nil {
+		return err
+	}
+
+	return handler(srv, stream)
+}
+
+// track touches the session of an authenticated request with a device id
+// and reports whether its token predates the session's revocation marker.
+// Tracking failures are logged and do not fail the request; revocation is
+// only enforced when the marker is known.
+func (i *Interceptor) track(ctx context.Context) error {
+	md, ok := metadata.FromIncomingContext(ctx)
+
+	if !ok {
+		return nil
+	}
+
+	userID := md.Get(userIDHeader)
+	deviceID := md.Get(deviceIDHeader)
+
+	if len(userID) == 0 || len(deviceID) == 0 {
+		return nil
+	}
+
+	marker, err := i.s.TouchSession(ctx, userID[0], deviceID[0], peerIP(ctx))
+
+	if err != nil {
+		logger.Log.Warn("Cannot touch session", zap.String("device_id", deviceID[0]), zap.Error(err))
+		return nil
+	}
+
+	// A session that was never revoked carries the epoch as its marker.
+	if !marker.After(time.Unix(0, 0)) {
+		return nil
+	}
+
+	issuedAt, ok := i.tokenIssuedAt(md)
+
+	if !ok {
+		return nil
+	}
+
+	if issuedAt.Before(marker) {
+		return status.Error(codes.Unauthenticated, "session revoked")
+	}
+
+	return nil
+}
+
+// tokenIssuedAt recovers the token's issue time: from the auth_at
+// metadata the tokener publishes, which stays correct when the configured
+// token duration changes between issuance and check, or failing that from
+// token_exp minus the configured lifetime for tokens minted without an
+// issued-at claim.
+func (i *Interceptor) tokenIssuedAt(md metadata.MD) (time.Time, bool) {
+	if authAt := md.Get(authAtHeader); len(authAt) != 0 {
+		if sec, err := strconv.ParseInt(authAt[0], 10, 64); err == nil {
+			return time.Unix(sec, 0), true
+		}
+	}
+
+	tokenExp := md.Get(tokenExpHeader)
+
+	if len(tokenExp) == 0 {
+		return time.Time{}, false
+	}
+
+	exp, err := strconv.ParseInt(tokenExp[0], 10, 64)
+
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return time.Unix(exp, 0).Add(-i.tokenExp), true
+}
+
+// peerIP extracts the caller's IP without the port; an unknown address
+// yields an empty string.
+func peerIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+
+	if !ok {
+		return ""
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+
+	if err != nil {
+		return p.Addr.String()
+	}
+
+	return host
+}
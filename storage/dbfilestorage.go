@@ -0,0 +1,201 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	queryFileChunkExists = `
+	SELECT 1
+	FROM file_chunks
+	WHERE file_path = $1
+	LIMIT 1;
+	`
+
+	queryInsertFileChunk = `
+	INSERT INTO file_chunks (file_path, seq, data)
+	VALUES ($1, $2, $3);
+	`
+
+	querySelectFileChunk = `
+	SELECT data
+	FROM file_chunks
+	WHERE file_path = $1 AND seq = $2;
+	`
+
+	queryDeleteFileChunks = `
+	DELETE FROM file_chunks
+	WHERE file_path = $1;
+	`
+)
+
+// DBFileStorage stores file content as ordered chunks in the file_chunks
+// table instead of on disk, for deployments without shared storage. It
+// implements the same FileStore contract as FileStorage, so the handlers
+// are unchanged.
+type DBFileStorage struct {
+	conn      *pgxpool.Pool
+	chunkSize int
+}
+
+// NewDBFileStorage creates a DBFileStorage over the storage's connection
+// pool with the given chunk size.
+func NewDBFileStorage(s *Storage, chunkSize int) *DBFileStorage {
+	return &DBFileStorage{
+		conn:      s.conn,
+		chunkSize: chunkSize,
+	}
+}
+
+// CreateDBFile returns a DBFiler writing sequential chunks under the given
+// name. It returns an error if chunks with that name already exist.
+func (dfs *DBFileStorage) CreateDBFile(fileName string) (DBFiler, error) {
+	var one int
+
+	err := dfs.conn.QueryRow(context.Background(), queryFileChunkExists, fileName).Scan(&one)
+
+	switch {
+	case err == nil:
+		return nil, fmt.Errorf("file %s is exists", fileName)
+	case !errors.Is(err, pgx.ErrNoRows):
+		return nil, fmt.Errorf("checking file %s exist: %w", fileName, err)
+	}
+
+	return newDBChunkedFile(dfs.conn, fileName, dfs.chunkSize), nil
+}
+
+// GetDBFile returns a DBFiler reading the chunks stored under the given
+// name in order. It returns an error if no chunks exist.
+func (dfs *DBFileStorage) GetDBFile(fileName string) (DBFiler, error) {
+	var one int
+
+	err := dfs.conn.QueryRow(context.Background(), queryFileChunkExists, fileName).Scan(&one)
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("open file %s: %w", fileName, ErrFileNotFound)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("open file %s: %w", fileName, err)
+	}
+
+	return newDBChunkedFile(dfs.conn, fileName, dfs.chunkSize), nil
+}
+
+// DeleteDBFile removes all chunks stored under the given name. Deleting a
+// missing file is not an error.
+func (dfs *DBFileStorage) DeleteDBFile(fileName string) error {
+	_, err := dfs.conn.Exec(context.Background(), queryDeleteFileChunks, fileName)
+
+	if err != nil {
+		return fmt.Errorf("delete file %s: %w", fileName, err)
+	}
+
+	return nil
+}
+
+// Ping verifies the connection pool holding the chunk table is
+// reachable.
+func (dfs *DBFileStorage) Ping(ctx context.Context) error {
+	if err := dfs.conn.Ping(ctx); err != nil {
+		return fmt.Errorf("ping file chunk pool: %w", err)
+	}
+
+	return nil
+}
+
+// GetChunkSize returns the chunk size used by the DBFileStorage instance.
+func (dfs *DBFileStorage) GetChunkSize() int {
+	return dfs.chunkSize
+}
+
+// DBChunkedFile reads and writes one file's chunk rows. Writes are
+// buffered to the chunk size and inserted with increasing sequence
+// numbers; reads return the chunks in that order.
+type DBChunkedFile struct {
+	conn      *pgxpool.Pool
+	filePath  string
+	buffer    []byte
+	chunkSize int
+	writeSeq  int
+	readSeq   int
+}
+
+// newDBChunkedFile creates a DBChunkedFile over the given pool.
+func newDBChunkedFile(conn *pgxpool.Pool, filePath string, chunkSize int) *DBChunkedFile {
+	return &DBChunkedFile{
+		conn:      conn,
+		filePath:  filePath,
+		buffer:    make([]byte, 0, chunkSize),
+		chunkSize: chunkSize,
+	}
+}
+
+// Write buffers the given bytes and inserts a chunk row for every full
+// chunk size reached. The remainder is written by Close.
+func (dcf *DBChunkedFile) Write(b []byte) (int, error) {
+	dcf.buffer = append(dcf.buffer, b...)
+
+	for len(dcf.buffer) >= dcf.chunkSize {
+		if err := dcf.insertChunk(dcf.buffer[:dcf.chunkSize]); err != nil {
+			return 0, err
+		}
+
+		dcf.buffer = dcf.buffer[dcf.chunkSize:]
+	}
+
+	return len(b), nil
+}
+
+// GetChunk returns the next chunk row, or io.EOF past the last one.
+func (dcf *DBChunkedFile) GetChunk() ([]byte, error) {
+	var data []byte
+
+	err := dcf.conn.QueryRow(context.Background(), querySelectFileChunk, dcf.filePath, dcf.readSeq).Scan(&data)
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, io.EOF
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("read file chunk %d: %w", dcf.readSeq, err)
+	}
+
+	dcf.readSeq++
+
+	return data, nil
+}
+
+// Close flushes the buffered remainder as the final partial chunk.
+func (dcf *DBChunkedFile) Close() error {
+	if len(dcf.buffer) == 0 {
+		return nil
+	}
+
+	if err := dcf.insertChunk(dcf.buffer); err != nil {
+		return err
+	}
+
+	dcf.buffer = dcf.buffer[:0]
+
+	return nil
+}
+
+// insertChunk inserts one chunk row with the next sequence number.
+func (dcf *DBChunkedFile) insertChunk(data []byte) error {
+	_, err := dcf.conn.Exec(context.Background(), queryInsertFileChunk, dcf.filePath, dcf.writeSeq, data)
+
+	if err != nil {
+		return fmt.Errorf("write file chunk %d: %w", dcf.writeSeq, err)
+	}
+
+	dcf.writeSeq++
+
+	return nil
+}
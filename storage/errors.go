@@ -0,0 +1,79 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// Lookups, updates and deletes scope every query by user_id, so an ID that
+// exists but belongs to another user yields the same *NotFound sentinel as an
+// ID that does not exist at all. Callers must preserve this masking: it keeps
+// entry IDs unprobeable across accounts.
+var (
+	// ErrUserNotFound is returned when a user is not found in the database.
+	ErrUserNotFound = errors.New("user not found")
+	// ErrUserAlreadyExists is returned when attempting to create a user that already exists.
+	ErrUserAlreadyExists = errors.New("user already exists")
+	// ErrPasswordNotFound is returned when a password is not found in the database.
+	ErrPasswordNotFound = errors.New("password not found")
+	// ErrFileNotFound is returned when a file is not found in the storage.
+	ErrFileNotFound = errors.New("file not found")
+	// ErrPathAlreadyExists is returned when a file path is already used by another record.
+	ErrPathAlreadyExists = errors.New("file path already exists")
+	// ErrBankNotFound is returned when a bank record is not found in the database.
+	ErrBankNotFound = errors.New("bank not found")
+	// ErrTextNotFound is returned when a text record is not found in the database.
+	ErrTextNotFound = errors.New("text not found")
+	// ErrRecoveryCodeNotFound is returned when a recovery code is not found in the database.
+	ErrRecoveryCodeNotFound = errors.New("recovery code not found")
+	// ErrPublicKeyNotFound is returned when a user has no uploaded public key.
+	ErrPublicKeyNotFound = errors.New("public key not found")
+	// ErrSessionNotFound is returned when a device session is not found in the database.
+	ErrSessionNotFound = errors.New("session not found")
+	// ErrDeterministicEncryptionDisabled is returned by queries that match
+	// on ciphertext when deterministic encryption is not enabled.
+	ErrDeterministicEncryptionDisabled = errors.New("deterministic encryption is disabled")
+	// ErrUnknownEntryKind is returned when an entry kind does not name one
+	// of the password, bank, text or file tables.
+	ErrUnknownEntryKind = errors.New("unknown entry kind")
+	// ErrUnknownQueryExecMode is returned when a configuration value does
+	// not name a pgx query execution mode.
+	ErrUnknownQueryExecMode = errors.New("unknown query exec mode")
+)
+
+// wrapPgError maps a driver error onto a storage sentinel while keeping the
+// driver error in the chain, so callers can match the sentinel with
+// errors.Is and still recover the *pgconn.PgError with errors.As for
+// SQLSTATE inspection.
+func wrapPgError(detail string, sentinel, err error) error {
+	return fmt.Errorf("%s: %w: %w", detail, sentinel, err)
+}
+
+// IsUniqueViolation checks if the given error is of type pgconn.PgError and is a unique violation error.
+func IsUniqueViolation(err error) bool {
+	var tError *pgconn.PgError
+	if errors.As(err, &tError) && tError.Code == pgerrcode.UniqueViolation {
+		return true
+	}
+
+	return false
+}
+
+// IsNoRowError checks if the given error is of type pgx.ErrNoRows.
+func IsNoRowError(err error) bool {
+	return errors.Is(err, pgx.ErrNoRows)
+}
+
+// IsForeignKeyViolation checks if the given error is of type pgconn.PgError and is a foreign key violation error.
+func IsForeignKeyViolation(err error) bool {
+	var tError *pgconn.PgError
+	if errors.As(err, &tError) && tError.Code == pgerrcode.ForeignKeyViolation {
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,120 @@
+//go:build unit
+
+package storage
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testDSN = "host=localhost port=5433 user=gophkeeper dbname=gophkeeper"
+
+func TestParseQueryExecMode(t *testing.T) {
+	t.Run("empty keeps the pgx default", func(t *testing.T) {
+		mode, err := ParseQueryExecMode("")
+		require.NoError(t, err)
+		assert.Equal(t, pgx.QueryExecMode(0), mode)
+	})
+
+	t.Run("known modes", func(t *testing.T) {
+		for name, want := range queryExecModes {
+			mode, err := ParseQueryExecMode(name)
+			require.NoError(t, err)
+			assert.Equal(t, want, mode)
+		}
+	})
+
+	t.Run("unknown mode", func(t *testing.T) {
+		mode, err := ParseQueryExecMode("wrongMode")
+		require.ErrorIs(t, err, ErrUnknownQueryExecMode)
+		assert.Equal(t, pgx.QueryExecMode(0), mode)
+	})
+}
+
+func TestNewStorageWithConfig(t *testing.T) {
+	t.Run("configured mode is applied to the pool config", func(t *testing.T) {
+		s, err := NewStorageWithConfig(context.Background(), testDSN, pgx.QueryExecModeExec)
+		require.NoError(t, err)
+
+		defer s.Close()
+
+		assert.Equal(t, pgx.QueryExecModeExec, s.conn.Config().ConnConfig.DefaultQueryExecMode)
+	})
+
+	t.Run("zero mode keeps the pgx default", func(t *testing.T) {
+		s, err := NewStorageWithConfig(context.Background(), testDSN, 0)
+		require.NoError(t, err)
+
+		defer s.Close()
+
+		assert.Equal(t, pgx.QueryExecModeCacheStatement, s.conn.Config().ConnConfig.DefaultQueryExecMode)
+	})
+
+	t.Run("cannot parse DSN", func(t *testing.T) {
+		s, err := NewStorageWithConfig(context.Background(), "port=wrongPort", 0)
+		require.ErrorContains(t, err, "parse pgxpool config")
+		assert.Nil(t, s)
+	})
+}
+
+func TestCloseIdempotent(t *testing.T) {
+	s, err := NewStorageWithConfig(context.Background(), testDSN, 0, WithReadReplicaDSN(testDSN))
+	require.NoError(t, err)
+
+	assert.NotPanics(t, func() {
+		s.Close()
+		s.Close()
+	})
+}
+
+func TestCloseConcurrent(t *testing.T) {
+	s, err := NewStorageWithConfig(context.Background(), testDSN, 0, WithReadReplicaDSN(testDSN))
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			assert.NotPanics(t, s.Close)
+		}()
+	}
+
+	wg.Wait()
+}
+
+func TestReadReplicaRouting(t *testing.T) {
+	t.Run("reads select the replica pool", func(t *testing.T) {
+		s, err := NewStorageWithConfig(context.Background(), testDSN, 0, WithReadReplicaDSN(testDSN))
+		require.NoError(t, err)
+
+		defer s.Close()
+
+		require.NotNil(t, s.readConn)
+		assert.NotSame(t, s.conn, s.readConn)
+		assert.Same(t, s.readConn, s.readPool())
+	})
+
+	t.Run("no replica keeps reads on the primary", func(t *testing.T) {
+		s, err := NewStorageWithConfig(context.Background(), testDSN, 0)
+		require.NoError(t, err)
+
+		defer s.Close()
+
+		assert.Nil(t, s.readConn)
+		assert.Same(t, s.conn, s.readPool())
+	})
+
+	t.Run("cannot parse replica DSN", func(t *testing.T) {
+		s, err := NewStorageWithConfig(context.Background(), testDSN, 0, WithReadReplicaDSN("port=wrongPort"))
+		require.ErrorContains(t, err, "read replica")
+		assert.Nil(t, s)
+	})
+}
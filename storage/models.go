@@ -0,0 +1,636 @@
+package storage
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	fieldID             = "id"
+	fieldSalt           = "salt"
+	fieldAlgo           = "algo"
+	fieldUpdateAt       = "updateat"
+	fieldLogin          = "login"
+	fieldDataName       = "name"
+	fieldMeta           = "meta"
+	fieldUserID         = "user_id"
+	fieldPassword       = "password"
+	fieldPathToFile     = "pathtofile"
+	fieldCardNumber     = "cardnumber"
+	fieldCVC            = "cvc"
+	fieldOwner          = "owner"
+	fieldExp            = "exp"
+	fieldKind           = "kind"
+	fieldCodeHash       = "code_hash"
+	fieldWrappedKey     = "wrapped_key"
+	fieldPinned         = "pinned"
+	fieldRequiresReauth = "requires_reauth"
+
+	fieldFingerprint = "password_fingerprint"
+	fieldIDs         = "ids"
+
+	fieldDeviceID    = "device_id"
+	fieldLastSeen    = "last_seen"
+	fieldIP          = "ip"
+	fieldTokenMarker = "token_marker"
+)
+
+// User represents a user data structure.
+type User struct {
+	ID       string
+	Login    string
+	Password string
+	Salt     string
+	Algo     string
+}
+
+// ScanRow scans the user data from the provided rows.
+func (u *User) ScanRow(rows pgx.Rows) error {
+	values, err := rows.Values()
+	if err != nil {
+		return err
+	}
+
+	for i := range values {
+		fieldName := strings.ToLower(rows.FieldDescriptions()[i].Name)
+		switch fieldName {
+		case fieldID:
+			id, err := convertUUIDToString(values[i])
+
+			if err != nil {
+				return err
+			}
+
+			u.ID = id
+		case fieldLogin:
+			u.Login = values[i].(string)
+		case fieldPassword:
+			u.Password = strings.TrimSpace(values[i].(string))
+		case fieldSalt:
+			u.Salt = strings.TrimSpace(values[i].(string))
+		case fieldAlgo:
+			u.Algo = strings.TrimSpace(values[i].(string))
+		}
+	}
+
+	return nil
+}
+
+// UserSummary lists one user for the admin API: id, login and update
+// time only, no password hashes or salts.
+type UserSummary struct {
+	ID       string
+	Login    string
+	UpdateAt time.Time
+}
+
+// ScanRow scans the user summary data from the provided rows.
+func (us *UserSummary) ScanRow(rows pgx.Rows) error {
+	values, err := rows.Values()
+	if err != nil {
+		return err
+	}
+
+	for i := range values {
+		fieldName := strings.ToLower(rows.FieldDescriptions()[i].Name)
+		switch fieldName {
+		case fieldID:
+			id, err := convertUUIDToString(values[i])
+
+			if err != nil {
+				return err
+			}
+
+			us.ID = id
+		case fieldLogin:
+			us.Login = values[i].(string)
+		case fieldUpdateAt:
+			us.UpdateAt = values[i].(time.Time)
+		}
+	}
+
+	return nil
+}
+
+// RecoveryCode represents a stored one-time recovery code hash.
+type RecoveryCode struct {
+	ID       string
+	UserID   string
+	CodeHash string
+}
+
+// ScanRow scans the recovery code data from the provided rows.
+func (rc *RecoveryCode) ScanRow(rows pgx.Rows) error {
+	values, err := rows.Values()
+	if err != nil {
+		return err
+	}
+
+	for i := range values {
+		fieldName := strings.ToLower(rows.FieldDescriptions()[i].Name)
+		switch fieldName {
+		case fieldID:
+			id, err := convertUUIDToString(values[i])
+
+			if err != nil {
+				return err
+			}
+
+			rc.ID = id
+		case fieldUserID:
+			userID, err := convertUUIDToString(values[i])
+
+			if err != nil {
+				return err
+			}
+
+			rc.UserID = userID
+		case fieldCodeHash:
+			rc.CodeHash = strings.TrimSpace(values[i].(string))
+		}
+	}
+
+	return nil
+}
+
+// Password represents a password data structure.
+type Password struct {
+	ID             string
+	UserID         string
+	Name           string
+	Login          string
+	Password       string
+	Meta           string
+	WrappedKey     string
+	Fingerprint    string
+	Pinned         bool
+	RequiresReauth bool
+	UpdateAt       time.Time
+}
+
+// ScanRow scans the password data from the provided rows.
+func (p *Password) ScanRow(rows pgx.Rows) error {
+	values, err := rows.Values()
+	if err != nil {
+		return err
+	}
+
+	for i := range values {
+		fieldName := strings.ToLower(rows.FieldDescriptions()[i].Name)
+		switch fieldName {
+		case fieldID:
+			id, err := convertUUIDToString(values[i])
+
+			if err != nil {
+				return err
+			}
+			p.ID = id
+		case fieldUserID:
+			id, err := convertUUIDToString(values[i])
+
+			if err != nil {
+				return err
+			}
+
+			p.UserID = id
+		case fieldDataName:
+			p.Name = values[i].(string)
+		case fieldLogin:
+			p.Login = values[i].(string)
+		case fieldPassword:
+			p.Password = values[i].(string)
+		case fieldMeta:
+			p.Meta = values[i].(string)
+		case fieldWrappedKey:
+			if wrappedKey, ok := values[i].(string); ok {
+				p.WrappedKey = wrappedKey
+			}
+		case fieldFingerprint:
+			if fingerprint, ok := values[i].(string); ok {
+				p.Fingerprint = fingerprint
+			}
+		case fieldPinned:
+			p.Pinned = values[i].(bool)
+		case fieldRequiresReauth:
+			p.RequiresReauth = values[i].(bool)
+		case fieldUpdateAt:
+			p.UpdateAt = values[i].(time.Time)
+		}
+	}
+
+	return nil
+}
+
+// ReusedPasswords groups the ids of a user's password entries that share
+// one client-supplied fingerprint.
+type ReusedPasswords struct {
+	Fingerprint string
+	IDs         []string
+}
+
+// ScanRow scans one fingerprint group from the provided rows.
+func (r *ReusedPasswords) ScanRow(rows pgx.Rows) error {
+	values, err := rows.Values()
+	if err != nil {
+		return err
+	}
+
+	for i := range values {
+		fieldName := strings.ToLower(rows.FieldDescriptions()[i].Name)
+		switch fieldName {
+		case fieldFingerprint:
+			r.Fingerprint = values[i].(string)
+		case fieldIDs:
+			ids, ok := values[i].([]any)
+
+			if !ok {
+				return fmt.Errorf("cannot convert value to id list")
+			}
+
+			r.IDs = make([]string, 0, len(ids))
+
+			for _, id := range ids {
+				r.IDs = append(r.IDs, id.(string))
+			}
+		}
+	}
+
+	return nil
+}
+
+// File represents a file data structure.
+type File struct {
+	ID             string
+	UserID         string
+	Name           string
+	PathToFile     string
+	Meta           string
+	Pinned         bool
+	RequiresReauth bool
+	UpdateAt       time.Time
+}
+
+// ScanRow scans the file data from the provided rows.
+func (f *File) ScanRow(rows pgx.Rows) error {
+	values, err := rows.Values()
+	if err != nil {
+		return err
+	}
+
+	for i := range values {
+		fieldName := strings.ToLower(rows.FieldDescriptions()[i].Name)
+		switch fieldName {
+		case fieldID:
+			id, err := convertUUIDToString(values[i])
+
+			if err != nil {
+				return err
+			}
+
+			f.ID = id
+		case fieldUserID:
+			id, err := convertUUIDToString(values[i])
+
+			if err != nil {
+				return err
+			}
+
+			f.UserID = id
+		case fieldDataName:
+			f.Name = values[i].(string)
+		case fieldPathToFile:
+			f.PathToFile = values[i].(string)
+		case fieldMeta:
+			f.Meta = values[i].(string)
+		case fieldPinned:
+			f.Pinned = values[i].(bool)
+		case fieldRequiresReauth:
+			f.RequiresReauth = values[i].(bool)
+		case fieldUpdateAt:
+			f.UpdateAt = values[i].(time.Time)
+		}
+	}
+
+	return nil
+}
+
+// Bank represents a card data structure.
+type Bank struct {
+	ID             string
+	UserID         string
+	Name           string
+	CardNumber     string
+	CVC            string
+	Owner          string
+	Meta           string
+	Exp            string
+	Pinned         bool
+	RequiresReauth bool
+	UpdateAt       time.Time
+
+	// Expired is not persisted: Exp is stored encrypted, so the client
+	// computes the flag via IsExpired after opening the data.
+	Expired bool
+}
+
+// IsExpired reports whether the card expiry in MM/YY format has passed.
+// The card stays valid through the end of its expiry month; unparseable
+// values are reported as not expired.
+func (f *Bank) IsExpired() bool {
+	exp, err := time.Parse("01/06", f.Exp)
+
+	if err != nil {
+		return false
+	}
+
+	return time.Now().After(exp.AddDate(0, 1, 0))
+}
+
+// ScanRow scans the bank data from the provided rows.
+func (f *Bank) ScanRow(rows pgx.Rows) error {
+	values, err := rows.Values()
+	if err != nil {
+		return err
+	}
+
+	for i := range values {
+		fieldName := strings.ToLower(rows.FieldDescriptions()[i].Name)
+		switch fieldName {
+		case fieldID:
+			id, err := convertUUIDToString(values[i])
+
+			if err != nil {
+				return err
+			}
+
+			f.ID = id
+		case fieldUserID:
+			id, err := convertUUIDToString(values[i])
+
+			if err != nil {
+				return err
+			}
+
+			f.UserID = id
+		case fieldDataName:
+			f.Name = values[i].(string)
+		case fieldCardNumber:
+			f.CardNumber = values[i].(string)
+		case fieldCVC:
+			f.CVC = values[i].(string)
+		case fieldOwner:
+			f.Owner = values[i].(string)
+		case fieldMeta:
+			f.Meta = values[i].(string)
+		case fieldExp:
+			f.Exp = values[i].(string)
+		case fieldPinned:
+			f.Pinned = values[i].(bool)
+		case fieldRequiresReauth:
+			f.RequiresReauth = values[i].(bool)
+		case fieldUpdateAt:
+			f.UpdateAt = values[i].(time.Time)
+		}
+	}
+
+	return nil
+}
+
+// Text represents a text data structure.
+type Text struct {
+	ID             string
+	UserID         string
+	Name           string
+	Text           string
+	Meta           string
+	Pinned         bool
+	RequiresReauth bool
+	UpdateAt       time.Time
+}
+
+// ScanRow scans the text data from the provided rows.
+func (f *Text) ScanRow(rows pgx.Rows) error {
+	values, err := rows.Values()
+	if err != nil {
+		return err
+	}
+
+	for i := range values {
+		fieldName := strings.ToLower(rows.FieldDescriptions()[i].Name)
+		switch fieldName {
+		case fieldID:
+			id, err := convertUUIDToString(values[i])
+
+			if err != nil {
+				return err
+			}
+
+			f.ID = id
+		case fieldUserID:
+			id, err := convertUUIDToString(values[i])
+
+			if err != nil {
+				return err
+			}
+
+			f.UserID = id
+		case fieldDataName:
+			f.Name = values[i].(string)
+		case "text":
+			f.Text = values[i].(string)
+		case fieldMeta:
+			f.Meta = values[i].(string)
+		case fieldPinned:
+			f.Pinned = values[i].(bool)
+		case fieldRequiresReauth:
+			f.RequiresReauth = values[i].(bool)
+		case fieldUpdateAt:
+			f.UpdateAt = values[i].(time.Time)
+		}
+	}
+
+	return nil
+}
+
+// Favorites groups a user's pinned entries across all kinds.
+type Favorites struct {
+	Passwords []Password
+	Files     []File
+	Banks     []Bank
+	Texts     []Text
+}
+
+// Activity represents one entry of a user's cross-type activity feed.
+// Kind names the source table: password, bank, text or file. Name is
+// returned sealed, the client opens it.
+type Activity struct {
+	ID       string
+	Kind     string
+	Name     string
+	UpdateAt time.Time
+}
+
+// ScanRow scans the activity data from the provided rows.
+func (a *Activity) ScanRow(rows pgx.Rows) error {
+	values, err := rows.Values()
+	if err != nil {
+		return err
+	}
+
+	for i := range values {
+		fieldName := strings.ToLower(rows.FieldDescriptions()[i].Name)
+		switch fieldName {
+		case fieldID:
+			id, err := convertUUIDToString(values[i])
+
+			if err != nil {
+				return err
+			}
+
+			a.ID = id
+		case fieldKind:
+			a.Kind = values[i].(string)
+		case fieldDataName:
+			a.Name = values[i].(string)
+		case fieldUpdateAt:
+			a.UpdateAt = values[i].(time.Time)
+		}
+	}
+
+	return nil
+}
+
+// ManifestEntry identifies one stored entry of any kind by id, kind and
+// update time. The manifest spans all kinds, so a sync client gets the
+// full picture of the vault in one call.
+type ManifestEntry struct {
+	ID       string
+	Kind     string
+	UpdateAt time.Time
+}
+
+// ScanRow scans the manifest entry data from the provided rows.
+func (m *ManifestEntry) ScanRow(rows pgx.Rows) error {
+	values, err := rows.Values()
+	if err != nil {
+		return err
+	}
+
+	for i := range values {
+		fieldName := strings.ToLower(rows.FieldDescriptions()[i].Name)
+		switch fieldName {
+		case fieldID:
+			id, err := convertUUIDToString(values[i])
+
+			if err != nil {
+				return err
+			}
+
+			m.ID = id
+		case fieldKind:
+			m.Kind = values[i].(string)
+		case fieldUpdateAt:
+			m.UpdateAt = values[i].(time.Time)
+		}
+	}
+
+	return nil
+}
+
+// EntryID identifies one stored entry by id and update time only. It
+// carries no names or secrets, so clients can reconcile a cache against
+// the server without downloading data.
+type EntryID struct {
+	ID       string
+	UpdateAt time.Time
+}
+
+// ScanRow scans the entry id data from the provided rows.
+func (e *EntryID) ScanRow(rows pgx.Rows) error {
+	values, err := rows.Values()
+	if err != nil {
+		return err
+	}
+
+	for i := range values {
+		fieldName := strings.ToLower(rows.FieldDescriptions()[i].Name)
+		switch fieldName {
+		case fieldID:
+			id, err := convertUUIDToString(values[i])
+
+			if err != nil {
+				return err
+			}
+
+			e.ID = id
+		case fieldUpdateAt:
+			e.UpdateAt = values[i].(time.Time)
+		}
+	}
+
+	return nil
+}
+
+// Session represents one device session of a user: the device id the
+// client sent in its metadata, when and from where it was last seen, and
+// the token marker set by the last revocation.
+type Session struct {
+	DeviceID    string
+	LastSeen    time.Time
+	IP          string
+	TokenMarker time.Time
+}
+
+// ScanRow scans the session data from the provided rows.
+func (s *Session) ScanRow(rows pgx.Rows) error {
+	values, err := rows.Values()
+	if err != nil {
+		return err
+	}
+
+	for i := range values {
+		fieldName := strings.ToLower(rows.FieldDescriptions()[i].Name)
+		switch fieldName {
+		case fieldDeviceID:
+			s.DeviceID = values[i].(string)
+		case fieldLastSeen:
+			s.LastSeen = values[i].(time.Time)
+		case fieldIP:
+			s.IP = values[i].(string)
+		case fieldTokenMarker:
+			s.TokenMarker = values[i].(time.Time)
+		}
+	}
+
+	return nil
+}
+
+func convertUUIDToString(value any) (string, error) {
+	v, ok := value.([16]byte)
+
+	if !ok {
+		return "", fmt.Errorf("cannot convert value to byte")
+	}
+
+	uuid := pgtype.UUID{
+		Bytes: v,
+		Valid: true,
+	}
+	id, err := uuid.Value()
+
+	if err != nil {
+		return "", err
+	}
+
+	result, ok := id.(string)
+
+	if !ok {
+		return "", fmt.Errorf("cannot convert driver.Value to string")
+	}
+
+	return result, nil
+}
@@ -0,0 +1,32 @@
+//go:build unit
+
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBank_IsExpired(t *testing.T) {
+	t.Run("expired card", func(t *testing.T) {
+		bank := Bank{Exp: "01/20"}
+		require.True(t, bank.IsExpired())
+	})
+
+	t.Run("current card", func(t *testing.T) {
+		bank := Bank{Exp: time.Now().Format("01/06")}
+		require.False(t, bank.IsExpired())
+	})
+
+	t.Run("future card", func(t *testing.T) {
+		bank := Bank{Exp: time.Now().AddDate(1, 0, 0).Format("01/06")}
+		require.False(t, bank.IsExpired())
+	})
+
+	t.Run("unparseable expiry", func(t *testing.T) {
+		bank := Bank{Exp: "not a date"}
+		require.False(t, bank.IsExpired())
+	})
+}
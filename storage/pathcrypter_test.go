@@ -0,0 +1,50 @@
+//go:build unit
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/Tomap-Tomap/GophKeeper/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPathCrypter(t *testing.T) {
+	crypter, _, err := crypto.NewCrypter(32, t.TempDir(), crypto.WithDeterministicSealing())
+	require.NoError(t, err)
+
+	s := &Storage{}
+	s.SetPathCrypter(crypter)
+
+	t.Run("path round trips through the storage key", func(t *testing.T) {
+		sealed, err := s.sealPath("testPathToFile")
+		require.NoError(t, err)
+		require.NotEqual(t, "testPathToFile", sealed)
+
+		require.Equal(t, "testPathToFile", s.openPath(sealed))
+	})
+
+	t.Run("sealing is deterministic", func(t *testing.T) {
+		first, err := s.sealPath("testPathToFile")
+		require.NoError(t, err)
+
+		second, err := s.sealPath("testPathToFile")
+		require.NoError(t, err)
+
+		require.Equal(t, first, second)
+	})
+
+	t.Run("plaintext legacy path passes through", func(t *testing.T) {
+		require.Equal(t, "testLegacyPath", s.openPath("testLegacyPath"))
+	})
+
+	t.Run("without a crypter paths pass through", func(t *testing.T) {
+		plain := &Storage{}
+
+		sealed, err := plain.sealPath("testPathToFile")
+		require.NoError(t, err)
+		require.Equal(t, "testPathToFile", sealed)
+
+		require.Equal(t, "testPathToFile", plain.openPath("testPathToFile"))
+	})
+}
@@ -0,0 +1,450 @@
+package storage
+
+const (
+	queryInsertUser = `
+	INSERT INTO users (login, password)
+	VALUES ($1, $2)
+	RETURNING id, login, password;
+	`
+
+	queryInsertUserPreHashed = `
+	INSERT INTO users (login, password, algo)
+	VALUES ($1, $2, $3)
+	RETURNING id, login, password;
+	`
+
+	queryInsertSalt = `
+	INSERT INTO salts (login, salt)
+	VALUES ($1, $2)
+	RETURNING salt;
+	`
+
+	querySelectUser = `
+	SELECT u.id, u.login, u.password, u.algo, s.salt
+	FROM users u, salts s
+	WHERE u.login = $1 AND s.login = $2;
+	`
+
+	querySelectUserByLogin = `
+	SELECT id, login, password, algo
+	FROM users
+	WHERE login = $1;
+	`
+
+	querySelectUserByID = `
+	SELECT id, login
+	FROM users
+	WHERE id = $1;
+	`
+
+	querySelectUsers = `
+	SELECT id, login, updateAt
+	FROM users;
+	`
+
+	querySelectUserFilePaths = `
+	SELECT pathtofile
+	FROM files
+	WHERE user_id = $1;
+	`
+
+	queryDeleteUserRecoveryCodes = `
+	DELETE FROM recovery_codes WHERE user_id = $1;
+	`
+
+	queryDeleteUserPasswords = `
+	DELETE FROM passwords WHERE user_id = $1;
+	`
+
+	queryDeleteUserBanks = `
+	DELETE FROM banks WHERE user_id = $1;
+	`
+
+	queryDeleteUserTexts = `
+	DELETE FROM texts WHERE user_id = $1;
+	`
+
+	queryDeleteUserFiles = `
+	DELETE FROM files WHERE user_id = $1;
+	`
+
+	queryDeleteUserSalt = `
+	DELETE FROM salts WHERE login = $1;
+	`
+
+	queryDeleteUserRow = `
+	DELETE FROM users WHERE id = $1;
+	`
+)
+
+const (
+	queryDeleteRecoveryCodes = `
+	DELETE FROM recovery_codes
+	WHERE user_id = $1;
+	`
+
+	queryInsertRecoveryCode = `
+	INSERT INTO recovery_codes (user_id, code_hash)
+	VALUES ($1, $2);
+	`
+
+	querySelectRecoveryCodes = `
+	SELECT id, user_id, code_hash
+	FROM recovery_codes
+	WHERE user_id = $1;
+	`
+
+	queryDeleteRecoveryCode = `
+	DELETE FROM recovery_codes
+	WHERE id = $1
+	RETURNING id;
+	`
+)
+
+const (
+	queryUpsertPublicKey = `
+	INSERT INTO user_public_keys (user_id, public_key)
+	VALUES ($1, $2)
+	ON CONFLICT (user_id) DO UPDATE
+	SET public_key = EXCLUDED.public_key
+	RETURNING user_id;
+	`
+
+	querySelectPublicKey = `
+	SELECT public_key
+	FROM user_public_keys
+	WHERE user_id = $1;
+	`
+)
+
+const (
+	queryInsertPassword = `
+	INSERT INTO passwords (user_id, name, login, password, meta, wrapped_key, password_fingerprint, pinned, requires_reauth)
+	VALUES ($1, $2, $3, $4, $5, NULLIF($6, ''), NULLIF($7, ''), $8, $9)
+	RETURNING *;
+	`
+
+	queryUpdatePassword = `
+	UPDATE passwords
+	SET user_id = $1,
+		name = $2,
+		login = $3,
+		password = $4,
+		meta = $5,
+		wrapped_key = NULLIF($6, ''),
+		password_fingerprint = NULLIF($7, ''),
+		pinned = $8,
+		requires_reauth = $9
+	WHERE id = $10
+	RETURNING *;
+	`
+
+	querySelectPassword = `
+	SELECT *
+	FROM passwords
+	WHERE id = $1 AND user_id = $2;
+	`
+
+	queryUpdatePasswordMetaJSON = `
+	UPDATE passwords
+	SET meta_json = $1
+	WHERE id = $2 AND user_id = $3
+	RETURNING id;
+	`
+
+	querySelectPasswordMetaJSON = `
+	SELECT meta_json
+	FROM passwords
+	WHERE id = $1 AND user_id = $2;
+	`
+
+	querySelectPasswords = `
+	SELECT *
+	FROM passwords
+	WHERE user_id = $1;
+	`
+
+	querySelectPasswordsByLogin = `
+	SELECT *
+	FROM passwords
+	WHERE user_id = $1 AND login = $2;
+	`
+
+	querySelectPasswordsByCategory = `
+	SELECT *
+	FROM passwords
+	WHERE user_id = $1 AND meta_json @> jsonb_build_object('category', $2::text);
+	`
+
+	queryDeletePassword = `
+	DELETE FROM passwords WHERE id = $1 AND user_id = $2 RETURNING *;
+	`
+
+	queryTouchPassword = `
+	UPDATE passwords
+	SET id = id
+	WHERE id = $1 AND user_id = $2
+	RETURNING *;
+	`
+
+	queryReusedPasswords = `
+	SELECT password_fingerprint, array_agg(id::text ORDER BY id) AS ids
+	FROM passwords
+	WHERE user_id = $1 AND password_fingerprint IS NOT NULL
+	GROUP BY password_fingerprint
+	HAVING count(*) > 1;
+	`
+)
+
+const (
+	queryInsertFile = `
+	INSERT INTO files (user_id, name, pathtofile, meta, pinned, requires_reauth) VALUES ($1, $2, $3, $4, $5, $6)
+	RETURNING *
+	`
+
+	queryUpdateFile = `
+		WITH t AS (
+			UPDATE files
+			SET user_id = $1,
+				name = $2,
+				pathtofile = $3,
+				meta = $4,
+				pinned = $5,
+				requires_reauth = $6
+			WHERE id = $7
+			RETURNING *
+		)
+		SELECT t.id, t.user_id, t.name, files.pathtofile, t.meta, t.pinned, t.requires_reauth, t.updateat FROM t
+		INNER JOIN files ON t.id = files.id;
+	`
+
+	queryUpdateFileInfo = `
+	UPDATE files
+	SET name = $1,
+		meta = $2,
+		pinned = $3,
+		requires_reauth = $4
+	WHERE id = $5 AND user_id = $6
+	RETURNING *;
+	`
+
+	querySelectFile = `
+	SELECT *
+	FROM files
+	WHERE id = $1 AND user_id = $2;
+	`
+
+	querySelectFiles = `
+	SELECT *
+	FROM files
+	WHERE user_id = $1;
+	`
+
+	querySelectAllFiles = `
+	SELECT *
+	FROM files;
+	`
+
+	queryDeleteFile = `
+	DELETE FROM files WHERE id = $1 AND user_id = $2 RETURNING *;
+	`
+)
+
+const (
+	queryInsertBank = `
+	INSERT INTO banks (user_id, name, cardnumber, exp, cvc, owner, meta, pinned, requires_reauth)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	RETURNING *
+	`
+
+	queryUpdateBank = `
+	UPDATE banks
+	SET user_id = $1,
+		name = $2,
+		cardnumber = $3,
+		exp = $4,
+		cvc = $5,
+		owner = $6,
+		meta = $7,
+		pinned = $8,
+		requires_reauth = $9
+	WHERE id = $10
+	RETURNING *
+	`
+
+	querySelectBank = `
+	SELECT *
+	FROM banks
+	WHERE id = $1 AND user_id = $2;
+	`
+
+	querySelectBanks = `
+	SELECT *
+	FROM banks
+	WHERE user_id = $1;
+	`
+
+	queryDeleteBank = `
+	DELETE FROM banks WHERE id = $1 AND user_id = $2 RETURNING *;
+	`
+)
+
+const (
+	queryInsertText = `
+	INSERT INTO texts (user_id, name, text, meta, pinned, requires_reauth) VALUES ($1, $2, $3, $4, $5, $6)
+	RETURNING *
+	`
+
+	queryUpdateText = `
+	UPDATE texts
+	SET user_id = $1,
+		name = $2,
+		text = $3,
+		meta = $4,
+		pinned = $5,
+		requires_reauth = $6
+	WHERE id = $7
+	RETURNING *
+	`
+
+	querySelectText = `
+	SELECT *
+	FROM texts
+	WHERE id = $1 AND user_id = $2;
+	`
+
+	querySelectTexts = `
+	SELECT *
+	FROM texts
+	WHERE user_id = $1
+	`
+
+	queryDeleteText = `
+	DELETE FROM texts WHERE id = $1 AND user_id = $2 RETURNING *;
+	`
+)
+
+const (
+	querySelectPasswordIDs = `
+	SELECT id, updateAt
+	FROM passwords
+	WHERE user_id = $1;
+	`
+
+	querySelectBankIDs = `
+	SELECT id, updateAt
+	FROM banks
+	WHERE user_id = $1;
+	`
+
+	querySelectTextIDs = `
+	SELECT id, updateAt
+	FROM texts
+	WHERE user_id = $1;
+	`
+
+	querySelectFileIDs = `
+	SELECT id, updateAt
+	FROM files
+	WHERE user_id = $1;
+	`
+
+	queryVaultManifest = `
+	SELECT id, 'password' AS kind, updateAt FROM passwords WHERE user_id = $1
+	UNION ALL
+	SELECT id, 'bank' AS kind, updateAt FROM banks WHERE user_id = $1
+	UNION ALL
+	SELECT id, 'text' AS kind, updateAt FROM texts WHERE user_id = $1
+	UNION ALL
+	SELECT id, 'file' AS kind, updateAt FROM files WHERE user_id = $1
+	ORDER BY kind, id
+	`
+)
+
+const (
+	querySelectRecentActivity = `
+	SELECT id, 'password' AS kind, name, updateAt FROM passwords WHERE user_id = $1
+	UNION ALL
+	SELECT id, 'bank' AS kind, name, updateAt FROM banks WHERE user_id = $1
+	UNION ALL
+	SELECT id, 'text' AS kind, name, updateAt FROM texts WHERE user_id = $1
+	UNION ALL
+	SELECT id, 'file' AS kind, name, updateAt FROM files WHERE user_id = $1
+	ORDER BY updateAt DESC
+	LIMIT $2;
+	`
+)
+
+const (
+	queryUpsertSession = `
+	INSERT INTO sessions (user_id, device_id, last_seen, ip)
+	VALUES ($1, $2, current_timestamp, $3)
+	ON CONFLICT (user_id, device_id) DO UPDATE
+	SET last_seen = current_timestamp, ip = EXCLUDED.ip
+	RETURNING token_marker;
+	`
+
+	querySelectSessions = `
+	SELECT device_id, last_seen, ip, token_marker
+	FROM sessions
+	WHERE user_id = $1
+	ORDER BY last_seen DESC;
+	`
+
+	queryRevokeSession = `
+	UPDATE sessions
+	SET token_marker = current_timestamp
+	WHERE user_id = $1 AND device_id = $2
+	RETURNING device_id;
+	`
+
+	queryServerTime = `
+	SELECT current_timestamp;
+	`
+
+	queryIncrementAttempt = `
+	INSERT INTO login_attempts (key, count, window_start)
+	VALUES ($1, 1, current_timestamp)
+	ON CONFLICT (key) DO UPDATE
+	SET count = CASE
+			WHEN login_attempts.window_start <= current_timestamp - make_interval(secs => $2) THEN 1
+			ELSE login_attempts.count + 1
+		END,
+		window_start = CASE
+			WHEN login_attempts.window_start <= current_timestamp - make_interval(secs => $2) THEN current_timestamp
+			ELSE login_attempts.window_start
+		END
+	RETURNING count;
+	`
+
+	queryResetAttempts = `
+	DELETE FROM login_attempts
+	WHERE key = $1;
+	`
+)
+
+const (
+	querySelectFavoritePasswords = `
+	SELECT *
+	FROM passwords
+	WHERE user_id = $1 AND pinned;
+	`
+
+	querySelectFavoriteFiles = `
+	SELECT *
+	FROM files
+	WHERE user_id = $1 AND pinned;
+	`
+
+	querySelectFavoriteBanks = `
+	SELECT *
+	FROM banks
+	WHERE user_id = $1 AND pinned;
+	`
+
+	querySelectFavoriteTexts = `
+	SELECT *
+	FROM texts
+	WHERE user_id = $1 AND pinned;
+	`
+)
@@ -0,0 +1,109 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// RetryPolicy defines the retry policy for database queries
+type RetryPolicy struct {
+	retryCount int
+	duration   int
+	increment  int
+}
+
+// NewRetryPolicy initializes a new retry policy in millisecinds.
+// The function is always executed once; retryCount is the number of
+// additional attempts made after a retryable failure.
+func NewRetryPolicy(retryCount, duration, increment int) *RetryPolicy {
+	return &RetryPolicy{retryCount, duration, increment}
+}
+
+// NoRetry returns a policy that executes the function exactly once,
+// without any additional attempts. It is meant for tests, where retrying
+// can hide logic bugs and slow down failing runs.
+func NoRetry() RetryPolicy {
+	return RetryPolicy{}
+}
+
+// Retry executes a database query considering the retry policy in case of Class 08 errors
+func Retry(ctx context.Context, rp RetryPolicy, fn func() error) error {
+	fnWithReturn := func() (struct{}, error) {
+		return struct{}{}, fn()
+	}
+
+	_, err := Retry2(ctx, rp, fnWithReturn)
+	return err
+}
+
+// Retry2 executes a database query considering the retry policy in case of Class 08 errors
+func Retry2[T any](ctx context.Context, rp RetryPolicy, fn func() (T, error)) (T, error) {
+	return retryIf(ctx, rp, isConnectionException, fn)
+}
+
+// Retry3 executes a database query considering the retry policy in case of Class 08 errors,
+// for functions returning two values besides the error.
+func Retry3[T1, T2 any](ctx context.Context, rp RetryPolicy, fn func() (T1, T2, error)) (T1, T2, error) {
+	type pair struct {
+		v1 T1
+		v2 T2
+	}
+
+	fnWithPair := func() (pair, error) {
+		v1, v2, err := fn()
+		return pair{v1, v2}, err
+	}
+
+	ret, err := retryIf(ctx, rp, isConnectionException, fnWithPair)
+	return ret.v1, ret.v2, err
+}
+
+// RetryIf executes fn considering the retry policy, retrying while the
+// retryable predicate reports the returned error as transient. It is meant
+// for non-database operations, such as blob deletes, that should reuse the
+// same backoff.
+func RetryIf(ctx context.Context, rp RetryPolicy, retryable func(error) bool, fn func() error) error {
+	fnWithReturn := func() (struct{}, error) {
+		return struct{}{}, fn()
+	}
+
+	_, err := retryIf(ctx, rp, retryable, fnWithReturn)
+	return err
+}
+
+func retryIf[T any](ctx context.Context, rp RetryPolicy, retryable func(error) bool, fn func() (T, error)) (T, error) {
+	ret1, err := fn()
+	if err == nil || !retryable(err) {
+		return ret1, err
+	}
+
+	duration := rp.duration
+	for i := 0; i < rp.retryCount; i++ {
+		select {
+		case <-time.After(time.Duration(duration) * time.Millisecond):
+			ret1, err = fn()
+			if err == nil || !retryable(err) {
+				return ret1, err
+			}
+		case <-ctx.Done():
+			return ret1, err
+		}
+
+		duration += rp.increment
+	}
+
+	return ret1, err
+}
+
+func isConnectionException(err error) bool {
+	var tError *pgconn.PgError
+	if errors.As(err, &tError) && pgerrcode.IsConnectionException(tError.Code) {
+		return true
+	}
+
+	return false
+}
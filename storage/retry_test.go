@@ -0,0 +1,355 @@
+//go:build unit
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_isConnectionException(t *testing.T) {
+	t.Run("is connection exception", func(t *testing.T) {
+		require.True(t, isConnectionException(&pgconn.PgError{Code: "08000"}))
+	})
+
+	t.Run("isn't connection exception", func(t *testing.T) {
+		require.False(t, isConnectionException(&pgconn.PgError{Code: "02000"}))
+	})
+
+	t.Run("random error", func(t *testing.T) {
+		require.False(t, isConnectionException(errors.New("test")))
+	})
+}
+
+func Test_retry2(t *testing.T) {
+	t.Run("test no error", func(t *testing.T) {
+		t.Parallel()
+		rp := RetryPolicy{
+			retryCount: 3,
+			duration:   1,
+			increment:  2,
+		}
+		got, err := Retry2(context.Background(), rp, func() (int, error) {
+			return 0, nil
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, 0, got)
+	})
+
+	t.Run("test error", func(t *testing.T) {
+		t.Parallel()
+		rp := RetryPolicy{
+			retryCount: 3,
+			duration:   1,
+			increment:  2,
+		}
+		_, err := Retry2(context.Background(), rp, func() (*int, error) {
+			return nil, &pgconn.PgError{Code: "02000"}
+		})
+
+		require.Error(t, err)
+	})
+
+	t.Run("test error connection", func(t *testing.T) {
+		t.Parallel()
+		rp := RetryPolicy{
+			retryCount: 3,
+			duration:   1,
+			increment:  2,
+		}
+		_, err := Retry2(context.Background(), rp, func() (*int, error) {
+			return nil, &pgconn.PgError{Code: "08000"}
+		})
+
+		require.Error(t, err)
+	})
+
+	t.Run("test error resolved", func(t *testing.T) {
+		t.Parallel()
+		rp := RetryPolicy{
+			retryCount: 3,
+			duration:   1,
+			increment:  2,
+		}
+
+		var errConn error = &pgconn.PgError{Code: "08000"}
+		var mu sync.RWMutex
+
+		go func() {
+			time.Sleep(5 * time.Millisecond)
+			mu.Lock()
+			defer mu.Unlock()
+			errConn = nil
+		}()
+
+		_, err := Retry2(context.Background(), rp, func() (*int, error) {
+			mu.RLock()
+			defer mu.RUnlock()
+			return nil, errConn
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("test context done", func(t *testing.T) {
+		t.Parallel()
+		rp := RetryPolicy{
+			retryCount: 3,
+			duration:   1,
+			increment:  2,
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+		defer cancel()
+
+		_, err := Retry2(ctx, rp, func() (*int, error) {
+			return nil, &pgconn.PgError{Code: "08000"}
+		})
+
+		require.Error(t, err)
+	})
+}
+
+func Test_retry3(t *testing.T) {
+	t.Run("test no error", func(t *testing.T) {
+		t.Parallel()
+		rp := RetryPolicy{
+			retryCount: 3,
+			duration:   1,
+			increment:  2,
+		}
+		got1, got2, err := Retry3(context.Background(), rp, func() (int, string, error) {
+			return 1, "test", nil
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, 1, got1)
+		require.Equal(t, "test", got2)
+	})
+
+	t.Run("test error", func(t *testing.T) {
+		t.Parallel()
+		rp := RetryPolicy{
+			retryCount: 3,
+			duration:   1,
+			increment:  2,
+		}
+		_, _, err := Retry3(context.Background(), rp, func() (*int, string, error) {
+			return nil, "", &pgconn.PgError{Code: "02000"}
+		})
+
+		require.Error(t, err)
+	})
+
+	t.Run("test error resolved", func(t *testing.T) {
+		t.Parallel()
+		rp := RetryPolicy{
+			retryCount: 3,
+			duration:   1,
+			increment:  2,
+		}
+
+		var errConn error = &pgconn.PgError{Code: "08000"}
+		var mu sync.RWMutex
+
+		go func() {
+			time.Sleep(5 * time.Millisecond)
+			mu.Lock()
+			defer mu.Unlock()
+			errConn = nil
+		}()
+
+		got1, got2, err := Retry3(context.Background(), rp, func() (int, string, error) {
+			mu.RLock()
+			defer mu.RUnlock()
+			return 1, "test", errConn
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, 1, got1)
+		require.Equal(t, "test", got2)
+	})
+}
+
+func Test_retryIf(t *testing.T) {
+	t.Run("test no error", func(t *testing.T) {
+		t.Parallel()
+		rp := RetryPolicy{
+			retryCount: 3,
+			duration:   1,
+			increment:  2,
+		}
+		err := RetryIf(context.Background(), rp, func(error) bool { return true }, func() error {
+			return nil
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("test not retryable error", func(t *testing.T) {
+		t.Parallel()
+		rp := RetryPolicy{
+			retryCount: 3,
+			duration:   1,
+			increment:  2,
+		}
+
+		calls := 0
+
+		err := RetryIf(context.Background(), rp, func(error) bool { return false }, func() error {
+			calls++
+			return errors.New("test")
+		})
+
+		require.Error(t, err)
+		require.Equal(t, 1, calls)
+	})
+
+	t.Run("test transient error resolved", func(t *testing.T) {
+		t.Parallel()
+		rp := RetryPolicy{
+			retryCount: 3,
+			duration:   1,
+			increment:  2,
+		}
+
+		calls := 0
+
+		err := RetryIf(context.Background(), rp, func(error) bool { return true }, func() error {
+			calls++
+			if calls < 3 {
+				return errors.New("test")
+			}
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, 3, calls)
+	})
+}
+
+func TestNoRetry(t *testing.T) {
+	t.Run("test exactly one attempt on retryable error", func(t *testing.T) {
+		t.Parallel()
+
+		calls := 0
+
+		_, err := Retry2(context.Background(), NoRetry(), func() (*int, error) {
+			calls++
+			return nil, &pgconn.PgError{Code: "08000"}
+		})
+
+		require.Error(t, err)
+		require.Equal(t, 1, calls)
+	})
+
+	t.Run("test no error", func(t *testing.T) {
+		t.Parallel()
+
+		calls := 0
+
+		err := Retry(context.Background(), NoRetry(), func() error {
+			calls++
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, 1, calls)
+	})
+}
+
+func Test_retry(t *testing.T) {
+	t.Run("test no error", func(t *testing.T) {
+		t.Parallel()
+		rp := RetryPolicy{
+			retryCount: 3,
+			duration:   1,
+			increment:  2,
+		}
+		err := Retry(context.Background(), rp, func() error {
+			return nil
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("test error", func(t *testing.T) {
+		t.Parallel()
+		rp := RetryPolicy{
+			retryCount: 3,
+			duration:   1,
+			increment:  2,
+		}
+		err := Retry(context.Background(), rp, func() error {
+			return &pgconn.PgError{Code: "02000"}
+		})
+
+		require.Error(t, err)
+	})
+
+	t.Run("test error connection", func(t *testing.T) {
+		t.Parallel()
+		rp := RetryPolicy{
+			retryCount: 3,
+			duration:   1,
+			increment:  2,
+		}
+		err := Retry(context.Background(), rp, func() error {
+			return &pgconn.PgError{Code: "08000"}
+		})
+
+		require.Error(t, err)
+	})
+
+	t.Run("test error resolved", func(t *testing.T) {
+		t.Parallel()
+		rp := RetryPolicy{
+			retryCount: 3,
+			duration:   1,
+			increment:  2,
+		}
+
+		var errConn error = &pgconn.PgError{Code: "08000"}
+		var mu sync.RWMutex
+
+		go func() {
+			time.Sleep(5 * time.Millisecond)
+			mu.Lock()
+			defer mu.Unlock()
+			errConn = nil
+		}()
+
+		err := Retry(context.Background(), rp, func() error {
+			mu.RLock()
+			defer mu.RUnlock()
+			return errConn
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("test context done", func(t *testing.T) {
+		t.Parallel()
+		rp := RetryPolicy{
+			retryCount: 3,
+			duration:   1,
+			increment:  2,
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+		defer cancel()
+
+		err := Retry(ctx, rp, func() error {
+			return &pgconn.PgError{Code: "08000"}
+		})
+
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,1596 @@
+// Package storage implements methods for working with a PostgreSQL database.
+package storage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Tomap-Tomap/GophKeeper/parameters"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Storage represents a structure for interacting with the database.
+type Storage struct {
+	conn     *pgxpool.Pool
+	readConn *pgxpool.Pool
+
+	deterministicLogins bool
+	closeOnce           sync.Once
+	pathCrypter         PathCrypter
+}
+
+// StorageOption configures optional Storage behavior in
+// NewStorageWithConfig.
+type StorageOption func(*storageOptions)
+
+type storageOptions struct {
+	readDSN string
+	warmup  bool
+}
+
+// WithReadReplicaDSN routes entry read queries to a separate pool
+// connected to the given replica DSN, while writes and auth lookups keep
+// going to the primary. Replication lags behind the primary, so a read
+// right after a write may miss the new row; do not configure a replica
+// when read-after-write consistency matters. An empty DSN keeps all
+// queries on the primary.
+func WithReadReplicaDSN(dsn string) StorageOption {
+	return func(o *storageOptions) {
+		o.readDSN = dsn
+	}
+}
+
+// WithWarmup makes NewStorageWithConfig acquire and ping MinConns
+// connections on each pool before returning. pgxpool connects lazily, so
+// without a warmup the first requests after boot pay the connection
+// setup cost. A pool whose config keeps MinConns at zero is left alone.
+func WithWarmup() StorageOption {
+	return func(o *storageOptions) {
+		o.warmup = true
+	}
+}
+
+// PathCrypter seals and opens the files path column with a server-side
+// key, separate from the user's zero-knowledge key.
+type PathCrypter interface {
+	SealStringWithoutNonce(str string) (string, error)
+	OpenStringWithoutNonce(encryptStr string) (string, error)
+}
+
+// SetPathCrypter makes the storage seal the files path column before it
+// is written and open it after it is read, so the stored metadata does
+// not reveal blob names. The crypter must seal deterministically, or the
+// unique constraint on the column stops detecting duplicate paths. Off by
+// default; paths stored before the key was configured stay plaintext and
+// are returned unchanged.
+func (s *Storage) SetPathCrypter(pc PathCrypter) {
+	s.pathCrypter = pc
+}
+
+// sealPath seals a path column value when a PathCrypter is configured.
+func (s *Storage) sealPath(path string) (string, error) {
+	if s.pathCrypter == nil {
+		return path, nil
+	}
+
+	sealed, err := s.pathCrypter.SealStringWithoutNonce(path)
+
+	if err != nil {
+		return "", fmt.Errorf("cannot seal path: %w", err)
+	}
+
+	return sealed, nil
+}
+
+// openPath opens a path column value when a PathCrypter is configured.
+// Values that do not open are plaintext paths stored before the key was
+// configured and pass through unchanged.
+func (s *Storage) openPath(path string) string {
+	if s.pathCrypter == nil {
+		return path
+	}
+
+	opened, err := s.pathCrypter.OpenStringWithoutNonce(path)
+
+	if err != nil {
+		return path
+	}
+
+	return opened
+}
+
+// NewStorage allocates and initializes a new Storage instance.
+func NewStorage(ctx context.Context, DSN string) (*Storage, error) {
+	conn, err := pgxpool.New(ctx, DSN)
+	if err != nil {
+		// The driver error can embed the DSN, so its text is redacted
+		// before it reaches logs.
+		return nil, fmt.Errorf("create pgxpool: %s", parameters.RedactDSN(err.Error()))
+	}
+
+	dbs := &Storage{conn: conn}
+
+	return dbs, nil
+}
+
+// queryExecModes maps configuration values to pgx query execution modes.
+var queryExecModes = map[string]pgx.QueryExecMode{
+	"cache_statement": pgx.QueryExecModeCacheStatement,
+	"cache_describe":  pgx.QueryExecModeCacheDescribe,
+	"describe":        pgx.QueryExecModeDescribeExec,
+	"exec":            pgx.QueryExecModeExec,
+	"simple":          pgx.QueryExecModeSimpleProtocol,
+}
+
+// ParseQueryExecMode translates a configuration value to a pgx query
+// execution mode. An empty value keeps pgx's default and parses to the
+// zero mode.
+func ParseQueryExecMode(mode string) (pgx.QueryExecMode, error) {
+	if mode == "" {
+		return 0, nil
+	}
+
+	m, ok := queryExecModes[mode]
+
+	if !ok {
+		return 0, fmt.Errorf("%s: %w", mode, ErrUnknownQueryExecMode)
+	}
+
+	return m, nil
+}
+
+// NewStorageWithConfig allocates and initializes a new Storage instance
+// with the given query execution mode applied to the pool config.
+// QueryExecModeCacheStatement prepares and caches statements per
+// connection, cutting parse overhead on hot paths; the zero mode keeps
+// pgx's default.
+func NewStorageWithConfig(ctx context.Context, DSN string, execMode pgx.QueryExecMode, opts ...StorageOption) (*Storage, error) {
+	o := &storageOptions{}
+
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	conn, err := newPool(ctx, DSN, execMode)
+
+	if err != nil {
+		return nil, err
+	}
+
+	dbs := &Storage{conn: conn}
+
+	if o.readDSN != "" {
+		readConn, err := newPool(ctx, o.readDSN, execMode)
+
+		if err != nil {
+			conn.Close()
+
+			return nil, fmt.Errorf("read replica: %w", err)
+		}
+
+		dbs.readConn = readConn
+	}
+
+	if o.warmup {
+		if err := warmPool(ctx, dbs.conn); err != nil {
+			dbs.Close()
+
+			return nil, err
+		}
+
+		if dbs.readConn != nil {
+			if err := warmPool(ctx, dbs.readConn); err != nil {
+				dbs.Close()
+
+				return nil, fmt.Errorf("read replica: %w", err)
+			}
+		}
+	}
+
+	return dbs, nil
+}
+
+// warmPool acquires and pings MinConns connections so the pool is
+// populated before the first request. The connections are held until all
+// of them are up, otherwise the pool would hand the same one back on
+// every iteration.
+func warmPool(ctx context.Context, pool *pgxpool.Pool) error {
+	minConns := int(pool.Config().MinConns)
+
+	conns := make([]*pgxpool.Conn, 0, minConns)
+
+	defer func() {
+		for _, conn := range conns {
+			conn.Release()
+		}
+	}()
+
+	for i := 0; i < minConns; i++ {
+		conn, err := pool.Acquire(ctx)
+
+		if err != nil {
+			return fmt.Errorf("acquire warmup connection: %w", err)
+		}
+
+		conns = append(conns, conn)
+
+		if err := conn.Ping(ctx); err != nil {
+			return fmt.Errorf("ping warmup connection: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// newPool builds a pgx pool for one DSN with the given query execution
+// mode applied to the pool config.
+func newPool(ctx context.Context, DSN string, execMode pgx.QueryExecMode) (*pgxpool.Pool, error) {
+	config, err := pgxpool.ParseConfig(DSN)
+
+	if err != nil {
+		// The driver error can embed the DSN, so its text is redacted
+		// before it reaches logs.
+		return nil, fmt.Errorf("parse pgxpool config: %s", parameters.RedactDSN(err.Error()))
+	}
+
+	if execMode != 0 {
+		config.ConnConfig.DefaultQueryExecMode = execMode
+	}
+
+	conn, err := pgxpool.NewWithConfig(ctx, config)
+
+	if err != nil {
+		return nil, fmt.Errorf("create pgxpool: %s", parameters.RedactDSN(err.Error()))
+	}
+
+	return conn, nil
+}
+
+// readPool returns the pool entry read queries run against: the replica
+// when one is configured, the primary otherwise.
+func (s *Storage) readPool() *pgxpool.Pool {
+	if s.readConn != nil {
+		return s.readConn
+	}
+
+	return s.conn
+}
+
+// Ping verifies the database is reachable: the primary pool always, the
+// read replica pool when one is configured. Readiness probes use it to
+// report the server not-ready while the database is down.
+func (s *Storage) Ping(ctx context.Context) error {
+	if err := s.conn.Ping(ctx); err != nil {
+		return fmt.Errorf("ping primary pool: %w", err)
+	}
+
+	if s.readConn != nil {
+		if err := s.readConn.Ping(ctx); err != nil {
+			return fmt.Errorf("ping read replica pool: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Close gracefully closes the database connection pools. It is
+// idempotent and safe for concurrent use: the server's shutdown path and
+// tests both close the storage, so a repeated call is a no-op instead of
+// a double close of the pools.
+func (s *Storage) Close() {
+	s.closeOnce.Do(func() {
+		if s.conn != nil {
+			s.conn.Close()
+		}
+
+		if s.readConn != nil {
+			s.readConn.Close()
+		}
+	})
+}
+
+// CreateUser adds a user record to the database.
+func (s *Storage) CreateUser(ctx context.Context, login, loginHashed, salt, password string) (*User, error) {
+	ud := &User{}
+
+	err := pgx.BeginFunc(ctx, s.conn, func(tx pgx.Tx) error {
+		err := tx.QueryRow(ctx, queryInsertUser, login, password).Scan(ud)
+
+		if err != nil {
+			if IsUniqueViolation(err) {
+				return wrapPgError(login, ErrUserAlreadyExists, err)
+			}
+
+			return fmt.Errorf("insert into users table login %s: %w", login, err)
+		}
+
+		err = tx.QueryRow(ctx, queryInsertSalt, loginHashed, salt).Scan(ud)
+
+		if err != nil {
+			return fmt.Errorf("insert into salts table login %s: %w", login, err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return ud, nil
+}
+
+// CreateUserPreHashed adds a user record whose password hash and salt were
+// produced by another system. The hash, salt and algorithm marker are stored
+// verbatim so authentication can dispatch on the original algorithm.
+func (s *Storage) CreateUserPreHashed(ctx context.Context, login, loginHashed, salt, passwordHash, algo string) (*User, error) {
+	ud := &User{}
+
+	err := pgx.BeginFunc(ctx, s.conn, func(tx pgx.Tx) error {
+		err := tx.QueryRow(ctx, queryInsertUserPreHashed, login, passwordHash, algo).Scan(ud)
+
+		if err != nil {
+			if IsUniqueViolation(err) {
+				return wrapPgError(login, ErrUserAlreadyExists, err)
+			}
+
+			return fmt.Errorf("insert into users table login %s: %w", login, err)
+		}
+
+		err = tx.QueryRow(ctx, queryInsertSalt, loginHashed, salt).Scan(ud)
+
+		if err != nil {
+			return fmt.Errorf("insert into salts table login %s: %w", login, err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return ud, nil
+}
+
+// GetUser retrieves user data from the database.
+func (s *Storage) GetUser(ctx context.Context, login, loginHashed string) (*User, error) {
+	ud := &User{}
+
+	err := s.conn.QueryRow(ctx, querySelectUser, login, loginHashed).Scan(ud)
+
+	if err != nil {
+		if IsNoRowError(err) {
+			return nil, wrapPgError(login, ErrUserNotFound, err)
+		}
+
+		return nil, fmt.Errorf("get user %s: %w", login, err)
+	}
+
+	return ud, nil
+}
+
+// maintenanceTables are the data tables vacuumed by Maintenance.
+var maintenanceTables = []string{"passwords", "banks", "texts", "files"}
+
+// Maintenance runs VACUUM (ANALYZE) on the data tables and returns how long
+// the run took, so operators can reclaim space after big deletes without
+// shelling into the database. Cancelling the context aborts the run.
+func (s *Storage) Maintenance(ctx context.Context) (time.Duration, error) {
+	start := time.Now()
+
+	for _, table := range maintenanceTables {
+		_, err := s.conn.Exec(ctx, "VACUUM (ANALYZE) "+table+";")
+
+		if err != nil {
+			return 0, fmt.Errorf("vacuum table %s: %w", table, err)
+		}
+	}
+
+	return time.Since(start), nil
+}
+
+// ServerTime returns the database clock's current time. The same clock stamps
+// every UpdateAt, so it is the single time source clients should offset
+// against for "modified since" comparisons.
+func (s *Storage) ServerTime(ctx context.Context) (time.Time, error) {
+	var t time.Time
+
+	err := s.conn.QueryRow(ctx, queryServerTime).Scan(&t)
+
+	if err != nil {
+		return time.Time{}, fmt.Errorf("select server time: %w", err)
+	}
+
+	return t, nil
+}
+
+// IncrementAttempt records one failed attempt against key and returns the
+// count accumulated within the current window. A window that has elapsed
+// since the first failure starts a fresh count of one; the window logic runs
+// in a single upsert, so concurrent increments from several server instances
+// stay consistent.
+func (s *Storage) IncrementAttempt(ctx context.Context, key string, window time.Duration) (int64, error) {
+	var count int64
+
+	err := s.conn.QueryRow(ctx, queryIncrementAttempt, key, window.Seconds()).Scan(&count)
+
+	if err != nil {
+		return 0, fmt.Errorf("increment attempt: %w", err)
+	}
+
+	return count, nil
+}
+
+// ResetAttempts clears the failed attempt count for key, for example after a
+// successful login.
+func (s *Storage) ResetAttempts(ctx context.Context, key string) error {
+	_, err := s.conn.Exec(ctx, queryResetAttempts, key)
+
+	if err != nil {
+		return fmt.Errorf("reset attempts: %w", err)
+	}
+
+	return nil
+}
+
+// GetUserByLogin retrieves user data by login alone, without the salt join,
+// for flows that do not verify the password.
+func (s *Storage) GetUserByLogin(ctx context.Context, login string) (*User, error) {
+	ud := &User{}
+
+	err := s.conn.QueryRow(ctx, querySelectUserByLogin, login).Scan(ud)
+
+	if err != nil {
+		if IsNoRowError(err) {
+			return nil, wrapPgError(login, ErrUserNotFound, err)
+		}
+
+		return nil, fmt.Errorf("get user %s: %w", login, err)
+	}
+
+	return ud, nil
+}
+
+// GetUserByID returns the id and login of the user with the given id.
+func (s *Storage) GetUserByID(ctx context.Context, userID string) (*User, error) {
+	ud := &User{}
+
+	err := s.conn.QueryRow(ctx, querySelectUserByID, userID).Scan(ud)
+
+	if err != nil {
+		if IsNoRowError(err) {
+			return nil, wrapPgError(userID, ErrUserNotFound, err)
+		}
+
+		return nil, fmt.Errorf("get user %s: %w", userID, err)
+	}
+
+	return ud, nil
+}
+
+// ListUsers returns the id, login and update time of every user, for the
+// admin API. No password hashes or salts are selected.
+func (s *Storage) ListUsers(ctx context.Context) ([]UserSummary, error) {
+	users := make([]UserSummary, 0)
+
+	rows, err := s.conn.Query(ctx, querySelectUsers)
+
+	if err != nil {
+		return nil, fmt.Errorf("query execution for users list: %w", err)
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var user UserSummary
+		err := rows.Scan(&user)
+
+		if err != nil {
+			return nil, fmt.Errorf("scanning the users list query string: %w", err)
+		}
+
+		users = append(users, user)
+	}
+
+	return users, nil
+}
+
+// DeleteUser removes the user and everything stored for them: entries of
+// every kind, recovery codes, the salt and the user row itself, in one
+// transaction. It returns the file paths that were referenced, so the
+// caller can remove the stored content afterwards.
+func (s *Storage) DeleteUser(ctx context.Context, userID, loginHashed string) ([]string, error) {
+	paths := make([]string, 0)
+
+	err := pgx.BeginFunc(ctx, s.conn, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, querySelectUserFilePaths, userID)
+
+		if err != nil {
+			return fmt.Errorf("query execution for user file paths user_id %s: %w", userID, err)
+		}
+
+		for rows.Next() {
+			var path string
+
+			if err := rows.Scan(&path); err != nil {
+				rows.Close()
+				return fmt.Errorf("scanning the user file paths query string user_id %s: %w", userID, err)
+			}
+
+			paths = append(paths, s.openPath(path))
+		}
+
+		rows.Close()
+
+		if err := rows.Err(); err != nil {
+			return fmt.Errorf("reading the user file paths user_id %s: %w", userID, err)
+		}
+
+		dataQueries := []string{
+			queryDeleteUserRecoveryCodes,
+			queryDeleteUserPasswords,
+			queryDeleteUserBanks,
+			queryDeleteUserTexts,
+			queryDeleteUserFiles,
+		}
+
+		for _, query := range dataQueries {
+			if _, err := tx.Exec(ctx, query, userID); err != nil {
+				return fmt.Errorf("delete user %s data: %w", userID, err)
+			}
+		}
+
+		if _, err := tx.Exec(ctx, queryDeleteUserSalt, loginHashed); err != nil {
+			return fmt.Errorf("delete user %s salt: %w", userID, err)
+		}
+
+		ct, err := tx.Exec(ctx, queryDeleteUserRow, userID)
+
+		if err != nil {
+			return fmt.Errorf("delete user %s: %w", userID, err)
+		}
+
+		if ct.RowsAffected() == 0 {
+			return fmt.Errorf("%s: %w", userID, ErrUserNotFound)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return paths, nil
+}
+
+// ReplaceRecoveryCodes replaces all recovery codes of the user with the
+// given hashes in one transaction, so regenerating codes invalidates the
+// old set.
+func (s *Storage) ReplaceRecoveryCodes(ctx context.Context, userID string, codeHashes []string) error {
+	err := pgx.BeginFunc(ctx, s.conn, func(tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, queryDeleteRecoveryCodes, userID)
+
+		if err != nil {
+			return fmt.Errorf("delete recovery codes user_id %s: %w", userID, err)
+		}
+
+		for _, hash := range codeHashes {
+			_, err := tx.Exec(ctx, queryInsertRecoveryCode, userID, hash)
+
+			if err != nil {
+				return fmt.Errorf("insert recovery code user_id %s: %w", userID, err)
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetRecoveryCodes retrieves the stored recovery code hashes of the user.
+func (s *Storage) GetRecoveryCodes(ctx context.Context, userID string) ([]RecoveryCode, error) {
+	codes := make([]RecoveryCode, 0)
+
+	rows, err := s.conn.Query(ctx, querySelectRecoveryCodes, userID)
+
+	if err != nil {
+		return nil, fmt.Errorf("query execution from table recovery_codes user_id %s: %w", userID, err)
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var code RecoveryCode
+		err := rows.Scan(&code)
+
+		if err != nil {
+			return nil, fmt.Errorf("scanning the query string from recovery_codes table user_id %s: %w", userID, err)
+		}
+
+		codes = append(codes, code)
+	}
+
+	return codes, nil
+}
+
+// DeleteRecoveryCode deletes a recovery code by its ID, enforcing single
+// use.
+func (s *Storage) DeleteRecoveryCode(ctx context.Context, id string) error {
+	code := &RecoveryCode{}
+
+	err := s.conn.QueryRow(ctx, queryDeleteRecoveryCode, id).Scan(code)
+
+	if err != nil {
+		if IsNoRowError(err) {
+			return wrapPgError(id, ErrRecoveryCodeNotFound, err)
+		}
+
+		return fmt.Errorf("delete recovery code %s: %w", id, err)
+	}
+
+	return nil
+}
+
+// UpsertPublicKey stores the user's public key for sharing, replacing any
+// previously uploaded one. The key is stored as given; format validation
+// is the caller's job.
+func (s *Storage) UpsertPublicKey(ctx context.Context, userID, publicKey string) error {
+	var id string
+
+	err := s.conn.QueryRow(ctx, queryUpsertPublicKey, userID, publicKey).Scan(&id)
+
+	if err != nil {
+		if IsForeignKeyViolation(err) {
+			return wrapPgError(userID, ErrUserNotFound, err)
+		}
+
+		return fmt.Errorf("upsert into user_public_keys table user_id %s: %w", userID, err)
+	}
+
+	return nil
+}
+
+// GetPublicKey returns the public key uploaded by the user.
+// It returns ErrPublicKeyNotFound when the user has not uploaded one.
+func (s *Storage) GetPublicKey(ctx context.Context, userID string) (string, error) {
+	var publicKey string
+
+	err := s.readPool().QueryRow(ctx, querySelectPublicKey, userID).Scan(&publicKey)
+
+	if err != nil {
+		if IsNoRowError(err) {
+			return "", wrapPgError(userID, ErrPublicKeyNotFound, err)
+		}
+
+		return "", fmt.Errorf("query execution from table user_public_keys user_id %s: %w", userID, err)
+	}
+
+	return publicKey, nil
+}
+
+// TouchSession records that the device was seen now from the given IP,
+// creating the session row on first sight, and returns the session's
+// token marker so the caller can reject tokens issued before the last
+// revocation.
+func (s *Storage) TouchSession(ctx context.Context, userID, deviceID, ip string) (time.Time, error) {
+	var marker time.Time
+
+	err := s.conn.QueryRow(ctx, queryUpsertSession, userID, deviceID, ip).Scan(&marker)
+
+	if err != nil {
+		if IsForeignKeyViolation(err) {
+			return time.Time{}, wrapPgError(userID, ErrUserNotFound, err)
+		}
+
+		return time.Time{}, fmt.Errorf("upsert into sessions table device %s: %w", deviceID, err)
+	}
+
+	return marker, nil
+}
+
+// ListSessions returns the user's device sessions, most recently seen
+// first.
+func (s *Storage) ListSessions(ctx context.Context, userID string) ([]Session, error) {
+	sessions := make([]Session, 0)
+
+	rows, err := s.conn.Query(ctx, querySelectSessions, userID)
+
+	if err != nil {
+		return nil, fmt.Errorf("query execution from table sessions user_id %s: %w", userID, err)
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var session Session
+		err := rows.Scan(&session)
+
+		if err != nil {
+			return nil, fmt.Errorf("scanning the query string from sessions table user_id %s: %w", userID, err)
+		}
+
+		sessions = append(sessions, session)
+	}
+
+	return sessions, nil
+}
+
+// RevokeSession bumps the session's token marker to now, so tokens issued
+// for that device before this moment stop working while the user's other
+// devices are unaffected.
+func (s *Storage) RevokeSession(ctx context.Context, userID, deviceID string) error {
+	var revoked string
+
+	err := s.conn.QueryRow(ctx, queryRevokeSession, userID, deviceID).Scan(&revoked)
+
+	if err != nil {
+		if IsNoRowError(err) {
+			return wrapPgError(deviceID, ErrSessionNotFound, err)
+		}
+
+		return fmt.Errorf("revoke session device %s: %w", deviceID, err)
+	}
+
+	return nil
+}
+
+// CreatePassword adds password data to the database.
+func (s *Storage) CreatePassword(ctx context.Context, userID, name, login, password, meta, wrappedKey, fingerprint string, pinned, requiresReauth bool) (*Password, error) {
+	pwd := &Password{}
+
+	err := s.conn.QueryRow(ctx, queryInsertPassword, userID, name, login, password, meta, wrappedKey, fingerprint, pinned, requiresReauth).Scan(pwd)
+
+	if err != nil {
+		if IsForeignKeyViolation(err) {
+			return nil, wrapPgError(userID, ErrUserNotFound, err)
+		}
+
+		return nil, fmt.Errorf("insert into passwords table name %s: %w", name, err)
+	}
+
+	return pwd, nil
+}
+
+// UpdatePassword updates password data in the database.
+func (s *Storage) UpdatePassword(ctx context.Context, passwordID, userID, name, login, password, meta, wrappedKey, fingerprint string, pinned, requiresReauth bool) (*Password, error) {
+	pwd := &Password{}
+
+	err := s.conn.QueryRow(ctx, queryUpdatePassword, userID, name, login, password, meta, wrappedKey, fingerprint, pinned, requiresReauth, passwordID).Scan(pwd)
+
+	if err != nil {
+		switch {
+		case IsForeignKeyViolation(err):
+			return nil, wrapPgError(userID, ErrUserNotFound, err)
+		case IsNoRowError(err):
+			return nil, wrapPgError(passwordID, ErrPasswordNotFound, err)
+		default:
+			return nil, fmt.Errorf("update passwords table name %s: %w", name, err)
+		}
+	}
+
+	return pwd, nil
+}
+
+// GetPassword returns password data from the database.
+func (s *Storage) GetPassword(ctx context.Context, passwordID, userID string) (*Password, error) {
+	pwd := &Password{}
+
+	err := s.readPool().QueryRow(ctx, querySelectPassword, passwordID, userID).Scan(pwd)
+
+	if err != nil {
+		if IsNoRowError(err) {
+			return nil, wrapPgError(passwordID, ErrPasswordNotFound, err)
+		}
+
+		return nil, fmt.Errorf("get password id %s: %w", passwordID, err)
+	}
+
+	return pwd, nil
+}
+
+// UpdatePasswordMetaJSON replaces the structured metadata of a password
+// entry. Values are sealed client-side except designated non-sensitive
+// keys, which arrive in plaintext so the server can filter on them. The
+// legacy meta string is left untouched.
+func (s *Storage) UpdatePasswordMetaJSON(ctx context.Context, passwordID, userID string, meta map[string]string) error {
+	var id string
+
+	err := s.conn.QueryRow(ctx, queryUpdatePasswordMetaJSON, meta, passwordID, userID).Scan(&id)
+
+	if err != nil {
+		if IsNoRowError(err) {
+			return wrapPgError(passwordID, ErrPasswordNotFound, err)
+		}
+
+		return fmt.Errorf("update meta_json for password id %s: %w", passwordID, err)
+	}
+
+	return nil
+}
+
+// GetPasswordMetaJSON returns the structured metadata of a password entry.
+// A password without structured metadata yields a nil map.
+func (s *Storage) GetPasswordMetaJSON(ctx context.Context, passwordID, userID string) (map[string]string, error) {
+	var meta map[string]string
+
+	err := s.readPool().QueryRow(ctx, querySelectPasswordMetaJSON, passwordID, userID).Scan(&meta)
+
+	if err != nil {
+		if IsNoRowError(err) {
+			return nil, wrapPgError(passwordID, ErrPasswordNotFound, err)
+		}
+
+		return nil, fmt.Errorf("get meta_json for password id %s: %w", passwordID, err)
+	}
+
+	return meta, nil
+}
+
+// GetAllPassword returns all passwords data from the database.
+func (s *Storage) GetAllPassword(ctx context.Context, userID string) ([]Password, error) {
+	pwds := make([]Password, 0)
+
+	rows, err := s.readPool().Query(ctx, querySelectPasswords, userID)
+
+	if err != nil {
+		return nil, fmt.Errorf("query execution from table passwords user_id %s: %w", userID, err)
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var pwd Password
+		err := rows.Scan(&pwd)
+
+		if err != nil {
+			return nil, fmt.Errorf("scanning the query string from passwords table user_id %s: %w", userID, err)
+		}
+
+		pwds = append(pwds, pwd)
+	}
+
+	return pwds, nil
+}
+
+// EnableDeterministicLogins marks the password logins as deterministically
+// encrypted, allowing GetPasswordsByLogin to match on the ciphertext. The
+// crypter currently seals with random nonces, so this stays off until a
+// deterministic encryption mode exists.
+func (s *Storage) EnableDeterministicLogins() {
+	s.deterministicLogins = true
+}
+
+// GetPasswordsByLogin returns the passwords whose encrypted login column
+// exactly matches the given sealed login. The match is only meaningful for
+// deterministic encryption, so ErrDeterministicEncryptionDisabled is
+// returned unless EnableDeterministicLogins was called.
+func (s *Storage) GetPasswordsByLogin(ctx context.Context, userID, login string) ([]Password, error) {
+	if !s.deterministicLogins {
+		return nil, ErrDeterministicEncryptionDisabled
+	}
+
+	pwds := make([]Password, 0)
+
+	rows, err := s.readPool().Query(ctx, querySelectPasswordsByLogin, userID, login)
+
+	if err != nil {
+		return nil, fmt.Errorf("query execution from table passwords user_id %s: %w", userID, err)
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var pwd Password
+		err := rows.Scan(&pwd)
+
+		if err != nil {
+			return nil, fmt.Errorf("scanning the query string from passwords table user_id %s: %w", userID, err)
+		}
+
+		pwds = append(pwds, pwd)
+	}
+
+	return pwds, nil
+}
+
+// GetPasswordsByCategory returns the passwords whose structured metadata
+// carries the given plaintext category key. The filter uses jsonb
+// containment on meta_json, so it is served by the GIN index instead of
+// scanning and decrypting everything client-side.
+func (s *Storage) GetPasswordsByCategory(ctx context.Context, userID, category string) ([]Password, error) {
+	pwds := make([]Password, 0)
+
+	rows, err := s.readPool().Query(ctx, querySelectPasswordsByCategory, userID, category)
+
+	if err != nil {
+		return nil, fmt.Errorf("query execution from table passwords user_id %s: %w", userID, err)
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var pwd Password
+		err := rows.Scan(&pwd)
+
+		if err != nil {
+			return nil, fmt.Errorf("scanning the query string from passwords table user_id %s: %w", userID, err)
+		}
+
+		pwds = append(pwds, pwd)
+	}
+
+	return pwds, nil
+}
+
+// DeletePassword delete password data in the database.
+func (s *Storage) DeletePassword(ctx context.Context, passwordID, userID string) error {
+	file := &Password{}
+
+	err := s.conn.QueryRow(ctx, queryDeletePassword, passwordID, userID).Scan(file)
+
+	if err != nil {
+		if IsNoRowError(err) {
+			return wrapPgError(passwordID, ErrPasswordNotFound, err)
+		}
+		return fmt.Errorf("delete passwords %s: %w", passwordID, err)
+	}
+
+	return nil
+}
+
+// TouchPassword bumps the password's update time without changing any other
+// field; the update trigger sets updateAt on every update.
+func (s *Storage) TouchPassword(ctx context.Context, passwordID, userID string) (*Password, error) {
+	pwd := &Password{}
+
+	err := s.conn.QueryRow(ctx, queryTouchPassword, passwordID, userID).Scan(pwd)
+
+	if err != nil {
+		if IsNoRowError(err) {
+			return nil, wrapPgError(passwordID, ErrPasswordNotFound, err)
+		}
+
+		return nil, fmt.Errorf("touch passwords %s: %w", passwordID, err)
+	}
+
+	return pwd, nil
+}
+
+// GetReusedPasswords groups the user's password entries by their
+// client-supplied fingerprint and returns the groups stored more than
+// once. Entries without a fingerprint are ignored.
+func (s *Storage) GetReusedPasswords(ctx context.Context, userID string) ([]ReusedPasswords, error) {
+	reused := make([]ReusedPasswords, 0)
+
+	rows, err := s.readPool().Query(ctx, queryReusedPasswords, userID)
+
+	if err != nil {
+		return nil, fmt.Errorf("query execution for reused passwords user_id %s: %w", userID, err)
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var group ReusedPasswords
+		err := rows.Scan(&group)
+
+		if err != nil {
+			return nil, fmt.Errorf("scanning the reused passwords query string user_id %s: %w", userID, err)
+		}
+
+		reused = append(reused, group)
+	}
+
+	return reused, nil
+}
+
+// CreateFile adds file data to the database.
+func (s *Storage) CreateFile(ctx context.Context, userID, name, pathToFile, meta string, pinned, requiresReauth bool) (*File, error) {
+	file := &File{}
+
+	sealedPath, err := s.sealPath(pathToFile)
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.conn.QueryRow(ctx, queryInsertFile, userID, name, sealedPath, meta, pinned, requiresReauth).Scan(file)
+
+	if err != nil {
+		switch {
+		case IsForeignKeyViolation(err):
+			return nil, wrapPgError(userID, ErrUserNotFound, err)
+		case IsUniqueViolation(err):
+			return nil, wrapPgError(pathToFile, ErrPathAlreadyExists, err)
+		default:
+			return nil, fmt.Errorf("insert into files table name %s: %w", name, err)
+		}
+	}
+
+	file.PathToFile = s.openPath(file.PathToFile)
+
+	return file, nil
+}
+
+// UpdateFile updates file data in the database.
+func (s *Storage) UpdateFile(ctx context.Context, fileID, userID, name, pathToFile, meta string, pinned, requiresReauth bool) (*File, error) {
+	file := &File{}
+
+	sealedPath, err := s.sealPath(pathToFile)
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.conn.QueryRow(ctx, queryUpdateFile, userID, name, sealedPath, meta, pinned, requiresReauth, fileID).Scan(file)
+
+	if err != nil {
+		switch {
+		case IsForeignKeyViolation(err):
+			return nil, wrapPgError(userID, ErrUserNotFound, err)
+		case IsUniqueViolation(err):
+			return nil, wrapPgError(pathToFile, ErrPathAlreadyExists, err)
+		case IsNoRowError(err):
+			return nil, wrapPgError(fileID, ErrFileNotFound, err)
+		default:
+			return nil, fmt.Errorf("update files table name %s: %w", name, err)
+		}
+	}
+
+	file.PathToFile = s.openPath(file.PathToFile)
+
+	return file, nil
+}
+
+// UpdateFileInfo updates a file's name and meta in the database without
+// touching its content path.
+func (s *Storage) UpdateFileInfo(ctx context.Context, fileID, userID, name, meta string, pinned, requiresReauth bool) (*File, error) {
+	file := &File{}
+
+	err := s.conn.QueryRow(ctx, queryUpdateFileInfo, name, meta, pinned, requiresReauth, fileID, userID).Scan(file)
+
+	if err != nil {
+		if IsNoRowError(err) {
+			return nil, wrapPgError(fileID, ErrFileNotFound, err)
+		}
+
+		return nil, fmt.Errorf("update files table info name %s: %w", name, err)
+	}
+
+	file.PathToFile = s.openPath(file.PathToFile)
+
+	return file, nil
+}
+
+// GetFile returns file data from the database.
+func (s *Storage) GetFile(ctx context.Context, fileID, userID string) (*File, error) {
+	file := &File{}
+
+	err := s.readPool().QueryRow(ctx, querySelectFile, fileID, userID).Scan(file)
+
+	if err != nil {
+		if IsNoRowError(err) {
+			return nil, wrapPgError(fileID, ErrFileNotFound, err)
+		}
+
+		return nil, fmt.Errorf("get file id %s: %w", fileID, err)
+	}
+
+	file.PathToFile = s.openPath(file.PathToFile)
+
+	return file, nil
+}
+
+// GetAllFiles returns all files data from the database.
+func (s *Storage) GetAllFiles(ctx context.Context, userID string) ([]File, error) {
+	files := make([]File, 0)
+
+	rows, err := s.readPool().Query(ctx, querySelectFiles, userID)
+
+	if err != nil {
+		return nil, fmt.Errorf("query execution from table files user_id %s: %w", userID, err)
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var file File
+		err := rows.Scan(&file)
+
+		if err != nil {
+			return nil, fmt.Errorf("scanning the query string from files table user_id %s: %w", userID, err)
+		}
+
+		file.PathToFile = s.openPath(file.PathToFile)
+
+		files = append(files, file)
+	}
+
+	return files, nil
+}
+
+// ListAllFiles returns every files row regardless of owner. It backs the
+// blob integrity scan, which needs the stored paths of all users.
+func (s *Storage) ListAllFiles(ctx context.Context) ([]File, error) {
+	files := make([]File, 0)
+
+	rows, err := s.readPool().Query(ctx, querySelectAllFiles)
+
+	if err != nil {
+		return nil, fmt.Errorf("query execution from table files: %w", err)
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var file File
+		err := rows.Scan(&file)
+
+		if err != nil {
+			return nil, fmt.Errorf("scanning the query string from files table: %w", err)
+		}
+
+		file.PathToFile = s.openPath(file.PathToFile)
+
+		files = append(files, file)
+	}
+
+	return files, nil
+}
+
+// DeleteFile delete file data in the database.
+func (s *Storage) DeleteFile(ctx context.Context, fileID, userID string) (*File, error) {
+	file := &File{}
+
+	err := s.conn.QueryRow(ctx, queryDeleteFile, fileID, userID).Scan(file)
+
+	if err != nil {
+		if IsNoRowError(err) {
+			return nil, wrapPgError(fileID, ErrFileNotFound, err)
+		}
+		return nil, fmt.Errorf("delete file id %s: %w", fileID, err)
+	}
+
+	file.PathToFile = s.openPath(file.PathToFile)
+
+	return file, nil
+}
+
+// CreateBank adds bank data to the database.
+func (s *Storage) CreateBank(ctx context.Context, userID, name, number, cvc, owner, exp, meta string, pinned, requiresReauth bool) (*Bank, error) {
+	bank := &Bank{}
+
+	err := s.conn.QueryRow(ctx, queryInsertBank, userID, name, number, exp, cvc, owner, meta, pinned, requiresReauth).Scan(bank)
+
+	if err != nil {
+		if IsForeignKeyViolation(err) {
+			return nil, wrapPgError(userID, ErrUserNotFound, err)
+		}
+
+		return nil, fmt.Errorf("insert into banks table name %s: %w", name, err)
+	}
+
+	return bank, nil
+}
+
+// UpdateBank updates bank data in the database.
+func (s *Storage) UpdateBank(ctx context.Context, bankID, userID, name, number, cvc, owner, exp, meta string, pinned, requiresReauth bool) (*Bank, error) {
+	bank := &Bank{}
+
+	err := s.conn.QueryRow(ctx, queryUpdateBank, userID, name, number, exp, cvc, owner, meta, pinned, requiresReauth, bankID).Scan(bank)
+
+	if err != nil {
+		switch {
+		case IsForeignKeyViolation(err):
+			return nil, wrapPgError(userID, ErrUserNotFound, err)
+		case IsNoRowError(err):
+			return nil, wrapPgError(bankID, ErrBankNotFound, err)
+		default:
+			return nil, fmt.Errorf("update banks table name %s: %w", name, err)
+		}
+	}
+
+	return bank, nil
+}
+
+// GetBank returns bank data from the database.
+func (s *Storage) GetBank(ctx context.Context, bankID, userID string) (*Bank, error) {
+	bank := &Bank{}
+
+	err := s.readPool().QueryRow(ctx, querySelectBank, bankID, userID).Scan(bank)
+
+	if err != nil {
+		if IsNoRowError(err) {
+			return nil, wrapPgError(bankID, ErrBankNotFound, err)
+		}
+
+		return nil, fmt.Errorf("get bank id %s: %w", bankID, err)
+	}
+
+	return bank, nil
+}
+
+// GetAllBanks returns all banks data from the database.
+func (s *Storage) GetAllBanks(ctx context.Context, userID string) ([]Bank, error) {
+	banks := make([]Bank, 0)
+
+	rows, err := s.readPool().Query(ctx, querySelectBanks, userID)
+
+	if err != nil {
+		return nil, fmt.Errorf("query execution from table banks user_id %s: %w", userID, err)
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var bank Bank
+		err := rows.Scan(&bank)
+
+		if err != nil {
+			return nil, fmt.Errorf("scanning the query string from banks table user_id %s: %w", userID, err)
+		}
+
+		banks = append(banks, bank)
+	}
+
+	return banks, nil
+}
+
+// DeleteBank delete bank data in the database.
+func (s *Storage) DeleteBank(ctx context.Context, bankID, userID string) error {
+	bank := &Bank{}
+	err := s.conn.QueryRow(ctx, queryDeleteBank, bankID, userID).Scan(bank)
+
+	if err != nil {
+		if IsNoRowError(err) {
+			return wrapPgError(bankID, ErrBankNotFound, err)
+		}
+
+		return fmt.Errorf("delete bank %s: %w", bankID, err)
+	}
+
+	return nil
+}
+
+// CreateText adds text data to the database.
+func (s *Storage) CreateText(ctx context.Context, userID, name, text, meta string, pinned, requiresReauth bool) (*Text, error) {
+	t := &Text{}
+
+	err := s.conn.QueryRow(ctx, queryInsertText, userID, name, text, meta, pinned, requiresReauth).Scan(t)
+
+	if err != nil {
+		if IsForeignKeyViolation(err) {
+			return nil, wrapPgError(userID, ErrUserNotFound, err)
+		}
+
+		return nil, fmt.Errorf("insert into texts table name %s: %w", name, err)
+	}
+
+	return t, nil
+}
+
+// UpdateText updates text data in the database.
+func (s *Storage) UpdateText(ctx context.Context, textID, userID, name, text, meta string, pinned, requiresReauth bool) (*Text, error) {
+
+	t := &Text{}
+
+	err := s.conn.QueryRow(ctx, queryUpdateText, userID, name, text, meta, pinned, requiresReauth, textID).Scan(t)
+
+	if err != nil {
+		switch {
+		case IsForeignKeyViolation(err):
+			return nil, wrapPgError(userID, ErrUserNotFound, err)
+		case IsNoRowError(err):
+			return nil, wrapPgError(textID, ErrTextNotFound, err)
+		default:
+			return nil, fmt.Errorf("update texts table name %s: %w", name, err)
+		}
+	}
+
+	return t, nil
+}
+
+// GetText returns text data from the database.
+func (s *Storage) GetText(ctx context.Context, textID, userID string) (*Text, error) {
+	t := &Text{}
+
+	err := s.readPool().QueryRow(ctx, querySelectText, textID, userID).Scan(t)
+
+	if err != nil {
+		if IsNoRowError(err) {
+			return nil, wrapPgError(textID, ErrTextNotFound, err)
+		}
+
+		return nil, fmt.Errorf("get text id %s: %w", textID, err)
+	}
+
+	return t, nil
+}
+
+// textOrderColumns maps the allowed order_by values to the real column
+// names, so user input never reaches the query text directly.
+var textOrderColumns = map[string]string{
+	"name":      "name",
+	"update_at": "updateAt",
+}
+
+// GetAllTexts returns all texts data from the database. The result can be
+// ordered by name or update_at and paginated with limit/offset; zero values
+// leave the query unordered and unpaginated. Names are stored encrypted, so
+// ordering by name follows the ciphertext bytes.
+func (s *Storage) GetAllTexts(ctx context.Context, userID, orderBy, orderDir string, limit, offset int) ([]Text, error) {
+	texts := make([]Text, 0)
+
+	query := querySelectTexts
+
+	if col, ok := textOrderColumns[orderBy]; ok {
+		dir := "ASC"
+
+		if strings.EqualFold(orderDir, "desc") {
+			dir = "DESC"
+		}
+
+		query += fmt.Sprintf(" ORDER BY %s %s", col, dir)
+	}
+
+	if limit > 0 {
+		query += fmt.Sprintf(" LIMIT %d", limit)
+	}
+
+	if offset > 0 {
+		query += fmt.Sprintf(" OFFSET %d", offset)
+	}
+
+	rows, err := s.readPool().Query(ctx, query, userID)
+
+	if err != nil {
+		return nil, fmt.Errorf("query execution from table texts user_id %s: %w", userID, err)
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var text Text
+		err := rows.Scan(&text)
+
+		if err != nil {
+			return nil, fmt.Errorf("scanning the query string from texts table user_id %s: %w", userID, err)
+		}
+
+		texts = append(texts, text)
+	}
+
+	return texts, nil
+}
+
+// GetFavorites returns the user's pinned entries of every kind.
+func (s *Storage) GetFavorites(ctx context.Context, userID string) (*Favorites, error) {
+	favorites := &Favorites{
+		Passwords: make([]Password, 0),
+		Files:     make([]File, 0),
+		Banks:     make([]Bank, 0),
+		Texts:     make([]Text, 0),
+	}
+
+	collect := func(query, table string, scan func(pgx.Rows) error) error {
+		rows, err := s.readPool().Query(ctx, query, userID)
+
+		if err != nil {
+			return fmt.Errorf("query execution from table %s user_id %s: %w", table, userID, err)
+		}
+
+		defer rows.Close()
+
+		for rows.Next() {
+			if err := scan(rows); err != nil {
+				return fmt.Errorf("scanning the query string from %s table user_id %s: %w", table, userID, err)
+			}
+		}
+
+		return nil
+	}
+
+	err := collect(querySelectFavoritePasswords, "passwords", func(rows pgx.Rows) error {
+		var pwd Password
+
+		if err := rows.Scan(&pwd); err != nil {
+			return err
+		}
+
+		favorites.Passwords = append(favorites.Passwords, pwd)
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = collect(querySelectFavoriteFiles, "files", func(rows pgx.Rows) error {
+		var file File
+
+		if err := rows.Scan(&file); err != nil {
+			return err
+		}
+
+		file.PathToFile = s.openPath(file.PathToFile)
+		favorites.Files = append(favorites.Files, file)
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = collect(querySelectFavoriteBanks, "banks", func(rows pgx.Rows) error {
+		var bank Bank
+
+		if err := rows.Scan(&bank); err != nil {
+			return err
+		}
+
+		favorites.Banks = append(favorites.Banks, bank)
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = collect(querySelectFavoriteTexts, "texts", func(rows pgx.Rows) error {
+		var text Text
+
+		if err := rows.Scan(&text); err != nil {
+			return err
+		}
+
+		favorites.Texts = append(favorites.Texts, text)
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return favorites, nil
+}
+
+// GetRecentActivity returns the user's entries across all types, newest
+// first. Only id, kind, name and update time are selected; names stay
+// sealed for the client to open.
+func (s *Storage) GetRecentActivity(ctx context.Context, userID string, limit int) ([]Activity, error) {
+	activities := make([]Activity, 0)
+
+	rows, err := s.readPool().Query(ctx, querySelectRecentActivity, userID, limit)
+
+	if err != nil {
+		return nil, fmt.Errorf("query execution for recent activity user_id %s: %w", userID, err)
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var activity Activity
+		err := rows.Scan(&activity)
+
+		if err != nil {
+			return nil, fmt.Errorf("scanning the recent activity query string user_id %s: %w", userID, err)
+		}
+
+		activities = append(activities, activity)
+	}
+
+	return activities, nil
+}
+
+// entryIDQueries maps an entry kind to its id listing query.
+var entryIDQueries = map[string]string{
+	"password": querySelectPasswordIDs,
+	"bank":     querySelectBankIDs,
+	"text":     querySelectTextIDs,
+	"file":     querySelectFileIDs,
+}
+
+// GetEntryIDs returns the ids and update times of the user's entries of the
+// given kind: password, bank, text or file. Nothing else is selected, so
+// clients can reconcile a cache without downloading data.
+func (s *Storage) GetEntryIDs(ctx context.Context, userID, kind string) ([]EntryID, error) {
+	query, ok := entryIDQueries[kind]
+
+	if !ok {
+		return nil, fmt.Errorf("%s: %w", kind, ErrUnknownEntryKind)
+	}
+
+	entries := make([]EntryID, 0)
+
+	rows, err := s.readPool().Query(ctx, query, userID)
+
+	if err != nil {
+		return nil, fmt.Errorf("query execution for %s ids user_id %s: %w", kind, userID, err)
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var entry EntryID
+		err := rows.Scan(&entry)
+
+		if err != nil {
+			return nil, fmt.Errorf("scanning the %s ids query string user_id %s: %w", kind, userID, err)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// GetVaultManifest returns the ids, kinds and update times of all the
+// user's entries across every kind in one stable-ordered result, backed by
+// a single UNION query. The result can be paginated with limit/offset;
+// zero values return everything. Deleted entries simply disappear from
+// the manifest.
+func (s *Storage) GetVaultManifest(ctx context.Context, userID string, limit, offset int) ([]ManifestEntry, error) {
+	query := queryVaultManifest
+
+	if limit > 0 {
+		query += fmt.Sprintf(" LIMIT %d", limit)
+	}
+
+	if offset > 0 {
+		query += fmt.Sprintf(" OFFSET %d", offset)
+	}
+
+	entries := make([]ManifestEntry, 0)
+
+	rows, err := s.readPool().Query(ctx, query, userID)
+
+	if err != nil {
+		return nil, fmt.Errorf("query execution for vault manifest user_id %s: %w", userID, err)
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var entry ManifestEntry
+		err := rows.Scan(&entry)
+
+		if err != nil {
+			return nil, fmt.Errorf("scanning the vault manifest query string user_id %s: %w", userID, err)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// DeleteText delete text data in the database.
+func (s *Storage) DeleteText(ctx context.Context, textID, userID string) error {
+	text := &Text{}
+	err := s.conn.QueryRow(ctx, queryDeleteText, textID, userID).Scan(text)
+
+	if err != nil {
+		if IsNoRowError(err) {
+			return wrapPgError(textID, ErrTextNotFound, err)
+		}
+
+		return fmt.Errorf("delete text %s: %w", textID, err)
+	}
+
+	return nil
+}
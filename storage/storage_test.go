@@ -0,0 +1,1961 @@
+//go:build integration
+
+// Package storage определяет структуры и методы для работы с базой данных postgres
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/Tomap-Tomap/GophKeeper/ratelimit"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	emptyUUID = "00000000-0000-0000-0000-000000000000"
+)
+
+func cleanupTables(s *Storage) error {
+	_, err := s.conn.Exec(context.Background(), "TRUNCATE TABLE users, banks, files, passwords, salts, texts, sessions, login_attempts;")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+type StorageTestSuite struct {
+	suite.Suite
+
+	testStorage *Storage
+
+	testLogin     string
+	testLoginHash string
+	testSalt      string
+	testPassword  string
+	testUserID    string
+
+	testPWDName     string
+	testPWDLogin    string
+	testPWDPassword string
+	testPWDMeta     string
+	testPWDID       string
+	testPWDUploadAt time.Time
+
+	testFileName     string
+	testFilePath     string
+	testFileMeta     string
+	testFileID       string
+	testFileUploadAt time.Time
+
+	testBankID       string
+	testBankName     string
+	testBankNumber   string
+	testBankCVC      string
+	testBankOwner    string
+	testBankExp      string
+	testBankMeta     string
+	testBankUploadAt time.Time
+
+	testTextID       string
+	testTextName     string
+	testTextText     string
+	testTextMeta     string
+	testTextUploadAt time.Time
+}
+
+func (suite *StorageTestSuite) SetupSuite() {
+	require := suite.Require()
+	dsn := os.Getenv("TEST_DSN")
+	require.NotEmpty(dsn, "TEST_DSN environment variable is not set")
+	s, err := NewStorage(context.Background(), dsn)
+	require.NoError(err)
+	suite.testStorage = s
+
+	suite.testLogin = "TestLogin"
+	suite.testLoginHash = "TestLoginHash"
+	suite.testPassword = "TestPassword"
+	suite.testSalt = "TestSalt"
+
+	gotUD, err := suite.testStorage.CreateUser(
+		context.Background(),
+		suite.testLogin,
+		suite.testLoginHash,
+		suite.testSalt,
+		suite.testPassword,
+	)
+	require.NoError(err)
+	require.NotNil(gotUD)
+
+	suite.testUserID = gotUD.ID
+
+	suite.testPWDName = "TestPWDName"
+	suite.testPWDLogin = "testPWDLogin"
+	suite.testPWDPassword = "testPWDPassword"
+	suite.testPWDMeta = "testPWDMeta"
+
+	gotPD, err := suite.testStorage.CreatePassword(
+		context.Background(),
+		suite.testUserID, suite.testPWDName,
+		suite.testPWDLogin,
+		suite.testPWDPassword,
+		suite.testPWDMeta,
+		"",
+		"",
+		false,
+		false,
+	)
+	require.NoError(err)
+	require.NotNil(gotPD)
+
+	suite.testPWDID = gotPD.ID
+	suite.testPWDUploadAt = gotPD.UpdateAt
+
+	suite.testFileName = "TestFileName"
+	suite.testFilePath = "testFilePath"
+	suite.testFileMeta = "testFileMeta"
+
+	gotFile, err := suite.testStorage.CreateFile(
+		context.Background(),
+		suite.testUserID,
+		suite.testFileName,
+		suite.testFilePath,
+		suite.testFileMeta,
+		false,
+		false,
+	)
+	require.NoError(err)
+	require.NotNil(gotFile)
+
+	suite.testFileID = gotFile.ID
+	suite.testFileUploadAt = gotFile.UpdateAt
+
+	suite.testBankName = "testBankName"
+	suite.testBankNumber = "testBankNumber"
+	suite.testBankCVC = "testBankCVC"
+	suite.testBankOwner = "testBankOwner"
+	suite.testBankExp = "testBankExp"
+	suite.testBankMeta = "testBankMeta"
+
+	gotBank, err := suite.testStorage.CreateBank(
+		context.Background(),
+		suite.testUserID,
+		suite.testBankName,
+		suite.testBankNumber,
+		suite.testBankCVC,
+		suite.testBankOwner,
+		suite.testBankExp,
+		suite.testBankMeta,
+		false,
+		false,
+	)
+	require.NoError(err)
+	require.NotNil(gotBank)
+
+	suite.testBankID = gotBank.ID
+	suite.testBankUploadAt = gotBank.UpdateAt
+
+	suite.testTextName = "testTextName"
+	suite.testTextText = "testTextText"
+	suite.testTextMeta = "testTextMeta"
+
+	gotText, err := suite.testStorage.CreateText(
+		context.Background(),
+		suite.testUserID,
+		suite.testTextName,
+		suite.testTextText,
+		suite.testTextMeta,
+		false,
+		false,
+	)
+
+	require.NoError(err)
+	require.NotNil(gotText)
+
+	suite.testTextID = gotText.ID
+	suite.testTextUploadAt = gotText.UpdateAt
+}
+
+func (suite *StorageTestSuite) TearDownSuite() {
+	err := cleanupTables(suite.testStorage)
+	suite.Require().NoError(err)
+	suite.testStorage.Close()
+}
+
+func (suite *StorageTestSuite) TestNewStorage() {
+	_, err := NewStorage(context.Background(), "errorDSN")
+
+	suite.Require().ErrorContains(err, "create pgxpool")
+}
+
+func (suite *StorageTestSuite) TestCreateUser() {
+	require := suite.Require()
+	suite.Run("create with empty login", func() {
+		gotUD, err := suite.testStorage.CreateUser(context.Background(), "", "Test", "Test", "Test")
+		require.ErrorContains(err, "insert into users table login")
+		require.Nil(gotUD)
+	})
+
+	suite.Run("create with empty password", func() {
+		gotUD, err := suite.testStorage.CreateUser(context.Background(), "Test", "Test", "Test", "")
+		require.ErrorContains(err, "insert into users table login")
+		require.Nil(gotUD)
+	})
+
+	suite.Run("create with empty salt", func() {
+		gotUD, err := suite.testStorage.CreateUser(context.Background(), "Test", "Test", "", "Test")
+		require.ErrorContains(err, "insert into salts table login")
+		require.Nil(gotUD)
+	})
+
+	suite.Run("create with empty login hashed", func() {
+		gotUD, err := suite.testStorage.CreateUser(context.Background(), "Test", "", "Test", "Test")
+		require.ErrorContains(err, "insert into salts table login")
+		require.Nil(gotUD)
+	})
+
+	suite.Run("create dublicate login", func() {
+		gotUD, err := suite.testStorage.CreateUser(context.Background(), suite.testLogin, suite.testLoginHash, suite.testSalt, suite.testPassword)
+		require.ErrorIs(err, ErrUserAlreadyExists)
+		require.Nil(gotUD)
+	})
+}
+
+// TestPgError forces a constraint violation and checks that the sentinel
+// mapping keeps the driver error in the chain, so callers can still
+// recover the PgError with errors.As and inspect the SQLSTATE.
+func (suite *StorageTestSuite) TestPgError() {
+	require := suite.Require()
+
+	gotUD, err := suite.testStorage.CreateUser(context.Background(), suite.testLogin, suite.testLoginHash, suite.testSalt, suite.testPassword)
+	require.Nil(gotUD)
+	require.ErrorIs(err, ErrUserAlreadyExists)
+
+	var pgErr *pgconn.PgError
+
+	require.ErrorAs(err, &pgErr)
+	require.Equal(pgerrcode.UniqueViolation, pgErr.Code)
+}
+
+func (suite *StorageTestSuite) TestGetUser() {
+	require := suite.Require()
+	suite.Run("get user test", func() {
+		gotUD, err := suite.testStorage.GetUser(context.Background(), suite.testLogin, suite.testLoginHash)
+
+		require.NoError(err)
+		require.Equal(&User{
+			ID:       suite.testUserID,
+			Login:    suite.testLogin,
+			Password: suite.testPassword,
+			Salt:     suite.testSalt,
+		}, gotUD)
+	})
+
+	suite.Run("unknown user test", func() {
+		_, err := suite.testStorage.GetUser(context.Background(), "Test", "Test")
+		require.ErrorIs(err, ErrUserNotFound)
+	})
+}
+
+func (suite *StorageTestSuite) TestMaintenance() {
+	require := suite.Require()
+
+	duration, err := suite.testStorage.Maintenance(context.Background())
+	require.NoError(err)
+	require.Greater(duration, time.Duration(0))
+
+	// A cancelled context aborts the run.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = suite.testStorage.Maintenance(ctx)
+	require.Error(err)
+}
+
+func (suite *StorageTestSuite) TestGetUserByLogin() {
+	require := suite.Require()
+
+	suite.Run("get user test", func() {
+		gotUD, err := suite.testStorage.GetUserByLogin(context.Background(), suite.testLogin)
+
+		require.NoError(err)
+		require.Equal(suite.testUserID, gotUD.ID)
+		require.Equal(suite.testLogin, gotUD.Login)
+	})
+
+	suite.Run("unknown user test", func() {
+		_, err := suite.testStorage.GetUserByLogin(context.Background(), "Test")
+		require.ErrorIs(err, ErrUserNotFound)
+	})
+}
+
+func (suite *StorageTestSuite) TestRecoveryCodes() {
+	require := suite.Require()
+
+	firstHashes := []string{"TestCodeHash1", "TestCodeHash2"}
+
+	err := suite.testStorage.ReplaceRecoveryCodes(context.Background(), suite.testUserID, firstHashes)
+	require.NoError(err)
+
+	gotCodes, err := suite.testStorage.GetRecoveryCodes(context.Background(), suite.testUserID)
+	require.NoError(err)
+	require.Len(gotCodes, len(firstHashes))
+
+	gotHashes := make([]string, 0, len(gotCodes))
+
+	for _, code := range gotCodes {
+		require.Equal(suite.testUserID, code.UserID)
+		gotHashes = append(gotHashes, code.CodeHash)
+	}
+
+	require.ElementsMatch(firstHashes, gotHashes)
+
+	// Regenerating replaces the previous set.
+	err = suite.testStorage.ReplaceRecoveryCodes(context.Background(), suite.testUserID, []string{"TestCodeHash3"})
+	require.NoError(err)
+
+	gotCodes, err = suite.testStorage.GetRecoveryCodes(context.Background(), suite.testUserID)
+	require.NoError(err)
+	require.Len(gotCodes, 1)
+	require.Equal("TestCodeHash3", gotCodes[0].CodeHash)
+
+	// A code can only be deleted once.
+	err = suite.testStorage.DeleteRecoveryCode(context.Background(), gotCodes[0].ID)
+	require.NoError(err)
+
+	err = suite.testStorage.DeleteRecoveryCode(context.Background(), gotCodes[0].ID)
+	require.ErrorIs(err, ErrRecoveryCodeNotFound)
+
+	gotCodes, err = suite.testStorage.GetRecoveryCodes(context.Background(), suite.testUserID)
+	require.NoError(err)
+	require.Empty(gotCodes)
+}
+
+func (suite *StorageTestSuite) TestCreatePassword() {
+	suite.Run("unknown user", func() {
+		gotPassword, err := suite.testStorage.CreatePassword(
+			context.Background(),
+			emptyUUID,
+			"Test",
+			"Test",
+			"Test",
+			"Test",
+			"",
+			"",
+			false,
+			false,
+		)
+		suite.Require().ErrorIs(err, ErrUserNotFound)
+		suite.Require().Nil(gotPassword)
+	})
+}
+
+func (suite *StorageTestSuite) TestUpdatePassword() {
+	require := suite.Require()
+
+	suite.Run("update existing password", func() {
+		newName := "NewTestName"
+		newLogin := "NewTestLogin"
+		newPassword := "NewTestPassword"
+		newMeta := "NewTestMeta"
+
+		gotPWD, err := suite.testStorage.UpdatePassword(
+			context.Background(),
+			suite.testPWDID,
+			suite.testUserID,
+			newName,
+			newLogin,
+			newPassword,
+			newMeta,
+			"",
+			"",
+			false,
+			false,
+		)
+		require.NoError(err)
+
+		updatedPassword, err := suite.testStorage.GetPassword(context.Background(), suite.testPWDID, suite.testUserID)
+		require.NoError(err)
+
+		require.Equal(gotPWD, updatedPassword)
+	})
+
+	suite.Run("update non-existing password", func() {
+		gotPWD, err := suite.testStorage.UpdatePassword(
+			context.Background(),
+			emptyUUID,
+			suite.testUserID,
+			"Test",
+			"Test",
+			"Test",
+			"Test",
+			"",
+			"",
+			false,
+			false,
+		)
+		require.ErrorIs(err, ErrPasswordNotFound)
+		require.Nil(gotPWD)
+	})
+
+	suite.Run("update non-existing user", func() {
+		gotPWD, err := suite.testStorage.UpdatePassword(
+			context.Background(),
+			suite.testPWDID,
+			emptyUUID,
+			"Test",
+			"Test",
+			"Test",
+			"Test",
+			"",
+			"",
+			false,
+			false,
+		)
+		require.ErrorIs(err, ErrUserNotFound)
+		require.Nil(gotPWD)
+	})
+}
+
+func (suite *StorageTestSuite) TestGetPassword() {
+	require := suite.Require()
+
+	suite.Run("positive test", func() {
+		gotPassword, err := suite.testStorage.GetPassword(context.Background(), suite.testPWDID, suite.testUserID)
+		require.NoError(err)
+		require.Equal(&Password{
+			ID:       suite.testPWDID,
+			UserID:   suite.testUserID,
+			Name:     suite.testPWDName,
+			Login:    suite.testPWDLogin,
+			Password: suite.testPWDPassword,
+			Meta:     suite.testPWDMeta,
+			UpdateAt: suite.testPWDUploadAt,
+		}, gotPassword)
+	})
+
+	suite.Run("unknown id", func() {
+		gotPassword, err := suite.testStorage.GetPassword(context.Background(), emptyUUID, suite.testUserID)
+		require.ErrorIs(err, ErrPasswordNotFound)
+		require.Nil(gotPassword)
+	})
+}
+
+func (suite *StorageTestSuite) TestCreateFile() {
+	require := suite.Require()
+	suite.Run("unknown user", func() {
+		gotPassword, err := suite.testStorage.CreateFile(
+			context.Background(),
+			emptyUUID,
+			"Test",
+			"Test",
+			"Test",
+			false,
+			false,
+		)
+		require.ErrorIs(err, ErrUserNotFound)
+		require.Nil(gotPassword)
+	})
+
+	suite.Run("duplicate path", func() {
+		gotFile, err := suite.testStorage.CreateFile(
+			context.Background(),
+			suite.testUserID,
+			"DuplicatePathName",
+			suite.testFilePath,
+			"DuplicatePathMeta",
+			false,
+			false,
+		)
+		require.ErrorIs(err, ErrPathAlreadyExists)
+		require.Nil(gotFile)
+	})
+}
+
+func (suite *StorageTestSuite) TestGetAllPassword() {
+	require := suite.Require()
+
+	suite.Run("positive test", func() {
+		gotPWDs, err := suite.testStorage.GetAllPassword(context.Background(), suite.testUserID)
+		require.NoError(err)
+		require.Equal([]Password{
+			{
+				ID:       suite.testPWDID,
+				UserID:   suite.testUserID,
+				Name:     suite.testPWDName,
+				Login:    suite.testPWDLogin,
+				Password: suite.testPWDPassword,
+				Meta:     suite.testPWDMeta,
+				UpdateAt: suite.testPWDUploadAt,
+			},
+		}, gotPWDs)
+	})
+
+	suite.Run("unknown user_id", func() {
+		gotPWDs, err := suite.testStorage.GetAllPassword(context.Background(), emptyUUID)
+		require.NoError(err)
+		require.Empty(gotPWDs)
+	})
+}
+
+func (suite *StorageTestSuite) TestGetPasswordsByLogin() {
+	require := suite.Require()
+
+	suite.Run("deterministic encryption disabled", func() {
+		gotPWDs, err := suite.testStorage.GetPasswordsByLogin(context.Background(), suite.testUserID, suite.testPWDLogin)
+		require.ErrorIs(err, ErrDeterministicEncryptionDisabled)
+		require.Nil(gotPWDs)
+	})
+
+	suite.testStorage.EnableDeterministicLogins()
+
+	suite.Run("positive test", func() {
+		gotPWDs, err := suite.testStorage.GetPasswordsByLogin(context.Background(), suite.testUserID, suite.testPWDLogin)
+		require.NoError(err)
+		require.Equal([]Password{
+			{
+				ID:       suite.testPWDID,
+				UserID:   suite.testUserID,
+				Name:     suite.testPWDName,
+				Login:    suite.testPWDLogin,
+				Password: suite.testPWDPassword,
+				Meta:     suite.testPWDMeta,
+				UpdateAt: suite.testPWDUploadAt,
+			},
+		}, gotPWDs)
+	})
+
+	suite.Run("unknown login", func() {
+		gotPWDs, err := suite.testStorage.GetPasswordsByLogin(context.Background(), suite.testUserID, "unknownLogin")
+		require.NoError(err)
+		require.Empty(gotPWDs)
+	})
+}
+
+func (suite *StorageTestSuite) TestPasswordMetaJSON() {
+	require := suite.Require()
+
+	suite.Run("password without structured meta", func() {
+		gotMeta, err := suite.testStorage.GetPasswordMetaJSON(context.Background(), suite.testPWDID, suite.testUserID)
+		require.NoError(err)
+		require.Nil(gotMeta)
+	})
+
+	suite.Run("write and read back", func() {
+		wantMeta := map[string]string{
+			"category": "TestCategory",
+			"notes":    "sealedTestNotes",
+		}
+
+		err := suite.testStorage.UpdatePasswordMetaJSON(context.Background(), suite.testPWDID, suite.testUserID, wantMeta)
+		require.NoError(err)
+
+		gotMeta, err := suite.testStorage.GetPasswordMetaJSON(context.Background(), suite.testPWDID, suite.testUserID)
+		require.NoError(err)
+		require.Equal(wantMeta, gotMeta)
+	})
+
+	suite.Run("unknown password on update", func() {
+		err := suite.testStorage.UpdatePasswordMetaJSON(context.Background(), emptyUUID, suite.testUserID, nil)
+		require.ErrorIs(err, ErrPasswordNotFound)
+	})
+
+	suite.Run("unknown password on get", func() {
+		gotMeta, err := suite.testStorage.GetPasswordMetaJSON(context.Background(), emptyUUID, suite.testUserID)
+		require.ErrorIs(err, ErrPasswordNotFound)
+		require.Nil(gotMeta)
+	})
+}
+
+func (suite *StorageTestSuite) TestGetPasswordsByCategory() {
+	require := suite.Require()
+
+	workPWD, err := suite.testStorage.CreatePassword(
+		context.Background(),
+		suite.testUserID,
+		"CategoryWorkName",
+		"CategoryWorkLogin",
+		"CategoryWorkPassword",
+		"CategoryWorkMeta",
+		"",
+		"",
+		false,
+		false,
+	)
+	require.NoError(err)
+
+	personalPWD, err := suite.testStorage.CreatePassword(
+		context.Background(),
+		suite.testUserID,
+		"CategoryPersonalName",
+		"CategoryPersonalLogin",
+		"CategoryPersonalPassword",
+		"CategoryPersonalMeta",
+		"",
+		"",
+		false,
+		false,
+	)
+	require.NoError(err)
+
+	require.NoError(suite.testStorage.UpdatePasswordMetaJSON(
+		context.Background(), workPWD.ID, suite.testUserID,
+		map[string]string{"category": "work"},
+	))
+	require.NoError(suite.testStorage.UpdatePasswordMetaJSON(
+		context.Background(), personalPWD.ID, suite.testUserID,
+		map[string]string{"category": "personal"},
+	))
+
+	suite.Run("returns only matching entries", func() {
+		gotPWDs, err := suite.testStorage.GetPasswordsByCategory(context.Background(), suite.testUserID, "work")
+		require.NoError(err)
+		require.Len(gotPWDs, 1)
+		require.Equal(workPWD.ID, gotPWDs[0].ID)
+	})
+
+	suite.Run("unknown category", func() {
+		gotPWDs, err := suite.testStorage.GetPasswordsByCategory(context.Background(), suite.testUserID, "unknownCategory")
+		require.NoError(err)
+		require.Empty(gotPWDs)
+	})
+
+	suite.Run("filter is served by the GIN index", func() {
+		conn, err := suite.testStorage.conn.Acquire(context.Background())
+		require.NoError(err)
+		defer conn.Release()
+
+		// The test tables are tiny, so the planner would pick a seq scan
+		// on cost alone; disabling it shows whether the index is usable.
+		_, err = conn.Exec(context.Background(), "SET enable_seqscan = off;")
+		require.NoError(err)
+
+		rows, err := conn.Query(context.Background(), "EXPLAIN "+querySelectPasswordsByCategory, suite.testUserID, "work")
+		require.NoError(err)
+
+		defer rows.Close()
+
+		plan := ""
+
+		for rows.Next() {
+			var line string
+			require.NoError(rows.Scan(&line))
+			plan += line + "\n"
+		}
+
+		require.NoError(rows.Err())
+		require.Contains(plan, "passwords_meta_json_idx")
+
+		_, err = conn.Exec(context.Background(), "SET enable_seqscan = on;")
+		require.NoError(err)
+	})
+
+	require.NoError(suite.testStorage.DeletePassword(context.Background(), workPWD.ID, suite.testUserID))
+	require.NoError(suite.testStorage.DeletePassword(context.Background(), personalPWD.ID, suite.testUserID))
+}
+
+func (suite *StorageTestSuite) TestGetEntryIDs() {
+	require := suite.Require()
+
+	suite.Run("unknown entry kind", func() {
+		gotEntries, err := suite.testStorage.GetEntryIDs(context.Background(), suite.testUserID, "wrongKind")
+		require.ErrorIs(err, ErrUnknownEntryKind)
+		require.Nil(gotEntries)
+	})
+
+	suite.Run("positive test", func() {
+		gotEntries, err := suite.testStorage.GetEntryIDs(context.Background(), suite.testUserID, "password")
+		require.NoError(err)
+		require.Equal([]EntryID{
+			{
+				ID:       suite.testPWDID,
+				UpdateAt: suite.testPWDUploadAt,
+			},
+		}, gotEntries)
+	})
+
+	suite.Run("file ids", func() {
+		gotEntries, err := suite.testStorage.GetEntryIDs(context.Background(), suite.testUserID, "file")
+		require.NoError(err)
+
+		ids := make([]string, 0, len(gotEntries))
+
+		for _, v := range gotEntries {
+			ids = append(ids, v.ID)
+		}
+
+		require.Contains(ids, suite.testFileID)
+	})
+}
+
+func (suite *StorageTestSuite) TestDeletePassword() {
+	require := suite.Require()
+
+	suite.Run("delete existing password", func() {
+		newPassword, err := suite.testStorage.CreatePassword(
+			context.Background(),
+			suite.testUserID,
+			"DeleteTestName",
+			"DeleteTestLogin",
+			"DeleteTestPassword",
+			"DeleteTestMeta",
+			"",
+			"",
+			false,
+			false,
+		)
+		require.NoError(err)
+		require.NotNil(newPassword)
+
+		err = suite.testStorage.DeletePassword(context.Background(), newPassword.ID, newPassword.UserID)
+		require.NoError(err)
+
+		deletedPassword, err := suite.testStorage.GetPassword(context.Background(), newPassword.ID, suite.testUserID)
+		require.ErrorIs(err, ErrPasswordNotFound)
+		require.Nil(deletedPassword)
+	})
+
+	suite.Run("delete non-existing passwword", func() {
+		err := suite.testStorage.DeletePassword(context.Background(), emptyUUID, suite.testUserID)
+		require.ErrorIs(err, ErrPasswordNotFound)
+	})
+}
+
+func (suite *StorageTestSuite) TestOwnershipMasking() {
+	require := suite.Require()
+
+	otherUser, err := suite.testStorage.CreateUser(
+		context.Background(),
+		"MaskingTestLogin",
+		"MaskingTestLoginHash",
+		"MaskingTestSalt",
+		"MaskingTestPassword",
+	)
+	require.NoError(err)
+	require.NotNil(otherUser)
+
+	defer func() {
+		_, err := suite.testStorage.DeleteUser(context.Background(), otherUser.ID, "MaskingTestLoginHash")
+		require.NoError(err)
+	}()
+
+	suite.Run("foreign password id is masked as not found", func() {
+		gotPassword, err := suite.testStorage.GetPassword(context.Background(), suite.testPWDID, otherUser.ID)
+		require.ErrorIs(err, ErrPasswordNotFound)
+		require.Nil(gotPassword)
+
+		_, randomErr := suite.testStorage.GetPassword(context.Background(), emptyUUID, otherUser.ID)
+		require.ErrorIs(randomErr, ErrPasswordNotFound)
+	})
+
+	suite.Run("foreign password update is masked as not found", func() {
+		gotPassword, err := suite.testStorage.UpdatePassword(
+			context.Background(),
+			suite.testPWDID,
+			otherUser.ID,
+			"MaskingTestName",
+			"MaskingTestLogin",
+			"MaskingTestPassword",
+			"MaskingTestMeta",
+			"",
+			"",
+			false,
+			false,
+		)
+		require.ErrorIs(err, ErrPasswordNotFound)
+		require.Nil(gotPassword)
+	})
+
+	suite.Run("foreign password delete is masked and keeps the row", func() {
+		err := suite.testStorage.DeletePassword(context.Background(), suite.testPWDID, otherUser.ID)
+		require.ErrorIs(err, ErrPasswordNotFound)
+
+		gotPassword, err := suite.testStorage.GetPassword(context.Background(), suite.testPWDID, suite.testUserID)
+		require.NoError(err)
+		require.NotNil(gotPassword)
+	})
+
+	suite.Run("foreign file, bank and text ids are masked as not found", func() {
+		gotFile, err := suite.testStorage.GetFile(context.Background(), suite.testFileID, otherUser.ID)
+		require.ErrorIs(err, ErrFileNotFound)
+		require.Nil(gotFile)
+
+		gotBank, err := suite.testStorage.GetBank(context.Background(), suite.testBankID, otherUser.ID)
+		require.ErrorIs(err, ErrBankNotFound)
+		require.Nil(gotBank)
+
+		gotText, err := suite.testStorage.GetText(context.Background(), suite.testTextID, otherUser.ID)
+		require.ErrorIs(err, ErrTextNotFound)
+		require.Nil(gotText)
+	})
+}
+
+func (suite *StorageTestSuite) TestTouchPassword() {
+	require := suite.Require()
+
+	suite.Run("touch bumps update time only", func() {
+		created, err := suite.testStorage.CreatePassword(
+			context.Background(),
+			suite.testUserID,
+			"TouchTestName",
+			"TouchTestLogin",
+			"TouchTestPassword",
+			"TouchTestMeta",
+			"",
+			"",
+			false,
+			false,
+		)
+		require.NoError(err)
+		require.NotNil(created)
+
+		touched, err := suite.testStorage.TouchPassword(context.Background(), created.ID, suite.testUserID)
+		require.NoError(err)
+		require.True(touched.UpdateAt.After(created.UpdateAt))
+
+		touched.UpdateAt = created.UpdateAt
+		require.Equal(created, touched)
+	})
+
+	suite.Run("touch non-existing password", func() {
+		touched, err := suite.testStorage.TouchPassword(context.Background(), emptyUUID, suite.testUserID)
+		require.ErrorIs(err, ErrPasswordNotFound)
+		require.Nil(touched)
+	})
+}
+
+func (suite *StorageTestSuite) TestDBFileStorage() {
+	require := suite.Require()
+
+	dfs := NewDBFileStorage(suite.testStorage, 4)
+	require.Equal(4, dfs.GetChunkSize())
+
+	content := []byte("testDBFileContent")
+
+	suite.Run("stream a multi-chunk file in", func() {
+		writer, err := dfs.CreateDBFile("testDBFile")
+		require.NoError(err)
+
+		n, err := writer.Write(content[:7])
+		require.NoError(err)
+		require.Equal(7, n)
+
+		n, err = writer.Write(content[7:])
+		require.NoError(err)
+		require.Equal(len(content)-7, n)
+
+		require.NoError(writer.Close())
+	})
+
+	suite.Run("create existing file", func() {
+		writer, err := dfs.CreateDBFile("testDBFile")
+		require.ErrorContains(err, "is exists")
+		require.Nil(writer)
+	})
+
+	suite.Run("stream the chunks back out in order", func() {
+		reader, err := dfs.GetDBFile("testDBFile")
+		require.NoError(err)
+
+		got := make([]byte, 0, len(content))
+
+		for {
+			chunk, err := reader.GetChunk()
+
+			if errors.Is(err, io.EOF) {
+				break
+			}
+
+			require.NoError(err)
+			require.LessOrEqual(len(chunk), dfs.GetChunkSize())
+
+			got = append(got, chunk...)
+		}
+
+		require.Equal(content, got)
+		require.NoError(reader.Close())
+	})
+
+	suite.Run("delete file", func() {
+		require.NoError(dfs.DeleteDBFile("testDBFile"))
+
+		reader, err := dfs.GetDBFile("testDBFile")
+		require.ErrorIs(err, ErrFileNotFound)
+		require.Nil(reader)
+
+		require.NoError(dfs.DeleteDBFile("testDBFile"))
+	})
+}
+
+func (suite *StorageTestSuite) TestGetReusedPasswords() {
+	require := suite.Require()
+
+	suite.Run("no reused fingerprints", func() {
+		reused, err := suite.testStorage.GetReusedPasswords(context.Background(), suite.testUserID)
+		require.NoError(err)
+		require.Empty(reused)
+	})
+
+	suite.Run("identical fingerprints are grouped", func() {
+		first, err := suite.testStorage.CreatePassword(
+			context.Background(),
+			suite.testUserID,
+			"ReusedTestName1",
+			"ReusedTestLogin1",
+			"ReusedTestPassword1",
+			"ReusedTestMeta1",
+			"",
+			"reusedFingerprint",
+			false,
+			false,
+		)
+		require.NoError(err)
+
+		second, err := suite.testStorage.CreatePassword(
+			context.Background(),
+			suite.testUserID,
+			"ReusedTestName2",
+			"ReusedTestLogin2",
+			"ReusedTestPassword2",
+			"ReusedTestMeta2",
+			"",
+			"reusedFingerprint",
+			false,
+			false,
+		)
+		require.NoError(err)
+
+		third, err := suite.testStorage.CreatePassword(
+			context.Background(),
+			suite.testUserID,
+			"UniqueTestName",
+			"UniqueTestLogin",
+			"UniqueTestPassword",
+			"UniqueTestMeta",
+			"",
+			"uniqueFingerprint",
+			false,
+			false,
+		)
+		require.NoError(err)
+
+		reused, err := suite.testStorage.GetReusedPasswords(context.Background(), suite.testUserID)
+		require.NoError(err)
+		require.Len(reused, 1)
+		require.Equal("reusedFingerprint", reused[0].Fingerprint)
+		require.ElementsMatch([]string{first.ID, second.ID}, reused[0].IDs)
+
+		for _, id := range []string{first.ID, second.ID, third.ID} {
+			require.NoError(suite.testStorage.DeletePassword(context.Background(), id, suite.testUserID))
+		}
+	})
+}
+
+func (suite *StorageTestSuite) TestGetVaultManifest() {
+	require := suite.Require()
+
+	suite.Run("every kind in one call", func() {
+		gotEntries, err := suite.testStorage.GetVaultManifest(context.Background(), suite.testUserID, 0, 0)
+		require.NoError(err)
+		require.Len(gotEntries, 4)
+
+		gotKinds := make(map[string]string, len(gotEntries))
+
+		for _, entry := range gotEntries {
+			gotKinds[entry.Kind] = entry.ID
+			require.False(entry.UpdateAt.IsZero())
+		}
+
+		require.Equal(map[string]string{
+			"password": suite.testPWDID,
+			"bank":     suite.testBankID,
+			"text":     suite.testTextID,
+			"file":     suite.testFileID,
+		}, gotKinds)
+	})
+
+	suite.Run("deleted entries disappear", func() {
+		newText, err := suite.testStorage.CreateText(
+			context.Background(),
+			suite.testUserID,
+			"ManifestTestTextName",
+			"ManifestTestTextText",
+			"ManifestTestTextMeta",
+			false,
+			false,
+		)
+		require.NoError(err)
+
+		gotEntries, err := suite.testStorage.GetVaultManifest(context.Background(), suite.testUserID, 0, 0)
+		require.NoError(err)
+		require.Len(gotEntries, 5)
+
+		require.NoError(suite.testStorage.DeleteText(context.Background(), newText.ID, suite.testUserID))
+
+		gotEntries, err = suite.testStorage.GetVaultManifest(context.Background(), suite.testUserID, 0, 0)
+		require.NoError(err)
+		require.Len(gotEntries, 4)
+
+		for _, entry := range gotEntries {
+			require.NotEqual(newText.ID, entry.ID)
+		}
+	})
+
+	suite.Run("pagination", func() {
+		firstPage, err := suite.testStorage.GetVaultManifest(context.Background(), suite.testUserID, 2, 0)
+		require.NoError(err)
+		require.Len(firstPage, 2)
+
+		secondPage, err := suite.testStorage.GetVaultManifest(context.Background(), suite.testUserID, 2, 2)
+		require.NoError(err)
+		require.Len(secondPage, 2)
+
+		gotIDs := make(map[string]struct{}, 4)
+
+		for _, entry := range append(firstPage, secondPage...) {
+			gotIDs[entry.ID] = struct{}{}
+		}
+
+		require.Len(gotIDs, 4)
+	})
+
+	suite.Run("unknown user_id", func() {
+		gotEntries, err := suite.testStorage.GetVaultManifest(context.Background(), emptyUUID, 0, 0)
+		require.NoError(err)
+		require.Empty(gotEntries)
+	})
+}
+
+func (suite *StorageTestSuite) TestGetFile() {
+	require := suite.Require()
+
+	suite.Run("positive test", func() {
+		gotFile, err := suite.testStorage.GetFile(context.Background(), suite.testFileID, suite.testUserID)
+		require.NoError(err)
+		require.Equal(&File{
+			ID:         suite.testFileID,
+			UserID:     suite.testUserID,
+			Name:       suite.testFileName,
+			PathToFile: suite.testFilePath,
+			Meta:       suite.testFileMeta,
+			UpdateAt:   suite.testFileUploadAt,
+		}, gotFile)
+	})
+
+	suite.Run("unknown id", func() {
+		gotPassword, err := suite.testStorage.GetFile(context.Background(), emptyUUID, suite.testUserID)
+		require.ErrorIs(err, ErrFileNotFound)
+		require.Nil(gotPassword)
+	})
+}
+
+func (suite *StorageTestSuite) TestUpdateFile() {
+	require := suite.Require()
+
+	suite.Run("update existing file", func() {
+		newName := "NewTestFileName"
+		newPath := "NewTestFilePath"
+		newMeta := "NewTestFileMeta"
+
+		gotFile, err := suite.testStorage.UpdateFile(
+			context.Background(),
+			suite.testFileID,
+			suite.testUserID,
+			newName,
+			newPath,
+			newMeta,
+			false,
+			false,
+		)
+		require.NoError(err)
+
+		updatedFile, err := suite.testStorage.GetFile(context.Background(), suite.testFileID, suite.testUserID)
+		require.NoError(err)
+
+		require.Equal(&File{
+			ID:         gotFile.ID,
+			UserID:     gotFile.UserID,
+			Name:       gotFile.Name,
+			PathToFile: newPath,
+			Meta:       gotFile.Meta,
+			UpdateAt:   gotFile.UpdateAt,
+		}, updatedFile)
+	})
+
+	suite.Run("update non-existing file", func() {
+		gotFile, err := suite.testStorage.UpdateFile(
+			context.Background(),
+			emptyUUID,
+			suite.testUserID,
+			"Test",
+			"Test",
+			"Test",
+			false,
+			false,
+		)
+		require.ErrorIs(err, ErrFileNotFound)
+		require.Nil(gotFile)
+	})
+
+	suite.Run("update non-existing user", func() {
+		gotFile, err := suite.testStorage.UpdateFile(
+			context.Background(),
+			suite.testFileID,
+			emptyUUID,
+			"Test",
+			"Test",
+			"Test",
+			false,
+			false,
+		)
+		require.ErrorIs(err, ErrUserNotFound)
+		require.Nil(gotFile)
+	})
+}
+
+func (suite *StorageTestSuite) TestUpdateFileInfo() {
+	require := suite.Require()
+
+	suite.Run("update existing file info", func() {
+		oldFile, err := suite.testStorage.GetFile(context.Background(), suite.testFileID, suite.testUserID)
+		require.NoError(err)
+
+		gotFile, err := suite.testStorage.UpdateFileInfo(
+			context.Background(),
+			suite.testFileID,
+			suite.testUserID,
+			"NewTestFileInfoName",
+			"NewTestFileInfoMeta",
+			false,
+			false,
+		)
+		require.NoError(err)
+		require.Equal("NewTestFileInfoName", gotFile.Name)
+		require.Equal("NewTestFileInfoMeta", gotFile.Meta)
+		require.Equal(oldFile.PathToFile, gotFile.PathToFile)
+	})
+
+	suite.Run("update non-existing file", func() {
+		gotFile, err := suite.testStorage.UpdateFileInfo(
+			context.Background(),
+			emptyUUID,
+			suite.testUserID,
+			"Test",
+			"Test",
+			false,
+			false,
+		)
+		require.ErrorIs(err, ErrFileNotFound)
+		require.Nil(gotFile)
+	})
+
+	suite.Run("update non-existing user", func() {
+		gotFile, err := suite.testStorage.UpdateFileInfo(
+			context.Background(),
+			suite.testFileID,
+			emptyUUID,
+			"Test",
+			"Test",
+			false,
+			false,
+		)
+		require.ErrorIs(err, ErrFileNotFound)
+		require.Nil(gotFile)
+	})
+}
+
+func (suite *StorageTestSuite) TestGetAllFiles() {
+	require := suite.Require()
+
+	suite.Run("positive test", func() {
+		gotFiles, err := suite.testStorage.GetAllFiles(context.Background(), suite.testUserID)
+		require.NoError(err)
+		require.Equal([]File{
+			{
+				ID:         suite.testFileID,
+				UserID:     suite.testUserID,
+				Name:       suite.testFileName,
+				PathToFile: suite.testFilePath,
+				Meta:       suite.testFileMeta,
+				UpdateAt:   suite.testFileUploadAt,
+			},
+		}, gotFiles)
+	})
+
+	suite.Run("unknown user_id", func() {
+		gotFiles, err := suite.testStorage.GetAllFiles(context.Background(), emptyUUID)
+		require.NoError(err)
+		require.Empty(gotFiles)
+	})
+}
+
+func (suite *StorageTestSuite) TestDeleteFile() {
+	require := suite.Require()
+
+	suite.Run("delete existing file", func() {
+		newFile, err := suite.testStorage.CreateFile(
+			context.Background(),
+			suite.testUserID,
+			"DeleteTestFileName",
+			"DeleteTestFilePath",
+			"DeleteTestFileMeta",
+			false,
+			false,
+		)
+		require.NoError(err)
+		require.NotNil(newFile)
+
+		file, err := suite.testStorage.DeleteFile(context.Background(), newFile.ID, newFile.UserID)
+		require.NoError(err)
+		require.Equal(&File{
+			ID:         newFile.ID,
+			UserID:     suite.testUserID,
+			Name:       "DeleteTestFileName",
+			PathToFile: "DeleteTestFilePath",
+			Meta:       "DeleteTestFileMeta",
+			UpdateAt:   newFile.UpdateAt,
+		}, file)
+
+		deletedFile, err := suite.testStorage.GetFile(context.Background(), newFile.ID, suite.testUserID)
+		require.ErrorIs(err, ErrFileNotFound)
+		require.Nil(deletedFile)
+	})
+
+	suite.Run("delete non-existing file", func() {
+		file, err := suite.testStorage.DeleteFile(context.Background(), emptyUUID, suite.testUserID)
+		require.ErrorIs(err, ErrFileNotFound)
+		require.Nil(file)
+	})
+}
+
+func (suite *StorageTestSuite) TestCreateBank() {
+	require := suite.Require()
+
+	suite.Run("unknown user", func() {
+		gotPassword, err := suite.testStorage.CreateBank(
+			context.Background(),
+			emptyUUID,
+			"Test",
+			"Test",
+			"Test",
+			"Test",
+			"Test",
+			"Test",
+			false,
+			false,
+		)
+		require.ErrorIs(err, ErrUserNotFound)
+		require.Nil(gotPassword)
+	})
+}
+
+func (suite *StorageTestSuite) TestUpdateBank() {
+	require := suite.Require()
+
+	suite.Run("update existing bank", func() {
+		newName := "NewTestBankName"
+		newNumber := "NewTestBankNumber"
+		newCVC := "NewTestBankCVC"
+		newOwner := "NewTestBankOwner"
+		newExp := "NewTestBankExp"
+		newMeta := "NewTestBankMeta"
+
+		gotBank, err := suite.testStorage.UpdateBank(
+			context.Background(),
+			suite.testBankID,
+			suite.testUserID,
+			newName,
+			newNumber,
+			newCVC,
+			newOwner,
+			newExp,
+			newMeta,
+			false,
+			false,
+		)
+		require.NoError(err)
+
+		updatedBank, err := suite.testStorage.GetBank(context.Background(), suite.testBankID, suite.testUserID)
+		require.NoError(err)
+
+		require.Equal(&Bank{
+			ID:         gotBank.ID,
+			UserID:     gotBank.UserID,
+			Name:       newName,
+			CardNumber: newNumber,
+			CVC:        newCVC,
+			Owner:      newOwner,
+			Exp:        newExp,
+			Meta:       newMeta,
+			UpdateAt:   gotBank.UpdateAt,
+		}, updatedBank)
+	})
+
+	suite.Run("update non-existing bank", func() {
+		gotBank, err := suite.testStorage.UpdateBank(
+			context.Background(),
+			emptyUUID,
+			suite.testUserID,
+			"Test",
+			"Test",
+			"Test",
+			"Test",
+			"Test",
+			"Test",
+			false,
+			false,
+		)
+		require.ErrorIs(err, ErrBankNotFound)
+		require.Nil(gotBank)
+	})
+
+	suite.Run("update non-existing user", func() {
+		gotBank, err := suite.testStorage.UpdateBank(
+			context.Background(),
+			suite.testBankID,
+			emptyUUID,
+			"Test",
+			"Test",
+			"Test",
+			"Test",
+			"Test",
+			"Test",
+			false,
+			false,
+		)
+		require.ErrorIs(err, ErrUserNotFound)
+		require.Nil(gotBank)
+	})
+}
+
+func (suite *StorageTestSuite) TestGetBank() {
+	require := suite.Require()
+
+	suite.Run("positive test", func() {
+		gotBank, err := suite.testStorage.GetBank(context.Background(), suite.testBankID, suite.testUserID)
+		require.NoError(err)
+		require.Equal(&Bank{
+			ID:         suite.testBankID,
+			UserID:     suite.testUserID,
+			Name:       suite.testBankName,
+			CardNumber: suite.testBankNumber,
+			CVC:        suite.testBankCVC,
+			Owner:      suite.testBankOwner,
+			Meta:       suite.testBankMeta,
+			Exp:        suite.testBankExp,
+			UpdateAt:   suite.testBankUploadAt,
+		}, gotBank)
+	})
+
+	suite.Run("unknown id", func() {
+		gotBank, err := suite.testStorage.GetBank(context.Background(), emptyUUID, suite.testUserID)
+		require.ErrorIs(err, ErrBankNotFound)
+		require.Nil(gotBank)
+	})
+}
+
+func (suite *StorageTestSuite) TestGetAllBanks() {
+	require := suite.Require()
+
+	suite.Run("positive test", func() {
+		gotBanks, err := suite.testStorage.GetAllBanks(context.Background(), suite.testUserID)
+		require.NoError(err)
+		require.Equal([]Bank{
+			{
+				ID:         suite.testBankID,
+				UserID:     suite.testUserID,
+				Name:       suite.testBankName,
+				CardNumber: suite.testBankNumber,
+				CVC:        suite.testBankCVC,
+				Owner:      suite.testBankOwner,
+				Meta:       suite.testBankMeta,
+				Exp:        suite.testBankExp,
+				UpdateAt:   suite.testBankUploadAt,
+			},
+		}, gotBanks)
+	})
+
+	suite.Run("unknown user_id", func() {
+		gotBanks, err := suite.testStorage.GetAllBanks(context.Background(), emptyUUID)
+		require.NoError(err)
+		require.Empty(gotBanks)
+	})
+}
+
+func (suite *StorageTestSuite) TestDeleteBank() {
+	require := suite.Require()
+
+	suite.Run("delete existing bank", func() {
+		newBank, err := suite.testStorage.CreateBank(
+			context.Background(),
+			suite.testUserID,
+			"DeleteTestBankName",
+			"DeleteTestBankNumber",
+			"DeleteTestBankCVC",
+			"DeleteTestBankOwner",
+			"DeleteTestBankExp",
+			"DeleteTestBankMeta",
+			false,
+			false,
+		)
+		require.NoError(err)
+		require.NotNil(newBank)
+
+		err = suite.testStorage.DeleteBank(context.Background(), newBank.ID, newBank.UserID)
+		require.NoError(err)
+
+		deletedBank, err := suite.testStorage.GetBank(context.Background(), newBank.ID, suite.testUserID)
+		require.ErrorIs(err, ErrBankNotFound)
+		require.Nil(deletedBank)
+	})
+
+	suite.Run("delete non-existing bank", func() {
+		err := suite.testStorage.DeleteBank(context.Background(), emptyUUID, suite.testUserID)
+		require.ErrorIs(err, ErrBankNotFound)
+	})
+}
+
+func (suite *StorageTestSuite) TestCreateText() {
+	require := suite.Require()
+
+	suite.Run("unknown user", func() {
+		gotPassword, err := suite.testStorage.CreateText(
+			context.Background(),
+			emptyUUID,
+			"Test",
+			"Test",
+			"Test",
+			false,
+			false,
+		)
+		require.ErrorIs(err, ErrUserNotFound)
+		require.Nil(gotPassword)
+	})
+}
+
+func (suite *StorageTestSuite) TestUpdateText() {
+	require := suite.Require()
+
+	suite.Run("update existing text", func() {
+		newName := "NewTestTextName"
+		newText := "NewTestTextData"
+		newMeta := "NewTestTextMeta"
+
+		gotText, err := suite.testStorage.UpdateText(
+			context.Background(),
+			suite.testTextID,
+			suite.testUserID,
+			newName,
+			newText,
+			newMeta,
+			false,
+			false,
+		)
+		require.NoError(err)
+
+		updatedText, err := suite.testStorage.GetText(context.Background(), suite.testTextID, suite.testUserID)
+		require.NoError(err)
+
+		require.Equal(&Text{
+			ID:       gotText.ID,
+			UserID:   gotText.UserID,
+			Name:     newName,
+			Text:     newText,
+			Meta:     newMeta,
+			UpdateAt: gotText.UpdateAt,
+		}, updatedText)
+	})
+
+	suite.Run("update non-existing text", func() {
+		gotText, err := suite.testStorage.UpdateText(
+			context.Background(),
+			emptyUUID,
+			suite.testUserID,
+			"Test",
+			"Test",
+			"Test",
+			false,
+			false,
+		)
+		require.ErrorIs(err, ErrTextNotFound)
+		require.Nil(gotText)
+	})
+
+	suite.Run("update non-existing user", func() {
+		gotText, err := suite.testStorage.UpdateText(
+			context.Background(),
+			suite.testTextID,
+			emptyUUID,
+			"Test",
+			"Test",
+			"Test",
+			false,
+			false,
+		)
+		require.ErrorIs(err, ErrUserNotFound)
+		require.Nil(gotText)
+	})
+}
+
+func (suite *StorageTestSuite) TestGetText() {
+	require := suite.Require()
+
+	suite.Run("positive test", func() {
+		gotText, err := suite.testStorage.GetText(context.Background(), suite.testTextID, suite.testUserID)
+		require.NoError(err)
+		require.Equal(&Text{
+			ID:       suite.testTextID,
+			UserID:   suite.testUserID,
+			Name:     suite.testTextName,
+			Text:     suite.testTextText,
+			Meta:     suite.testTextMeta,
+			UpdateAt: suite.testTextUploadAt,
+		}, gotText)
+	})
+
+	suite.Run("unknown id", func() {
+		gotText, err := suite.testStorage.GetText(context.Background(), emptyUUID, suite.testUserID)
+		require.ErrorIs(err, ErrTextNotFound)
+		require.Nil(gotText)
+	})
+}
+
+func (suite *StorageTestSuite) TestGetAllTexts() {
+	require := suite.Require()
+	suite.Run("positive test", func() {
+		gotTexts, err := suite.testStorage.GetAllTexts(context.Background(), suite.testUserID, "", "", 0, 0)
+		require.NoError(err)
+		require.Equal([]Text{
+			{
+				ID:       suite.testTextID,
+				UserID:   suite.testUserID,
+				Name:     suite.testTextName,
+				Text:     suite.testTextText,
+				Meta:     suite.testTextMeta,
+				UpdateAt: suite.testTextUploadAt,
+			},
+		}, gotTexts)
+	})
+
+	suite.Run("unknown user_id", func() {
+		gotTexts, err := suite.testStorage.GetAllTexts(context.Background(), emptyUUID, "", "", 0, 0)
+		require.NoError(err)
+		require.Empty(gotTexts)
+	})
+
+	suite.Run("order by update_at", func() {
+		newText, err := suite.testStorage.CreateText(
+			context.Background(),
+			suite.testUserID,
+			"OrderTestTextName",
+			"OrderTestTextText",
+			"OrderTestTextMeta",
+			false,
+			false,
+		)
+		require.NoError(err)
+		require.NotNil(newText)
+
+		gotTexts, err := suite.testStorage.GetAllTexts(context.Background(), suite.testUserID, "update_at", "asc", 0, 0)
+		require.NoError(err)
+		require.Len(gotTexts, 2)
+		require.False(gotTexts[0].UpdateAt.After(gotTexts[1].UpdateAt))
+
+		gotTexts, err = suite.testStorage.GetAllTexts(context.Background(), suite.testUserID, "update_at", "desc", 0, 0)
+		require.NoError(err)
+		require.Len(gotTexts, 2)
+		require.False(gotTexts[0].UpdateAt.Before(gotTexts[1].UpdateAt))
+
+		gotTexts, err = suite.testStorage.GetAllTexts(context.Background(), suite.testUserID, "update_at", "desc", 1, 1)
+		require.NoError(err)
+		require.Len(gotTexts, 1)
+
+		require.NoError(suite.testStorage.DeleteText(context.Background(), newText.ID, suite.testUserID))
+	})
+}
+
+func (suite *StorageTestSuite) TestGetRecentActivity() {
+	require := suite.Require()
+
+	suite.Run("positive test", func() {
+		gotActivities, err := suite.testStorage.GetRecentActivity(context.Background(), suite.testUserID, 10)
+		require.NoError(err)
+		require.Len(gotActivities, 4)
+
+		gotKinds := make(map[string]string, len(gotActivities))
+
+		for i, activity := range gotActivities {
+			gotKinds[activity.Kind] = activity.ID
+
+			if i > 0 {
+				require.False(gotActivities[i-1].UpdateAt.Before(activity.UpdateAt))
+			}
+		}
+
+		require.Equal(map[string]string{
+			"password": suite.testPWDID,
+			"bank":     suite.testBankID,
+			"text":     suite.testTextID,
+			"file":     suite.testFileID,
+		}, gotKinds)
+	})
+
+	suite.Run("limit", func() {
+		gotActivities, err := suite.testStorage.GetRecentActivity(context.Background(), suite.testUserID, 2)
+		require.NoError(err)
+		require.Len(gotActivities, 2)
+	})
+
+	suite.Run("unknown user_id", func() {
+		gotActivities, err := suite.testStorage.GetRecentActivity(context.Background(), emptyUUID, 10)
+		require.NoError(err)
+		require.Empty(gotActivities)
+	})
+}
+
+func (suite *StorageTestSuite) TestListQueryUsesIndex() {
+	require := suite.Require()
+
+	const textsCount = 100
+
+	createdIDs := make([]string, 0, textsCount)
+
+	for i := 0; i < textsCount; i++ {
+		newText, err := suite.testStorage.CreateText(
+			context.Background(),
+			suite.testUserID,
+			fmt.Sprintf("IndexTestTextName%d", i),
+			"IndexTestTextText",
+			"IndexTestTextMeta",
+			false,
+			false,
+		)
+		require.NoError(err)
+		require.NotNil(newText)
+
+		createdIDs = append(createdIDs, newText.ID)
+	}
+
+	conn, err := suite.testStorage.conn.Acquire(context.Background())
+	require.NoError(err)
+	defer conn.Release()
+
+	_, err = conn.Exec(context.Background(), "ANALYZE texts;")
+	require.NoError(err)
+
+	_, err = conn.Exec(context.Background(), "SET enable_seqscan = off;")
+	require.NoError(err)
+
+	defer func() {
+		_, err := conn.Exec(context.Background(), "RESET enable_seqscan;")
+		require.NoError(err)
+	}()
+
+	rows, err := conn.Query(
+		context.Background(),
+		"EXPLAIN SELECT id, name, text, meta, updateAt FROM texts WHERE user_id = $1 ORDER BY updateAt;",
+		suite.testUserID,
+	)
+	require.NoError(err)
+	defer rows.Close()
+
+	var plan string
+
+	for rows.Next() {
+		var line string
+		require.NoError(rows.Scan(&line))
+		plan += line + "\n"
+	}
+
+	require.NoError(rows.Err())
+	require.Contains(plan, "Index Scan", plan)
+	require.Contains(plan, "texts_user_id_update_at_idx", plan)
+
+	for _, id := range createdIDs {
+		require.NoError(suite.testStorage.DeleteText(context.Background(), id, suite.testUserID))
+	}
+}
+
+func (suite *StorageTestSuite) TestDeleteText() {
+	require := suite.Require()
+
+	suite.Run("delete existing text", func() {
+		newText, err := suite.testStorage.CreateText(
+			context.Background(),
+			suite.testUserID,
+			"DeleteTestTextName",
+			"DeleteTestTextData",
+			"DeleteTestTextMeta",
+			false,
+			false,
+		)
+		require.NoError(err)
+		require.NotNil(newText)
+
+		err = suite.testStorage.DeleteText(context.Background(), newText.ID, newText.UserID)
+		require.NoError(err)
+
+		deletedText, err := suite.testStorage.GetText(context.Background(), newText.ID, suite.testUserID)
+		require.ErrorIs(err, ErrTextNotFound)
+		require.Nil(deletedText)
+	})
+
+	suite.Run("delete non-existing text", func() {
+		err := suite.testStorage.DeleteText(context.Background(), emptyUUID, suite.testUserID)
+		require.ErrorIs(err, ErrTextNotFound)
+	})
+}
+
+func (suite *StorageTestSuite) TestPinnedEntries() {
+	require := suite.Require()
+
+	suite.Run("toggle pinned on update", func() {
+		newText, err := suite.testStorage.CreateText(
+			context.Background(),
+			suite.testUserID,
+			"PinnedToggleTextName",
+			"PinnedToggleTextData",
+			"PinnedToggleTextMeta",
+			false,
+			false,
+		)
+		require.NoError(err)
+		require.False(newText.Pinned)
+
+		gotText, err := suite.testStorage.UpdateText(
+			context.Background(),
+			newText.ID,
+			suite.testUserID,
+			"PinnedToggleTextName",
+			"PinnedToggleTextData",
+			"PinnedToggleTextMeta",
+			true,
+			false,
+		)
+		require.NoError(err)
+		require.True(gotText.Pinned)
+
+		storedText, err := suite.testStorage.GetText(context.Background(), newText.ID, suite.testUserID)
+		require.NoError(err)
+		require.True(storedText.Pinned)
+
+		require.NoError(suite.testStorage.DeleteText(context.Background(), newText.ID, suite.testUserID))
+	})
+
+	suite.Run("favorites filter by pinned", func() {
+		pinnedText, err := suite.testStorage.CreateText(
+			context.Background(),
+			suite.testUserID,
+			"PinnedFavTextName",
+			"PinnedFavTextData",
+			"PinnedFavTextMeta",
+			true,
+			false,
+		)
+		require.NoError(err)
+
+		unpinnedText, err := suite.testStorage.CreateText(
+			context.Background(),
+			suite.testUserID,
+			"UnpinnedFavTextName",
+			"UnpinnedFavTextData",
+			"UnpinnedFavTextMeta",
+			false,
+			false,
+		)
+		require.NoError(err)
+
+		pinnedPWD, err := suite.testStorage.CreatePassword(
+			context.Background(),
+			suite.testUserID,
+			"PinnedFavPWDName",
+			"PinnedFavPWDLogin",
+			"PinnedFavPWDPassword",
+			"PinnedFavPWDMeta",
+			"",
+			"",
+			true,
+			false,
+		)
+		require.NoError(err)
+
+		favorites, err := suite.testStorage.GetFavorites(context.Background(), suite.testUserID)
+		require.NoError(err)
+
+		textIDs := make([]string, 0, len(favorites.Texts))
+
+		for _, text := range favorites.Texts {
+			require.True(text.Pinned)
+			textIDs = append(textIDs, text.ID)
+		}
+
+		require.Contains(textIDs, pinnedText.ID)
+		require.NotContains(textIDs, unpinnedText.ID)
+
+		pwdIDs := make([]string, 0, len(favorites.Passwords))
+
+		for _, pwd := range favorites.Passwords {
+			require.True(pwd.Pinned)
+			pwdIDs = append(pwdIDs, pwd.ID)
+		}
+
+		require.Contains(pwdIDs, pinnedPWD.ID)
+
+		_, err = suite.testStorage.UpdateText(
+			context.Background(),
+			pinnedText.ID,
+			suite.testUserID,
+			"PinnedFavTextName",
+			"PinnedFavTextData",
+			"PinnedFavTextMeta",
+			false,
+			false,
+		)
+		require.NoError(err)
+
+		favorites, err = suite.testStorage.GetFavorites(context.Background(), suite.testUserID)
+		require.NoError(err)
+
+		for _, text := range favorites.Texts {
+			require.NotEqual(pinnedText.ID, text.ID)
+		}
+
+		require.NoError(suite.testStorage.DeleteText(context.Background(), pinnedText.ID, suite.testUserID))
+		require.NoError(suite.testStorage.DeleteText(context.Background(), unpinnedText.ID, suite.testUserID))
+		require.NoError(suite.testStorage.DeletePassword(context.Background(), pinnedPWD.ID, suite.testUserID))
+	})
+}
+
+func (suite *StorageTestSuite) TestReadReplicaQueries() {
+	require := suite.Require()
+
+	dsn := os.Getenv("TEST_DSN")
+	s, err := NewStorageWithConfig(context.Background(), dsn, 0, WithReadReplicaDSN(dsn))
+	require.NoError(err)
+
+	defer s.Close()
+
+	writesBefore := s.conn.Stat().AcquireCount()
+	readsBefore := s.readConn.Stat().AcquireCount()
+
+	newText, err := s.CreateText(
+		context.Background(),
+		suite.testUserID,
+		"ReplicaTestTextName",
+		"ReplicaTestTextData",
+		"ReplicaTestTextMeta",
+		false,
+		false,
+	)
+	require.NoError(err)
+	require.Greater(s.conn.Stat().AcquireCount(), writesBefore)
+	require.Equal(readsBefore, s.readConn.Stat().AcquireCount())
+
+	writesAfter := s.conn.Stat().AcquireCount()
+
+	gotText, err := s.GetText(context.Background(), newText.ID, suite.testUserID)
+	require.NoError(err)
+	require.Equal(newText.ID, gotText.ID)
+	require.Greater(s.readConn.Stat().AcquireCount(), readsBefore)
+	require.Equal(writesAfter, s.conn.Stat().AcquireCount())
+
+	require.NoError(s.DeleteText(context.Background(), newText.ID, suite.testUserID))
+}
+
+func (suite *StorageTestSuite) TestWarmup() {
+	require := suite.Require()
+
+	dsn := os.Getenv("TEST_DSN")
+	s, err := NewStorageWithConfig(context.Background(), dsn+" pool_min_conns=2", 0, WithWarmup())
+	require.NoError(err)
+
+	defer s.Close()
+
+	require.GreaterOrEqual(s.conn.Stat().TotalConns(), int32(2))
+}
+
+func (suite *StorageTestSuite) TestRateLimitStoreConformance() {
+	ratelimit.Conformance(suite.T(), func(window time.Duration) ratelimit.Store {
+		return ratelimit.NewDBStore(suite.testStorage, window)
+	})
+}
+
+func TestStorageTestSuite(t *testing.T) {
+	suite.Run(t, new(StorageTestSuite))
+}
+
+func BenchmarkGetPassword(b *testing.B) {
+	dsn := os.Getenv("TEST_DSN")
+
+	if dsn == "" {
+		b.Skip("TEST_DSN environment variable is not set")
+	}
+
+	benchmarks := []struct {
+		name string
+		mode pgx.QueryExecMode
+	}{
+		{"cache_statement", pgx.QueryExecModeCacheStatement},
+		{"exec", pgx.QueryExecModeExec},
+		{"simple", pgx.QueryExecModeSimpleProtocol},
+	}
+
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			s, err := NewStorageWithConfig(context.Background(), dsn, bm.mode)
+
+			if err != nil {
+				b.Fatal(err)
+			}
+
+			defer s.Close()
+
+			user, err := s.CreateUser(
+				context.Background(),
+				fmt.Sprintf("BenchLogin-%s", bm.name),
+				fmt.Sprintf("BenchLoginHash-%s", bm.name),
+				"BenchSalt",
+				"BenchPassword",
+			)
+
+			if err != nil {
+				b.Fatal(err)
+			}
+
+			pwd, err := s.CreatePassword(
+				context.Background(),
+				user.ID,
+				"BenchPWDName",
+				"BenchPWDLogin",
+				"BenchPWDPassword",
+				"BenchPWDMeta",
+				"",
+				"",
+				false,
+				false,
+			)
+
+			if err != nil {
+				b.Fatal(err)
+			}
+
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				_, err := s.GetPassword(context.Background(), pwd.ID, user.ID)
+
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
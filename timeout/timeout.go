@@ -0,0 +1,58 @@
+// Package timeout defines gRPC server interceptors that enforce a default
+// request deadline when the client did not set one, so a slow query cannot
+// hold server resources indefinitely.
+package timeout
+
+import (
+	"context"
+	"time"
+
+	"github.com/Tomap-Tomap/GophKeeper/proto"
+	"google.golang.org/grpc"
+)
+
+// Interceptor applies default deadlines to incoming requests. Streaming
+// methods move file content in chunks, so they get their own, larger
+// default.
+type Interceptor struct {
+	unaryTimeout  time.Duration
+	streamTimeout time.Duration
+}
+
+// NewInterceptor allocates a new Interceptor with the given defaults.
+func NewInterceptor(unaryTimeout, streamTimeout time.Duration) *Interceptor {
+	return &Interceptor{
+		unaryTimeout:  unaryTimeout,
+		streamTimeout: streamTimeout,
+	}
+}
+
+// UnaryServerInterceptor is a gRPC unary server interceptor that applies the
+// default unary deadline when the incoming context has none.
+func (i *Interceptor) UnaryServerInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, i.unaryTimeout)
+		defer cancel()
+	}
+
+	return handler(ctx, req)
+}
+
+// StreamServerInterceptor is a gRPC stream server interceptor that applies
+// the default stream deadline when the incoming context has none.
+func (i *Interceptor) StreamServerInterceptor(srv any, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx := stream.Context()
+
+	if _, ok := ctx.Deadline(); ok {
+		return handler(srv, stream)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, i.streamTimeout)
+	defer cancel()
+
+	wrapped := proto.WrapServerStream(stream)
+	wrapped.WrappedContext = ctx
+
+	return handler(srv, wrapped)
+}
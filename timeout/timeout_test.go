@@ -0,0 +1,96 @@
+//go:build unit
+
+package timeout
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+type serverStreamMock struct {
+	grpc.ServerStream
+
+	ctx context.Context
+}
+
+func (s *serverStreamMock) Context() context.Context {
+	return s.ctx
+}
+
+func TestInterceptor_UnaryServerInterceptor(t *testing.T) {
+	i := NewInterceptor(20*time.Millisecond, 40*time.Millisecond)
+
+	t.Run("cancels handler past default timeout", func(t *testing.T) {
+		_, err := i.UnaryServerInterceptor(context.Background(), nil, nil, func(ctx context.Context, _ any) (any, error) {
+			_, ok := ctx.Deadline()
+			require.True(t, ok)
+
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(time.Second):
+				return nil, nil
+			}
+		})
+
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("keeps client deadline", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+		defer cancel()
+
+		_, err := i.UnaryServerInterceptor(ctx, nil, nil, func(ctx context.Context, _ any) (any, error) {
+			deadline, ok := ctx.Deadline()
+			require.True(t, ok)
+			require.Greater(t, time.Until(deadline), time.Minute)
+
+			return nil, nil
+		})
+
+		require.NoError(t, err)
+	})
+}
+
+func TestInterceptor_StreamServerInterceptor(t *testing.T) {
+	i := NewInterceptor(20*time.Millisecond, 40*time.Millisecond)
+
+	t.Run("cancels handler past default timeout", func(t *testing.T) {
+		stream := &serverStreamMock{ctx: context.Background()}
+
+		err := i.StreamServerInterceptor(nil, stream, nil, func(_ any, stream grpc.ServerStream) error {
+			ctx := stream.Context()
+
+			_, ok := ctx.Deadline()
+			require.True(t, ok)
+
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(time.Second):
+				return nil
+			}
+		})
+
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("keeps client deadline", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+		defer cancel()
+
+		stream := &serverStreamMock{ctx: ctx}
+
+		err := i.StreamServerInterceptor(nil, stream, nil, func(_ any, gotStream grpc.ServerStream) error {
+			require.Same(t, stream, gotStream)
+
+			return nil
+		})
+
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,274 @@
+// Package tokener defines methods and structures for generating JWT tokens.
+package tokener
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Tomap-Tomap/GophKeeper/proto"
+	"github.com/golang-jwt/jwt/v4"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	headerAuthorize    = "authorization"
+	expectedAuthScheme = "Bearer"
+
+	// headerDeviceID names the client metadata key identifying the device;
+	// it is carried over into the authenticated context so session tracking
+	// can tie requests to devices.
+	headerDeviceID = "device-id"
+)
+
+// Tokener represents a structure for working with JWT tokens.
+type Tokener struct {
+	secret       []byte
+	challengeKey *rsa.PrivateKey
+	exp          time.Duration
+}
+
+// NewTokener allocates a new Tokener.
+func NewTokener(secret []byte, exp time.Duration) *Tokener {
+	return &Tokener{
+		secret: secret,
+		exp:    exp,
+	}
+}
+
+// SetChallengeKey equips the Tokener with the RSA key used to answer
+// identity challenges. Without a key SignChallenge returns an error.
+func (t *Tokener) SetChallengeKey(key *rsa.PrivateKey) {
+	t.challengeKey = key
+}
+
+// SignChallenge signs the nonce with the configured challenge key.
+func (t *Tokener) SignChallenge(nonce []byte) ([]byte, error) {
+	if t.challengeKey == nil {
+		return nil, fmt.Errorf("challenge key is not configured")
+	}
+
+	return SignChallenge(t.challengeKey, nonce)
+}
+
+// SignChallenge signs the nonce with the private key using the RS256
+// primitive (SHA-256 over PKCS1 v1.5), the same one used for RS256 JWTs.
+func SignChallenge(key *rsa.PrivateKey, nonce []byte) ([]byte, error) {
+	digest := sha256.Sum256(nonce)
+
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot sign challenge: %w", err)
+	}
+
+	return signature, nil
+}
+
+// ParsePrivateKeyPEM parses an RSA private key from PEM, accepting both
+// PKCS1 and PKCS8 encodings.
+func ParsePrivateKeyPEM(data []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+
+	if block == nil {
+		return nil, fmt.Errorf("no PEM block found")
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse private key: %w", err)
+	}
+
+	key, ok := parsed.(*rsa.PrivateKey)
+
+	if !ok {
+		return nil, fmt.Errorf("private key is not an RSA key")
+	}
+
+	return key, nil
+}
+
+// ParsePublicKeyPEM parses an RSA public key from PEM, accepting both
+// PKIX and PKCS1 encodings.
+func ParsePublicKeyPEM(data []byte) (*rsa.PublicKey, error) {
+	block, _ := pem.Decode(data)
+
+	if block == nil {
+		return nil, fmt.Errorf("no PEM block found")
+	}
+
+	if key, err := x509.ParsePKCS1PublicKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	parsed, err := x509.ParsePKIXPublicKey(block.Bytes)
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse public key: %w", err)
+	}
+
+	key, ok := parsed.(*rsa.PublicKey)
+
+	if !ok {
+		return nil, fmt.Errorf("public key is not an RSA key")
+	}
+
+	return key, nil
+}
+
+// VerifyChallenge checks that the signature was produced over the nonce
+// by the private counterpart of pub.
+func VerifyChallenge(pub *rsa.PublicKey, nonce, signature []byte) error {
+	digest := sha256.Sum256(nonce)
+
+	if err := rsa.VerifyPKCS1v15(pub, crypto.SHA256, digest[:], signature); err != nil {
+		return fmt.Errorf("cannot verify challenge: %w", err)
+	}
+
+	return nil
+}
+
+// GetToken generates a new token for the subject. The issue time doubles
+// as the moment of the last password entry: tokens are only issued after a
+// successful authentication, so handlers gating secrets behind a fresh
+// reauth compare against it.
+func (t *Tokener) GetToken(sub string) (string, error) {
+	token := jwt.NewWithClaims(
+		jwt.SigningMethodHS256,
+		jwt.RegisteredClaims{
+			Subject:   sub,
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(t.exp)),
+		},
+	)
+	tokenString, err := token.SignedString([]byte(t.secret))
+
+	if err != nil {
+		return "", fmt.Errorf("cannot sign token: %w", err)
+	}
+
+	return tokenString, nil
+}
+
+// StreamServerInterceptor is a gRPC stream server interceptor for checking JWT authentication.
+func (t *Tokener) StreamServerInterceptor(srv any, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx := stream.Context()
+	newCtx, err := t.authByGRPCContext(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	wrapped := proto.WrapServerStream(stream)
+	wrapped.WrappedContext = newCtx
+
+	return handler(srv, wrapped)
+}
+
+// UnaryServerInterceptor is a gRPC unary server interceptor for checking JWT authentication.
+func (t *Tokener) UnaryServerInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if strings.Contains(info.FullMethod, "Register") ||
+		strings.Contains(info.FullMethod, "Auth") ||
+		strings.Contains(info.FullMethod, "Challenge") ||
+		strings.Contains(info.FullMethod, "ImportUser") ||
+		strings.Contains(info.FullMethod, "Maintenance") ||
+		strings.Contains(info.FullMethod, "GetIntegrityReport") ||
+		strings.Contains(info.FullMethod, "RecoverWithCode") ||
+		strings.Contains(info.FullMethod, "ServerInfo") {
+		return handler(ctx, req)
+	}
+
+	newCtx, err := t.authByGRPCContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(newCtx, req)
+}
+
+func (t *Tokener) getClaimsFromToken(token string) (*jwt.RegisteredClaims, error) {
+	claims := &jwt.RegisteredClaims{}
+	jwtToken, err := jwt.ParseWithClaims(token, claims, func(jwtT *jwt.Token) (interface{}, error) {
+		if _, ok := jwtT.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", jwtT.Header["alg"])
+		}
+
+		return []byte(t.secret), nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot parsing token: %w", err)
+	}
+
+	if !jwtToken.Valid {
+		return nil, fmt.Errorf("invalid token")
+	}
+
+	return claims, nil
+}
+
+func (t *Tokener) authByGRPCContext(ctx context.Context) (context.Context, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+
+	if !ok {
+		return ctx, status.Error(codes.Unauthenticated, "missing metadata")
+	}
+
+	auth := md.Get(headerAuthorize)
+
+	if len(auth) == 0 {
+		return ctx, status.Errorf(codes.Unauthenticated, "missing %s", headerAuthorize)
+	}
+
+	scheme, token, found := strings.Cut(auth[0], " ")
+
+	if !found {
+		return ctx, status.Error(codes.Unauthenticated, "bad authorization string")
+	}
+	if !strings.EqualFold(scheme, expectedAuthScheme) {
+		return ctx, status.Errorf(codes.Unauthenticated, "request unauthenticated with %s", expectedAuthScheme)
+	}
+
+	claims, err := t.getClaimsFromToken(token)
+
+	if err != nil {
+		return ctx, status.Errorf(codes.PermissionDenied, "invalid auth token: %s", err)
+	}
+
+	deviceID := md.Get(headerDeviceID)
+
+	md = metadata.Pairs("user_id", claims.Subject)
+
+	if claims.ExpiresAt != nil {
+		md.Set("token_exp", strconv.FormatInt(claims.ExpiresAt.Unix(), 10))
+	}
+
+	if claims.IssuedAt != nil {
+		md.Set("auth_at", strconv.FormatInt(claims.IssuedAt.Unix(), 10))
+	}
+
+	if len(deviceID) != 0 {
+		md.Set(headerDeviceID, deviceID[0])
+	}
+
+	newCtx := metadata.NewIncomingContext(ctx, md)
+
+	return newCtx, nil
+}
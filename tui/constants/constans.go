@@ -0,0 +1,9 @@
+// Package constants provides a collection of constant values
+// that can be used throughout the application. These constants
+// are defined to avoid magic numbers and improve code readability.
+package constants
+
+// StringHeight is a constant integer value set to 1.
+// It can be used throughout the package or imported into other packages
+// where a fixed height value is required.
+const StringHeight = 1
@@ -0,0 +1,82 @@
+// Package editor opens secret text in the user's $EDITOR through a
+// temporary file readable only by the current user. The file is wiped
+// afterwards so the secret does not linger on disk.
+package editor
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// ErrNoEditor is returned when no editor command is configured.
+var ErrNoEditor = errors.New("no editor configured: set $EDITOR")
+
+// Edit runs the command from $EDITOR on the given text and returns the
+// edited result.
+func Edit(text string) (string, error) {
+	return EditWith(os.Getenv("EDITOR"), text)
+}
+
+// EditWith writes text into a temporary file created with 0600 permissions,
+// runs the given editor command on it and returns the file content after the
+// editor exits. The file is overwritten with zeros and removed before
+// returning.
+func EditWith(editor, text string) (retText string, retErr error) {
+	fields := strings.Fields(editor)
+
+	if len(fields) == 0 {
+		return "", ErrNoEditor
+	}
+
+	file, err := os.CreateTemp("", "gophkeeper-*.txt")
+
+	if err != nil {
+		return "", fmt.Errorf("cannot create temp file: %w", err)
+	}
+
+	defer func() {
+		retErr = errors.Join(retErr, wipe(file.Name()))
+	}()
+
+	_, err = file.WriteString(text)
+	err = errors.Join(err, file.Close())
+
+	if err != nil {
+		return "", fmt.Errorf("cannot write temp file: %w", err)
+	}
+
+	cmd := exec.Command(fields[0], append(fields[1:], file.Name())...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("cannot run editor %s: %w", fields[0], err)
+	}
+
+	data, err := os.ReadFile(file.Name())
+
+	if err != nil {
+		return "", fmt.Errorf("cannot read temp file: %w", err)
+	}
+
+	return string(data), nil
+}
+
+// wipe overwrites the file with zeros before removing it.
+func wipe(path string) error {
+	info, err := os.Stat(path)
+
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(path, make([]byte, info.Size()), 0o600); err != nil {
+		return err
+	}
+
+	return os.Remove(path)
+}
@@ -0,0 +1,58 @@
+//go:build unit
+
+package editor
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func fakeEditor(t *testing.T, script string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "fake-editor.sh")
+	err := os.WriteFile(path, []byte("#!/bin/sh\n"+script+"\n"), 0o755)
+	require.NoError(t, err)
+
+	return path
+}
+
+func TestEditWith(t *testing.T) {
+	t.Run("no editor configured", func(t *testing.T) {
+		edited, err := EditWith("", "testText")
+		require.ErrorIs(t, err, ErrNoEditor)
+		require.Empty(t, edited)
+	})
+
+	t.Run("cannot run editor", func(t *testing.T) {
+		edited, err := EditWith("unknown-editor-command", "testText")
+		require.ErrorContains(t, err, "cannot run editor")
+		require.Empty(t, edited)
+	})
+
+	t.Run("positive test", func(t *testing.T) {
+		editor := fakeEditor(t, `printf 'editedText' > "$1"`)
+
+		edited, err := EditWith(editor, "testText")
+		require.NoError(t, err)
+		require.Equal(t, "editedText", edited)
+	})
+
+	t.Run("temp file is wiped", func(t *testing.T) {
+		editor := fakeEditor(t, `cp "$1" "$(dirname "$0")/seen.txt"`)
+
+		_, err := EditWith(editor, "testText")
+		require.NoError(t, err)
+
+		seen, err := os.ReadFile(filepath.Join(filepath.Dir(editor), "seen.txt"))
+		require.NoError(t, err)
+		require.Equal(t, "testText", string(seen))
+
+		matches, err := filepath.Glob(filepath.Join(os.TempDir(), "gophkeeper-*.txt"))
+		require.NoError(t, err)
+		require.Empty(t, matches)
+	})
+}
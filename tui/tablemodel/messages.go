@@ -0,0 +1,25 @@
+package tablemodel
+
+type inputMsg struct {
+}
+
+type enterMsg struct {
+}
+
+type updateMsg struct {
+}
+
+type openMsg struct {
+}
+
+type deleteMsg struct {
+}
+
+type editRowMsg struct {
+}
+
+type scrollEndMsg struct {
+}
+
+type nextPageMsg struct {
+}
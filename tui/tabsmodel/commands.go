@@ -0,0 +1,7 @@
+package tabsmodel
+
+import tea "github.com/charmbracelet/bubbletea"
+
+func unblockCmd() tea.Msg {
+	return unblockMsg{}
+}
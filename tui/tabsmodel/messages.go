@@ -0,0 +1,4 @@
+package tabsmodel
+
+type unblockMsg struct {
+}